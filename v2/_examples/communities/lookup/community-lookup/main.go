@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+type authorize struct {
+	Token string
+}
+
+func (a authorize) Add(req *http.Request) {
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", a.Token))
+}
+
+/**
+	In order to run, the user will need to provide the bearer token and the community id.
+**/
+func main() {
+	token := flag.String("token", "", "twitter API token")
+	id := flag.String("id", "", "community id")
+	flag.Parse()
+
+	client := &twitter.Client{
+		Authorizer: authorize{
+			Token: *token,
+		},
+		Client: http.DefaultClient,
+		Host:   "https://api.twitter.com",
+	}
+	opts := twitter.CommunityLookupOpts{
+		CommunityFields: []twitter.CommunityField{
+			twitter.CommunityFieldName,
+			twitter.CommunityFieldDescription,
+			twitter.CommunityFieldAccess,
+			twitter.CommunityFieldMemberCount,
+		},
+	}
+
+	fmt.Println("Callout to community lookup callout")
+
+	communityResponse, err := client.CommunityLookup(context.Background(), *id, opts)
+	if err != nil {
+		log.Panicf("community lookup error: %v", err)
+	}
+
+	enc, err := json.MarshalIndent(communityResponse, "", "    ")
+	if err != nil {
+		log.Panic(err)
+	}
+	fmt.Println(string(enc))
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+type authorize struct {
+	Token string
+}
+
+func (a authorize) Add(req *http.Request) {
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", a.Token))
+}
+
+/**
+	In order to run, the user will need to provide the user context bearer token.
+**/
+func main() {
+	token := flag.String("token", "", "twitter API token")
+	flag.Parse()
+
+	client := &twitter.Client{
+		Authorizer: authorize{
+			Token: *token,
+		},
+		Client: http.DefaultClient,
+		Host:   "https://api.twitter.com",
+	}
+	opts := twitter.DMEventsLookupOpts{
+		EventTypes:    []twitter.DMEventType{twitter.DMEventTypeMessageCreate},
+		Expansions:    []twitter.Expansion{twitter.ExpansionSenderID},
+		DMEventFields: []twitter.DMEventField{twitter.DMEventFieldCreatedAt, twitter.DMEventFieldSenderID, twitter.DMEventFieldDMConversationID},
+	}
+
+	fmt.Println("Callout to dm events lookup callout")
+
+	dmResponse, err := client.DMEventsLookup(context.Background(), opts)
+	if err != nil {
+		log.Panicf("dm events lookup error: %v", err)
+	}
+
+	enc, err := json.MarshalIndent(dmResponse, "", "    ")
+	if err != nil {
+		log.Panic(err)
+	}
+	fmt.Println(string(enc))
+}
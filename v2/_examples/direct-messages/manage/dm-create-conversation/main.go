@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+type authorize struct {
+	Token string
+}
+
+func (a authorize) Add(req *http.Request) {
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", a.Token))
+}
+
+/**
+	In order to run, the user will need to provide the user context bearer token, the participant ids and the text.
+**/
+func main() {
+	token := flag.String("token", "", "twitter API token")
+	participantIDs := flag.String("participant_ids", "", "participant ids")
+	text := flag.String("text", "", "message text")
+	flag.Parse()
+
+	client := &twitter.Client{
+		Authorizer: authorize{
+			Token: *token,
+		},
+		Client: http.DefaultClient,
+		Host:   "https://api.twitter.com",
+	}
+
+	fmt.Println("Callout to create dm conversation callout")
+
+	dmResponse, err := client.CreateDMConversation(context.Background(), twitter.CreateDMConversationRequest{
+		ParticipantIDs: strings.Split(*participantIDs, ","),
+		Message: twitter.CreateDMMessage{
+			Text: *text,
+		},
+	})
+	if err != nil {
+		log.Panicf("create dm conversation error: %v", err)
+	}
+
+	enc, err := json.MarshalIndent(dmResponse, "", "    ")
+	if err != nil {
+		log.Panic(err)
+	}
+	fmt.Println(string(enc))
+}
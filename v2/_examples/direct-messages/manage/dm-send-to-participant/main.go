@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+type authorize struct {
+	Token string
+}
+
+func (a authorize) Add(req *http.Request) {
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", a.Token))
+}
+
+/**
+	In order to run, the user will need to provide the user context bearer token, the participant id and the text or media id.
+**/
+func main() {
+	token := flag.String("token", "", "twitter API token")
+	participantID := flag.String("participant_id", "", "participant id")
+	text := flag.String("text", "", "message text")
+	mediaID := flag.String("media_id", "", "optional media id uploaded with the dm_image, dm_gif or dm_video category")
+	flag.Parse()
+
+	client := &twitter.Client{
+		Authorizer: authorize{
+			Token: *token,
+		},
+		Client: http.DefaultClient,
+		Host:   "https://api.twitter.com",
+	}
+
+	fmt.Println("Callout to send dm to participant callout")
+
+	message := twitter.CreateDMMessage{
+		Text: *text,
+	}
+	if len(*mediaID) > 0 {
+		message.Attachments = []twitter.CreateDMAttachment{
+			{
+				MediaID: *mediaID,
+			},
+		}
+	}
+
+	dmResponse, err := client.SendDMToParticipant(context.Background(), *participantID, message)
+	if err != nil {
+		log.Panicf("send dm to participant error: %v", err)
+	}
+
+	enc, err := json.MarshalIndent(dmResponse, "", "    ")
+	if err != nil {
+		log.Panic(err)
+	}
+	fmt.Println(string(enc))
+}
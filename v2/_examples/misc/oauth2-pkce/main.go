@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+	"github.com/g8rswimmer/go-twitter/v2/auth"
+)
+
+/**
+	In order to run, the user will need to provide the OAuth 2.0 client id and the callback url registered with the app.
+	Open the printed url, authorize the app and the callback will look up the authorized user.
+**/
+func main() {
+	clientID := flag.String("client_id", "", "twitter OAuth 2.0 client id")
+	clientSecret := flag.String("client_secret", "", "twitter OAuth 2.0 client secret, only for confidential clients")
+	redirect := flag.String("redirect", "http://127.0.0.1:8080/callback", "the app's callback url")
+	addr := flag.String("addr", "127.0.0.1:8080", "the address to listen on")
+	flag.Parse()
+
+	config := &auth.Config{
+		ClientID:     *clientID,
+		ClientSecret: *clientSecret,
+		RedirectURL:  *redirect,
+		Scopes:       []auth.Scope{auth.ScopeTweetRead, auth.ScopeUsersRead, auth.ScopeOfflineAccess},
+	}
+
+	ar, err := config.AuthCodeURL()
+	if err != nil {
+		log.Panicf("auth code url error: %v", err)
+	}
+	fmt.Println("Open the url to authorize the app")
+	fmt.Println(ar.URL)
+
+	http.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		token, err := config.ExchangeCallback(r.Context(), ar, r.URL)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		client := &twitter.Client{
+			Authorizer: &auth.Authorizer{
+				Token: token,
+			},
+			Client: http.DefaultClient,
+			Host:   "https://api.twitter.com",
+		}
+		userResponse, err := client.AuthUserLookup(context.Background(), twitter.UserLookupOpts{})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		enc, err := json.MarshalIndent(userResponse.Raw.UserDictionaries(), "", "    ")
+		if err != nil {
+			log.Panic(err)
+		}
+		fmt.Fprintln(w, string(enc))
+	})
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+type authorize struct {
+	Token string
+}
+
+func (a authorize) Add(req *http.Request) {
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", a.Token))
+}
+
+/**
+	In order to run, the user will need to provide the user context bearer token.
+**/
+func main() {
+	token := flag.String("token", "", "twitter API token")
+	flag.Parse()
+
+	client := &twitter.Client{
+		Authorizer: authorize{
+			Token: *token,
+		},
+		Client: http.DefaultClient,
+		Host:   "https://api.twitter.com",
+	}
+	opts := twitter.PersonalizedTrendsOpts{
+		PersonalizedTrendFields: []twitter.PersonalizedTrendField{
+			twitter.PersonalizedTrendFieldCategory,
+			twitter.PersonalizedTrendFieldPostCount,
+			twitter.PersonalizedTrendFieldTrendName,
+			twitter.PersonalizedTrendFieldTrendingSince,
+		},
+	}
+
+	fmt.Println("Callout to personalized trends callout")
+
+	trendsResponse, err := client.PersonalizedTrends(context.Background(), opts)
+	if err != nil {
+		log.Panicf("personalized trends error: %v", err)
+	}
+
+	enc, err := json.MarshalIndent(trendsResponse, "", "    ")
+	if err != nil {
+		log.Panic(err)
+	}
+	fmt.Println(string(enc))
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+type authorize struct {
+	Token string
+}
+
+func (a authorize) Add(req *http.Request) {
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", a.Token))
+}
+
+/**
+	In order to run, the user will need to provide the bearer token and the location woeid.
+**/
+func main() {
+	token := flag.String("token", "", "twitter API token")
+	woeid := flag.Int("woeid", 1, "yahoo where on earth id of the location, 1 is worldwide")
+	flag.Parse()
+
+	client := &twitter.Client{
+		Authorizer: authorize{
+			Token: *token,
+		},
+		Client: http.DefaultClient,
+		Host:   "https://api.twitter.com",
+	}
+	opts := twitter.TrendsByWOEIDOpts{
+		MaxTrends:   20,
+		TrendFields: []twitter.TrendField{twitter.TrendFieldTrendName, twitter.TrendFieldTweetCount},
+	}
+
+	fmt.Println("Callout to trends by woeid callout")
+
+	trendsResponse, err := client.TrendsByWOEID(context.Background(), *woeid, opts)
+	if err != nil {
+		log.Panicf("trends by woeid error: %v", err)
+	}
+
+	enc, err := json.MarshalIndent(trendsResponse, "", "    ")
+	if err != nil {
+		log.Panic(err)
+	}
+	fmt.Println(string(enc))
+}
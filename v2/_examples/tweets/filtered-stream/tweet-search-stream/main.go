@@ -60,6 +60,9 @@ func main() {
 			case <-ch:
 				fmt.Println("closing")
 				return
+			case <-tweetStream.Done():
+				fmt.Println("stream stopped")
+				return
 			case tm := <-tweetStream.Tweets():
 				tmb, err := json.Marshal(tm)
 				if err != nil {
@@ -76,7 +79,7 @@ func main() {
 				outputFile.WriteString(fmt.Sprintf("system: %s\n\n", string(smb)))
 				outputFile.Sync()
 				fmt.Println("system")
-			case de := <-tweetStream.DisconnectionError():
+			case de := <-tweetStream.DisconnectionErrors():
 				ded, err := json.Marshal(de)
 				if err != nil {
 					fmt.Printf("error decoding disconnect message %v", err)
@@ -84,6 +87,14 @@ func main() {
 				outputFile.WriteString(fmt.Sprintf("disconnect: %s\n\n", string(ded)))
 				outputFile.Sync()
 				fmt.Println("disconnect")
+			case ce := <-tweetStream.Compliance():
+				ceb, err := json.Marshal(ce)
+				if err != nil {
+					fmt.Printf("error decoding compliance message %v", err)
+				}
+				outputFile.WriteString(fmt.Sprintf("compliance: %s\n\n", string(ceb)))
+				outputFile.Sync()
+				fmt.Println("compliance")
 			case strErr := <-tweetStream.Err():
 				outputFile.WriteString(fmt.Sprintf("error: %v\n\n", strErr))
 				outputFile.Sync()
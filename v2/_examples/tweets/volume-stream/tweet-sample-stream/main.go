@@ -61,6 +61,9 @@ func main() {
 			case <-ch:
 				fmt.Println("closing")
 				return
+			case <-tweetStream.Done():
+				fmt.Println("stream stopped")
+				return
 			case tm := <-tweetStream.Tweets():
 				tmb, err := json.Marshal(tm)
 				if err != nil {
@@ -77,7 +80,7 @@ func main() {
 				outputFile.WriteString(fmt.Sprintf("system: %s\n\n", string(smb)))
 				outputFile.Sync()
 				fmt.Println("system")
-			case de := <-tweetStream.DisconnectionError():
+			case de := <-tweetStream.DisconnectionErrors():
 				ded, err := json.Marshal(de)
 				if err != nil {
 					fmt.Printf("error decoding disconnect message %v", err)
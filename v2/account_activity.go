@@ -0,0 +1,122 @@
+package twitter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const webhookSignaturePrefix = "sha256="
+
+// AccountActivityWebhook is a webhook registered to an account activity environment
+type AccountActivityWebhook struct {
+	ID               string `json:"id"`
+	URL              string `json:"url"`
+	Valid            bool   `json:"valid"`
+	CreatedTimestamp string `json:"created_timestamp"`
+}
+
+// AccountActivityWebhookResponse is the response from registering a webhook
+type AccountActivityWebhookResponse struct {
+	Webhook   *AccountActivityWebhook
+	RateLimit *RateLimit
+}
+
+// AccountActivityWebhooksResponse is the response from the webhooks lookup
+type AccountActivityWebhooksResponse struct {
+	Webhooks  []*AccountActivityWebhook
+	RateLimit *RateLimit
+}
+
+// AccountActivityResponse is the response from the account activity requests that do not return a body
+type AccountActivityResponse struct {
+	RateLimit *RateLimit
+}
+
+// AccountActivitySubscribedResponse is the response from the subscription check
+type AccountActivitySubscribedResponse struct {
+	Subscribed bool
+	RateLimit  *RateLimit
+}
+
+// AccountActivitySubscription is a user subscribed to an account activity environment
+type AccountActivitySubscription struct {
+	UserID string `json:"user_id"`
+}
+
+// AccountActivitySubscriptionsResponse is the response from the subscriptions lookup
+type AccountActivitySubscriptionsResponse struct {
+	Environment   string                         `json:"environment"`
+	ApplicationID string                         `json:"application_id"`
+	Subscriptions []*AccountActivitySubscription `json:"subscriptions"`
+	RateLimit     *RateLimit                     `json:"-"`
+}
+
+func accountActivityWebhookURL(host, env, webhookID string) string {
+	return strings.ReplaceAll(accountActivityWebhookEndpoint.urlID(host, env), webhookIDTag, webhookID)
+}
+
+func accountActivitySubscriptionURL(host, env, userID string) string {
+	return strings.ReplaceAll(accountActivitySubscriptionEndpoint.urlID(host, env), userIDTag, userID)
+}
+
+// WebhookCRCResponse returns the response token of the webhook challenge, which is the base64 HMAC SHA-256 of the
+// CRC token signed with the app's consumer secret
+func WebhookCRCResponse(consumerSecret, crcToken string) string {
+	return webhookSignaturePrefix + webhookSignature(consumerSecret, []byte(crcToken))
+}
+
+// ValidWebhookSignature returns true if the x-twitter-webhooks-signature header is the signature of the body with the
+// app's consumer secret
+func ValidWebhookSignature(consumerSecret, signature string, body []byte) bool {
+	if !strings.HasPrefix(signature, webhookSignaturePrefix) {
+		return false
+	}
+	want := webhookSignaturePrefix + webhookSignature(consumerSecret, body)
+	return hmac.Equal([]byte(signature), []byte(want))
+}
+
+func webhookSignature(consumerSecret string, data []byte) string {
+	mac := hmac.New(sha256.New, []byte(consumerSecret))
+	mac.Write(data)
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// decodeClassic will decode the v1.1 response body into the value, which can be nil when there is no body.  If the
+// status code is not the expected status, then an error response or HTTP error is returned.
+func decodeClassic(resp *http.Response, name string, status int, v interface{}) (*RateLimit, error) {
+	decoder := json.NewDecoder(resp.Body)
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode != status {
+		e := &ErrorResponse{}
+		if err := decoder.Decode(e); err != nil {
+			return nil, &HTTPError{
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
+		return nil, e
+	}
+	if v == nil {
+		return rl, nil
+	}
+	if err := decoder.Decode(v); err != nil {
+		return nil, &ResponseDecodeError{
+			Name:      name,
+			Err:       err,
+			RateLimit: rl,
+		}
+	}
+	return rl, nil
+}
@@ -1,8 +1,126 @@
 package twitter
 
-import "net/http"
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
 
 // Authorizer will add the authorization to the HTTP request
 type Authorizer interface {
 	Add(req *http.Request)
 }
+
+// RefreshAuthorizer is an authorizer that can refresh its credentials, like an OAuth 2.0 user token.  When a request
+// is unauthorized, the client will call refresh with the request and, if successful, send the request again once.
+type RefreshAuthorizer interface {
+	Authorizer
+	Refresh(ctx context.Context, req *http.Request) error
+}
+
+// RotatingAuthorizer is an authorizer with more than one credential, like a token pool.  The client reports the rate
+// limit of each response and, when a request is rate limited, asks the authorizer to rotate to another credential.  If
+// it can, the request is sent again.
+type RotatingAuthorizer interface {
+	Authorizer
+	ObserveRateLimit(req *http.Request, statusCode int, rl *RateLimit)
+	Rotate(req *http.Request) bool
+}
+
+type authorizerKey struct{}
+
+// WithAuthorizer returns a context that will use the authorizer, instead of the client's authorizer, for the requests
+// sent with it.  This allows one client to send app and user context requests.
+func WithAuthorizer(ctx context.Context, authorizer Authorizer) context.Context {
+	return context.WithValue(ctx, authorizerKey{}, authorizer)
+}
+
+// authorizer returns the context's authorizer or the client's authorizer
+func (c *Client) authorizer(ctx context.Context) Authorizer {
+	if authorizer, ok := ctx.Value(authorizerKey{}).(Authorizer); ok && authorizer != nil {
+		return authorizer
+	}
+	return c.Authorizer
+}
+
+// authorize will add the authorization of the request's authorizer
+func (c *Client) authorize(req *http.Request) {
+	c.authorizer(req.Context()).Add(req)
+}
+
+// replayable returns true if the request body can be sent again
+func replayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// retryRequest will copy the request, with a new authorization, so it can be sent again
+func (c *Client) retryRequest(req *http.Request, authorizer Authorizer) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("retry body: %w", err)
+		}
+		retry.Body = body
+	}
+	retry.Header.Del("Authorization")
+	authorizer.Add(retry)
+	if err := c.Budget.reserve(retry); err != nil {
+		return nil, err
+	}
+	return retry, nil
+}
+
+// resend will send a copy of the request with a new authorization
+func (c *Client) resend(req *http.Request, authorizer Authorizer) (*http.Request, *http.Response, error) {
+	retry, err := c.retryRequest(req, authorizer)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := c.RateLimiter.wait(retry); err != nil {
+		return nil, nil, err
+	}
+	resp, err := c.send(retry)
+	return retry, resp, err
+}
+
+// retryUnauthorized will refresh the authorizer and send the request again.  If the authorizer can not refresh or the
+// request body can not be sent again, the unauthorized response is returned.
+func (c *Client) retryUnauthorized(req *http.Request, resp *http.Response) (*http.Response, error) {
+	ra, ok := c.authorizer(req.Context()).(RefreshAuthorizer)
+	if !ok || !replayable(req) {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	if err := ra.Refresh(req.Context(), req); err != nil {
+		return nil, fmt.Errorf("authorizer refresh: %w", err)
+	}
+	_, resp, err := c.resend(req, ra)
+	return resp, err
+}
+
+// retryRateLimited will send the request again with the authorizer's other credentials until one is not rate limited
+// or there are no more credentials to rotate to.
+func (c *Client) retryRateLimited(req *http.Request, resp *http.Response) (*http.Response, error) {
+	ra, ok := c.authorizer(req.Context()).(RotatingAuthorizer)
+	if !ok || !replayable(req) {
+		return resp, nil
+	}
+	for resp.StatusCode == http.StatusTooManyRequests && ra.Rotate(req) {
+		resp.Body.Close()
+		retry, retryResp, err := c.resend(req, ra)
+		if err != nil {
+			return nil, err
+		}
+		req, resp = retry, retryResp
+	}
+	return resp, nil
+}
+
+// observeRateLimit will report the response's rate limit to a rotating authorizer
+func (c *Client) observeRateLimit(req *http.Request, resp *http.Response) {
+	if ra, ok := c.authorizer(req.Context()).(RotatingAuthorizer); ok {
+		ra.ObserveRateLimit(req, resp.StatusCode, rateFromHeader(resp.Header))
+	}
+}
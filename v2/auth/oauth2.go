@@ -0,0 +1,332 @@
+// Package auth implements the OAuth 2.0 authorization code flow with PKCE for twitter user context.
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultAuthURL is twitter's authorization endpoint
+	DefaultAuthURL = "https://twitter.com/i/oauth2/authorize"
+	// DefaultTokenURL is twitter's token endpoint
+	DefaultTokenURL = "https://api.twitter.com/2/oauth2/token"
+
+	expiryDelta = 10 * time.Second
+)
+
+// Scope is an OAuth 2.0 scope
+type Scope string
+
+const (
+	// ScopeTweetRead is all the tweets you can view, including tweets from protected accounts
+	ScopeTweetRead Scope = "tweet.read"
+	// ScopeTweetWrite is tweet and retweet for you
+	ScopeTweetWrite Scope = "tweet.write"
+	// ScopeTweetModerate is hide and unhide replies to your tweets
+	ScopeTweetModerate Scope = "tweet.moderate.write"
+	// ScopeUsersRead is any account you can view, including protected accounts
+	ScopeUsersRead Scope = "users.read"
+	// ScopeFollowsRead is people who follow you and people who you follow
+	ScopeFollowsRead Scope = "follows.read"
+	// ScopeFollowsWrite is follow and unfollow people for you
+	ScopeFollowsWrite Scope = "follows.write"
+	// ScopeOfflineAccess is stay connected to your account until you revoke access, a refresh token is returned
+	ScopeOfflineAccess Scope = "offline.access"
+	// ScopeSpaceRead is all the spaces you can view
+	ScopeSpaceRead Scope = "space.read"
+	// ScopeMuteRead is accounts you've muted
+	ScopeMuteRead Scope = "mute.read"
+	// ScopeMuteWrite is mute and unmute accounts for you
+	ScopeMuteWrite Scope = "mute.write"
+	// ScopeLikeRead is tweets you've liked and likes you can view
+	ScopeLikeRead Scope = "like.read"
+	// ScopeLikeWrite is like and unlike tweets for you
+	ScopeLikeWrite Scope = "like.write"
+	// ScopeListRead is lists, list members and list followers of lists you've created or are a member of
+	ScopeListRead Scope = "list.read"
+	// ScopeListWrite is create and manage lists for you
+	ScopeListWrite Scope = "list.write"
+	// ScopeBlockRead is accounts you've blocked
+	ScopeBlockRead Scope = "block.read"
+	// ScopeBlockWrite is block and unblock accounts for you
+	ScopeBlockWrite Scope = "block.write"
+	// ScopeBookmarkRead is get bookmarked tweets from an authenticated user
+	ScopeBookmarkRead Scope = "bookmark.read"
+	// ScopeBookmarkWrite is bookmark and remove bookmarks from tweets
+	ScopeBookmarkWrite Scope = "bookmark.write"
+	// ScopeDirectMessageRead is all your direct messages
+	ScopeDirectMessageRead Scope = "dm.read"
+	// ScopeDirectMessageWrite is send and manage direct messages for you
+	ScopeDirectMessageWrite Scope = "dm.write"
+)
+
+var (
+	// ErrStateMismatch is returned when the callback state does not match the authorization request
+	ErrStateMismatch = errors.New("oauth2 state does not match the authorization request")
+	// ErrNoRefreshToken is returned when refreshing a token without a refresh token, the offline.access scope is required
+	ErrNoRefreshToken = errors.New("oauth2 token does not have a refresh token")
+)
+
+// Config is the OAuth 2.0 application configuration.  The client secret is only needed for confidential clients.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []Scope
+	AuthURL      string
+	TokenURL     string
+	Client       *http.Client
+}
+
+// Token is an OAuth 2.0 user token
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Valid returns true if the token has an access token that has not expired
+func (t *Token) Valid() bool {
+	return t != nil && len(t.AccessToken) > 0 && !t.Expired()
+}
+
+// Expired returns true if the token has an expiry that has passed, a small delta is used so the token does not
+// expire during a request
+func (t *Token) Expired() bool {
+	if t.Expiry.IsZero() {
+		return false
+	}
+	return time.Now().Add(expiryDelta).After(t.Expiry)
+}
+
+// TokenError is the error response from the token endpoint
+type TokenError struct {
+	StatusCode  int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (t *TokenError) Error() string {
+	if len(t.Description) > 0 {
+		return fmt.Sprintf("oauth2 token error %d %s: %s", t.StatusCode, t.Code, t.Description)
+	}
+	return fmt.Sprintf("oauth2 token error %d %s", t.StatusCode, t.Code)
+}
+
+// AuthorizationRequest is a started authorization.  The state and code verifier need to be kept, like in the user's
+// session, until the callback so the code can be exchanged.
+type AuthorizationRequest struct {
+	URL          string
+	State        string
+	CodeVerifier string
+}
+
+// AuthCodeURL will start an authorization, the user should be redirected to the request's url.  A random state and
+// code verifier are created and the S256 code challenge is sent.
+func (c *Config) AuthCodeURL() (*AuthorizationRequest, error) {
+	state, err := randomString(32)
+	if err != nil {
+		return nil, fmt.Errorf("auth code url state: %w", err)
+	}
+	verifier, err := randomString(64)
+	if err != nil {
+		return nil, fmt.Errorf("auth code url code verifier: %w", err)
+	}
+
+	authURL := c.AuthURL
+	if len(authURL) == 0 {
+		authURL = DefaultAuthURL
+	}
+	u, err := url.Parse(authURL)
+	if err != nil {
+		return nil, fmt.Errorf("auth code url: %w", err)
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", c.ClientID)
+	q.Set("redirect_uri", c.RedirectURL)
+	q.Set("scope", c.scope())
+	q.Set("state", state)
+	q.Set("code_challenge", codeChallenge(verifier))
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+
+	return &AuthorizationRequest{
+		URL:          u.String(),
+		State:        state,
+		CodeVerifier: verifier,
+	}, nil
+}
+
+// ExchangeCallback will verify the callback's state and exchange the code for a token.  The callback is the url that
+// twitter redirected the user to.
+func (c *Config) ExchangeCallback(ctx context.Context, ar *AuthorizationRequest, callback *url.URL) (*Token, error) {
+	q := callback.Query()
+	if e := q.Get("error"); len(e) > 0 {
+		return nil, fmt.Errorf("oauth2 callback: %w", &TokenError{
+			Code:        e,
+			Description: q.Get("error_description"),
+		})
+	}
+	return c.Exchange(ctx, ar, q.Get("state"), q.Get("code"))
+}
+
+// Exchange will verify the state and exchange the code for a token
+func (c *Config) Exchange(ctx context.Context, ar *AuthorizationRequest, state, code string) (*Token, error) {
+	switch {
+	case ar == nil || len(ar.State) == 0 || state != ar.State:
+		return nil, ErrStateMismatch
+	case len(code) == 0:
+		return nil, errors.New("oauth2 exchange: a code is required")
+	default:
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", c.RedirectURL)
+	form.Set("code_verifier", ar.CodeVerifier)
+	return c.token(ctx, form, "")
+}
+
+// Refresh will use the refresh token to get a new token.  Twitter rotates the refresh token, so the returned token
+// needs to be saved and the old refresh token can not be used again.
+func (c *Config) Refresh(ctx context.Context, token *Token) (*Token, error) {
+	if token == nil || len(token.RefreshToken) == 0 {
+		return nil, ErrNoRefreshToken
+	}
+	form := url.Values{}
+	form.Set("grant_type", "refresh_token")
+	form.Set("refresh_token", token.RefreshToken)
+	return c.token(ctx, form, token.RefreshToken)
+}
+
+// Revoke will revoke the access token
+func (c *Config) Revoke(ctx context.Context, token *Token) error {
+	form := url.Values{}
+	form.Set("token", token.AccessToken)
+	form.Set("token_type_hint", "access_token")
+	resp, err := c.post(ctx, strings.TrimSuffix(c.tokenURL(), "/token")+"/revoke", form)
+	if err != nil {
+		return fmt.Errorf("oauth2 revoke: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("oauth2 revoke: %w", tokenError(resp))
+	}
+	return nil
+}
+
+func (c *Config) token(ctx context.Context, form url.Values, refreshToken string) (*Token, error) {
+	resp, err := c.post(ctx, c.tokenURL(), form)
+	if err != nil {
+		return nil, fmt.Errorf("oauth2 token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oauth2 token: %w", tokenError(resp))
+	}
+
+	body := struct {
+		Token
+		ExpiresIn int64 `json:"expires_in"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("oauth2 token decode: %w", err)
+	}
+	if len(body.AccessToken) == 0 {
+		return nil, errors.New("oauth2 token: the response does not have an access token")
+	}
+	token := body.Token
+	if body.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(body.ExpiresIn) * time.Second)
+	}
+	if len(token.RefreshToken) == 0 {
+		token.RefreshToken = refreshToken
+	}
+	return &token, nil
+}
+
+func (c *Config) post(ctx context.Context, endpoint string, form url.Values) (*http.Response, error) {
+	if len(c.ClientSecret) == 0 {
+		form.Set("client_id", c.ClientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if len(c.ClientSecret) > 0 {
+		req.SetBasicAuth(url.QueryEscape(c.ClientID), url.QueryEscape(c.ClientSecret))
+	}
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return client.Do(req)
+}
+
+func (c *Config) tokenURL() string {
+	if len(c.TokenURL) > 0 {
+		return c.TokenURL
+	}
+	return DefaultTokenURL
+}
+
+func (c *Config) scope() string {
+	scopes := make([]string, len(c.Scopes))
+	for i, s := range c.Scopes {
+		scopes[i] = string(s)
+	}
+	return strings.Join(scopes, " ")
+}
+
+func tokenError(resp *http.Response) error {
+	e := &TokenError{}
+	body, _ := io.ReadAll(resp.Body)
+	if err := json.Unmarshal(body, e); err != nil || len(e.Code) == 0 {
+		e.Code = http.StatusText(resp.StatusCode)
+		e.Description = strings.TrimSpace(string(body))
+	}
+	e.StatusCode = resp.StatusCode
+	return e
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Authorizer will add the token as the bearer authorization, it can be used as the client's authorizer
+type Authorizer struct {
+	Token *Token
+}
+
+// Add will add the authorization header
+func (a *Authorizer) Add(req *http.Request) {
+	if a.Token == nil {
+		return
+	}
+	req.Header.Set("Authorization", "Bearer "+a.Token.AccessToken)
+}
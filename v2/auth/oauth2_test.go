@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestConfig_AuthCodeURL(t *testing.T) {
+	config := &Config{
+		ClientID:    "client",
+		RedirectURL: "https://www.example.com/callback",
+		Scopes:      []Scope{ScopeTweetRead, ScopeUsersRead, ScopeOfflineAccess},
+	}
+	ar, err := config.AuthCodeURL()
+	if err != nil {
+		t.Fatalf("Config.AuthCodeURL() error = %v", err)
+	}
+	u, err := url.Parse(ar.URL)
+	if err != nil {
+		t.Fatalf("Config.AuthCodeURL() url error = %v", err)
+	}
+	q := u.Query()
+	want := map[string]string{
+		"response_type":         "code",
+		"client_id":             "client",
+		"redirect_uri":          "https://www.example.com/callback",
+		"scope":                 "tweet.read users.read offline.access",
+		"state":                 ar.State,
+		"code_challenge":        codeChallenge(ar.CodeVerifier),
+		"code_challenge_method": "S256",
+	}
+	for k, v := range want {
+		if q.Get(k) != v {
+			t.Errorf("Config.AuthCodeURL() %s = %s, want %s", k, q.Get(k), v)
+		}
+	}
+	if l := len(ar.CodeVerifier); l < 43 || l > 128 {
+		t.Errorf("Config.AuthCodeURL() code verifier length = %d", l)
+	}
+	if got := codeChallenge("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"); got != "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM" {
+		t.Errorf("codeChallenge() = %s", got)
+	}
+}
+
+func TestConfig_Exchange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("the form is not correct %v", err)
+		}
+		if user, pass, ok := r.BasicAuth(); !ok || user != "client" || pass != "secret" {
+			t.Errorf("the basic auth is not correct %s %s", user, pass)
+		}
+		switch r.Form.Get("grant_type") {
+		case "authorization_code":
+			if r.Form.Get("code") != "code" || r.Form.Get("code_verifier") != "verifier" {
+				t.Errorf("the exchange form is not correct %v", r.Form)
+			}
+			fmt.Fprint(w, `{"token_type":"bearer","expires_in":7200,"access_token":"access","scope":"tweet.read offline.access","refresh_token":"refresh"}`)
+		case "refresh_token":
+			if r.Form.Get("refresh_token") != "refresh" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error":"invalid_request","error_description":"Value passed for the token was invalid."}`)
+				return
+			}
+			fmt.Fprint(w, `{"token_type":"bearer","expires_in":7200,"access_token":"access2","scope":"tweet.read offline.access","refresh_token":"refresh2"}`)
+		default:
+			t.Errorf("the grant type is not correct %v", r.Form)
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		TokenURL:     server.URL,
+	}
+	ar := &AuthorizationRequest{
+		State:        "state",
+		CodeVerifier: "verifier",
+	}
+	if _, err := config.Exchange(context.Background(), ar, "other", "code"); !errors.Is(err, ErrStateMismatch) {
+		t.Errorf("Config.Exchange() error = %v, want state mismatch", err)
+	}
+
+	callback, _ := url.Parse("https://www.example.com/callback?state=state&code=code")
+	token, err := config.ExchangeCallback(context.Background(), ar, callback)
+	if err != nil {
+		t.Fatalf("Config.ExchangeCallback() error = %v", err)
+	}
+	if !token.Valid() || token.AccessToken != "access" || token.RefreshToken != "refresh" {
+		t.Errorf("Config.ExchangeCallback() = %+v", token)
+	}
+
+	token, err = config.Refresh(context.Background(), token)
+	if err != nil {
+		t.Fatalf("Config.Refresh() error = %v", err)
+	}
+	if token.AccessToken != "access2" || token.RefreshToken != "refresh2" {
+		t.Errorf("Config.Refresh() = %+v", token)
+	}
+
+	_, err = config.Refresh(context.Background(), &Token{AccessToken: "old", RefreshToken: "rotated"})
+	var tokenErr *TokenError
+	if !errors.As(err, &tokenErr) || tokenErr.StatusCode != http.StatusBadRequest || tokenErr.Code != "invalid_request" {
+		t.Errorf("Config.Refresh() error = %v, want a token error", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "https://api.twitter.com/2/users/me", nil)
+	(&Authorizer{Token: token}).Add(req)
+	if got := req.Header.Get("Authorization"); got != "Bearer access2" {
+		t.Errorf("Authorizer.Add() = %s", got)
+	}
+}
@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ErrNoToken is returned when the token store does not have a token
+var ErrNoToken = errors.New("oauth2 token store does not have a token")
+
+// TokenStore will keep a user's token, like in a database or the user's session.  Twitter rotates the refresh
+// token, so the token needs to be saved each time it is refreshed.
+type TokenStore interface {
+	Get(ctx context.Context) (*Token, error)
+	Save(ctx context.Context, token *Token) error
+}
+
+// MemoryTokenStore is a token store that keeps the token in memory
+type MemoryTokenStore struct {
+	mutex sync.Mutex
+	token *Token
+}
+
+// Get will return the token
+func (m *MemoryTokenStore) Get(_ context.Context) (*Token, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.token == nil {
+		return nil, ErrNoToken
+	}
+	token := *m.token
+	return &token, nil
+}
+
+// Save will keep the token
+func (m *MemoryTokenStore) Save(_ context.Context, token *Token) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	t := *token
+	m.token = &t
+	return nil
+}
+
+// RefreshingAuthorizer will add the stored token to requests and refresh it when it has expired or a request is
+// unauthorized.  The refreshed token is saved before it is used, so the rotated refresh token is not lost.  Only one
+// refresh happens at a time, and a request that failed with an older token will use the newer token instead of
+// refreshing again.
+type RefreshingAuthorizer struct {
+	Config *Config
+	Store  TokenStore
+	mutex  sync.Mutex
+	token  *Token
+}
+
+// Add will add the authorization header.  If there is no token, or it can not be refreshed, the header is not added
+// and the request will be unauthorized.
+func (a *RefreshingAuthorizer) Add(req *http.Request) {
+	token, err := a.Token(req.Context())
+	if err != nil {
+		return
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+}
+
+// Token will return the current token, refreshing it if it has expired
+func (a *RefreshingAuthorizer) Token(ctx context.Context) (*Token, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.token == nil {
+		token, err := a.Store.Get(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("refreshing authorizer: %w", err)
+		}
+		a.token = token
+	}
+	if a.token == nil {
+		return nil, ErrNoToken
+	}
+	if a.token.Expired() && len(a.token.RefreshToken) > 0 {
+		if err := a.renew(ctx, a.token.AccessToken); err != nil {
+			return nil, err
+		}
+	}
+	return a.token, nil
+}
+
+// Refresh will refresh the token that was used by the unauthorized request
+func (a *RefreshingAuthorizer) Refresh(ctx context.Context, req *http.Request) error {
+	used := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.token != nil && len(used) > 0 && a.token.AccessToken != used {
+		return nil
+	}
+	return a.renew(ctx, used)
+}
+
+// renew will use the stored token if it is newer than the one used, otherwise the token is refreshed and saved
+func (a *RefreshingAuthorizer) renew(ctx context.Context, used string) error {
+	stored, err := a.Store.Get(ctx)
+	if err == nil && stored != nil {
+		a.token = stored
+		if stored.AccessToken != used && stored.Valid() {
+			return nil
+		}
+	}
+	if a.token == nil {
+		return fmt.Errorf("refreshing authorizer: %w", ErrNoToken)
+	}
+
+	token, err := a.Config.Refresh(ctx, a.token)
+	if err != nil {
+		return fmt.Errorf("refreshing authorizer: %w", err)
+	}
+	if err := a.Store.Save(ctx, token); err != nil {
+		a.token = token
+		return fmt.Errorf("refreshing authorizer save: %w", err)
+	}
+	a.token = token
+	return nil
+}
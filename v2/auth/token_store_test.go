@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+func TestRefreshingAuthorizer(t *testing.T) {
+	var refreshes int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("the form is not correct %v", err)
+		}
+		if r.Form.Get("refresh_token") != "refresh" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":"invalid_request","error_description":"Value passed for the token was invalid."}`)
+			return
+		}
+		atomic.AddInt32(&refreshes, 1)
+		fmt.Fprint(w, `{"token_type":"bearer","expires_in":7200,"access_token":"new","refresh_token":"rotated"}`)
+	}))
+	defer tokenServer.Close()
+
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer new" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"title":"Unauthorized","type":"about:blank","status":401,"detail":"Unauthorized"}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev"}}`)
+	}))
+	defer apiServer.Close()
+
+	store := &MemoryTokenStore{}
+	if err := store.Save(context.Background(), &Token{AccessToken: "old", RefreshToken: "refresh"}); err != nil {
+		t.Fatalf("MemoryTokenStore.Save() error = %v", err)
+	}
+	client := &twitter.Client{
+		Authorizer: &RefreshingAuthorizer{
+			Config: &Config{
+				ClientID: "client",
+				TokenURL: tokenServer.URL,
+			},
+			Store: store,
+		},
+		Client: http.DefaultClient,
+		Host:   apiServer.URL,
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.AuthUserLookup(context.Background(), twitter.UserLookupOpts{}); err != nil {
+				t.Errorf("Client.AuthUserLookup() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if refreshes != 1 {
+		t.Errorf("RefreshingAuthorizer refreshed %d times, want 1", refreshes)
+	}
+	saved, err := store.Get(context.Background())
+	if err != nil {
+		t.Fatalf("MemoryTokenStore.Get() error = %v", err)
+	}
+	if saved.AccessToken != "new" || saved.RefreshToken != "rotated" {
+		t.Errorf("RefreshingAuthorizer saved = %+v", saved)
+	}
+}
@@ -0,0 +1,106 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type mockRefreshAuth struct {
+	token     string
+	refreshes int
+}
+
+func (m *mockRefreshAuth) Add(req *http.Request) {
+	req.Header.Add("Authorization", "Bearer "+m.token)
+}
+
+func (m *mockRefreshAuth) Refresh(ctx context.Context, req *http.Request) error {
+	m.refreshes++
+	m.token = "refreshed"
+	return nil
+}
+
+func TestClient_retryUnauthorized(t *testing.T) {
+	auth := &mockRefreshAuth{
+		token: "expired",
+	}
+	client := &Client{
+		Authorizer: auth,
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if got := req.Header.Values("Authorization"); len(got) != 1 {
+				log.Panicf("the authorization header is not correct %v", got)
+			}
+			tweet := CreateTweetRequest{}
+			if err := json.NewDecoder(req.Body).Decode(&tweet); err != nil || tweet.Text != "hello" {
+				log.Panicf("the request body is not correct %v %v", tweet, err)
+			}
+			if req.Header.Get("Authorization") != "Bearer refreshed" {
+				return &http.Response{
+					StatusCode: http.StatusUnauthorized,
+					Body:       io.NopCloser(strings.NewReader(`{"title":"Unauthorized","type":"about:blank","status":401,"detail":"Unauthorized"}`)),
+				}
+			}
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"1","text":"hello"}}`)),
+			}
+		}),
+	}
+
+	resp, err := client.CreateTweet(context.Background(), CreateTweetRequest{Text: "hello"})
+	if err != nil {
+		t.Fatalf("Client.CreateTweet() error = %v", err)
+	}
+	if resp.Tweet.ID != "1" || auth.refreshes != 1 {
+		t.Errorf("Client.CreateTweet() = %v with %d refreshes", resp.Tweet, auth.refreshes)
+	}
+}
+
+func TestClient_WithAuthorizer(t *testing.T) {
+	user := &mockRefreshAuth{
+		token: "expired",
+	}
+	client := &Client{
+		Authorizer: BearerToken("app"),
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			switch req.Header.Get("Authorization") {
+			case "Bearer app":
+				if req.URL.Path != "/2/tweets/search/recent" {
+					log.Panicf("the app token was used for %s", req.URL.Path)
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"data":[],"meta":{"result_count":0}}`)),
+				}
+			case "Bearer refreshed":
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev"}}`)),
+				}
+			default:
+				return &http.Response{
+					StatusCode: http.StatusUnauthorized,
+					Body:       io.NopCloser(strings.NewReader(`{"title":"Unauthorized","type":"about:blank","status":401,"detail":"Unauthorized"}`)),
+				}
+			}
+		}),
+	}
+
+	if _, err := client.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{}); err != nil {
+		t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+	}
+	resp, err := client.AuthUserLookup(WithAuthorizer(context.Background(), user), UserLookupOpts{})
+	if err != nil {
+		t.Fatalf("Client.AuthUserLookup() error = %v", err)
+	}
+	if len(resp.Raw.Users) != 1 || user.refreshes != 1 {
+		t.Errorf("Client.AuthUserLookup() = %v with %d refreshes, the override should be refreshed", resp.Raw.Users, user.refreshes)
+	}
+}
@@ -0,0 +1,176 @@
+package twitter
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrBudgetExceeded is returned when a request would go over the client's request budget
+var ErrBudgetExceeded = errors.New("twitter request budget exceeded")
+
+// BudgetCap is a client side limit on the number of requests.  The caps are independent of twitter's rate limits and
+// can be used to bound the spend on metered access.
+//
+// Endpoint is the path of the endpoint to cap, like 2/tweets/search/recent.  The {id} segment will match any value but
+// the literal segment of another endpoint, like the me of 2/users/me.  If empty, the cap is applied to all requests.
+// Method will limit the cap to one HTTP method, if empty all methods are counted.
+//
+// PerHour and PerDay are the max requests in the clock hour and the UTC day.  A zero value is no limit.
+type BudgetCap struct {
+	Endpoint string
+	Method   string
+	PerHour  int
+	PerDay   int
+}
+
+// BudgetUsage is the current usage of a cap
+type BudgetUsage struct {
+	Cap       BudgetCap
+	HourCount int
+	HourReset time.Time
+	DayCount  int
+	DayReset  time.Time
+}
+
+// BudgetExceededError has the cap that would be exceeded by the request
+type BudgetExceededError struct {
+	Cap    BudgetCap
+	Window string
+	Limit  int
+	Reset  time.Time
+}
+
+func (b *BudgetExceededError) Error() string {
+	name := b.Cap.Endpoint
+	if len(name) == 0 {
+		name = "all endpoints"
+	}
+	return fmt.Sprintf("%s: %s cap of %d requests for %s, resets at %s", ErrBudgetExceeded.Error(), b.Window, b.Limit, name, b.Reset.Format(time.RFC3339))
+}
+
+// Is will match ErrBudgetExceeded
+func (b *BudgetExceededError) Is(target error) bool {
+	return target == ErrBudgetExceeded
+}
+
+type budgetCounter struct {
+	hourStart time.Time
+	hourCount int
+	dayStart  time.Time
+	dayCount  int
+}
+
+func (b *budgetCounter) roll(now time.Time) {
+	if hour := now.Truncate(time.Hour); !hour.Equal(b.hourStart) {
+		b.hourStart = hour
+		b.hourCount = 0
+	}
+	if day := now.UTC().Truncate(24 * time.Hour); !day.Equal(b.dayStart) {
+		b.dayStart = day
+		b.dayCount = 0
+	}
+}
+
+// RequestBudget will enforce the caps on the client's requests.  A request is counted when it is sent, even if the
+// callout fails.  A request that would exceed any of the matching caps is not sent and a *BudgetExceededError is returned.
+type RequestBudget struct {
+	Caps     []BudgetCap
+	mutex    sync.Mutex
+	counters map[int]*budgetCounter
+	now      func() time.Time
+}
+
+func (b *RequestBudget) clock() time.Time {
+	if b.now != nil {
+		return b.now()
+	}
+	return time.Now()
+}
+
+func (b *RequestBudget) matches(bc BudgetCap, req *http.Request) bool {
+	if len(bc.Method) > 0 && !strings.EqualFold(bc.Method, req.Method) {
+		return false
+	}
+	if len(bc.Endpoint) == 0 {
+		return true
+	}
+	_, _, _, ok := matchEndpoint(pathSegments(bc.Endpoint), pathSegments(req.URL.Path))
+	return ok
+}
+
+// reserve will count the request against the matching caps, the request is not counted if any cap would be exceeded
+func (b *RequestBudget) reserve(req *http.Request) error {
+	if b == nil || len(b.Caps) == 0 || req.URL == nil {
+		return nil
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.counters == nil {
+		b.counters = map[int]*budgetCounter{}
+	}
+
+	now := b.clock()
+	matched := []*budgetCounter{}
+	for i, bc := range b.Caps {
+		if !b.matches(bc, req) {
+			continue
+		}
+		counter, has := b.counters[i]
+		if !has {
+			counter = &budgetCounter{}
+			b.counters[i] = counter
+		}
+		counter.roll(now)
+		switch {
+		case bc.PerHour > 0 && counter.hourCount >= bc.PerHour:
+			return &BudgetExceededError{
+				Cap:    bc,
+				Window: "hourly",
+				Limit:  bc.PerHour,
+				Reset:  counter.hourStart.Add(time.Hour),
+			}
+		case bc.PerDay > 0 && counter.dayCount >= bc.PerDay:
+			return &BudgetExceededError{
+				Cap:    bc,
+				Window: "daily",
+				Limit:  bc.PerDay,
+				Reset:  counter.dayStart.Add(24 * time.Hour),
+			}
+		default:
+		}
+		matched = append(matched, counter)
+	}
+	for _, counter := range matched {
+		counter.hourCount++
+		counter.dayCount++
+	}
+	return nil
+}
+
+// Usage returns the current usage of each cap
+func (b *RequestBudget) Usage() []BudgetUsage {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := b.clock()
+	usage := make([]BudgetUsage, len(b.Caps))
+	for i, bc := range b.Caps {
+		counter, has := b.counters[i]
+		if !has {
+			counter = &budgetCounter{}
+		}
+		counter.roll(now)
+		usage[i] = BudgetUsage{
+			Cap:       bc,
+			HourCount: counter.hourCount,
+			HourReset: counter.hourStart.Add(time.Hour),
+			DayCount:  counter.dayCount,
+			DayReset:  counter.dayStart.Add(24 * time.Hour),
+		}
+	}
+	return usage
+}
@@ -0,0 +1,110 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_Budget(t *testing.T) {
+	now := time.Date(2022, time.June, 1, 10, 30, 0, 0, time.UTC)
+	budget := &RequestBudget{
+		Caps: []BudgetCap{
+			{
+				PerDay: 4,
+			},
+			{
+				Endpoint: "2/tweets/search/recent",
+				PerHour:  2,
+			},
+		},
+		now: func() time.Time { return now },
+	}
+	sent := 0
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Budget:     budget,
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			sent++
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"data":[],"meta":{"result_count":0}}`)),
+			}
+		}),
+	}
+
+	search := func() error {
+		_, err := client.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{})
+		return err
+	}
+	for i := 0; i < 2; i++ {
+		if err := search(); err != nil {
+			t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+		}
+	}
+	err := search()
+	var budgetErr *BudgetExceededError
+	if !errors.Is(err, ErrBudgetExceeded) || !errors.As(err, &budgetErr) {
+		t.Fatalf("Client.TweetRecentSearch() error = %v, want the budget to be exceeded", err)
+	}
+	if budgetErr.Window != "hourly" || !budgetErr.Reset.Equal(time.Date(2022, time.June, 1, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("Client.TweetRecentSearch() budget error = %+v", budgetErr)
+	}
+	if sent != 2 {
+		t.Errorf("Client.TweetRecentSearch() sent %d requests, want 2", sent)
+	}
+
+	if _, err := client.UserMentionTimeline(context.Background(), "2244994945", UserMentionTimelineOpts{}); err != nil {
+		t.Fatalf("Client.UserMentionTimeline() error = %v", err)
+	}
+
+	now = now.Add(time.Hour)
+	if err := search(); err != nil {
+		t.Fatalf("Client.TweetRecentSearch() next hour error = %v", err)
+	}
+	if err := search(); !errors.As(err, &budgetErr) || budgetErr.Window != "daily" {
+		t.Fatalf("Client.TweetRecentSearch() error = %v, want the daily cap", err)
+	}
+
+	usage := budget.Usage()
+	if usage[0].DayCount != 4 || usage[1].HourCount != 1 {
+		t.Errorf("RequestBudget.Usage() = %+v", usage)
+	}
+}
+
+func TestClient_BudgetLiteralEndpoint(t *testing.T) {
+	budget := &RequestBudget{
+		Caps: []BudgetCap{
+			{
+				Endpoint: "2/users/{id}",
+				PerHour:  1,
+			},
+		},
+	}
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Budget:     budget,
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev"}}`)),
+			}
+		}),
+	}
+
+	// 2/users/me is its own endpoint and is not capped by 2/users/{id}
+	for i := 0; i < 2; i++ {
+		if _, err := client.AuthUserLookup(context.Background(), UserLookupOpts{}); err != nil {
+			t.Fatalf("Client.AuthUserLookup() error = %v", err)
+		}
+	}
+	if usage := budget.Usage(); usage[0].HourCount != 0 {
+		t.Errorf("RequestBudget.Usage() = %+v, want 2/users/me to not be counted", usage)
+	}
+}
@@ -0,0 +1,90 @@
+package twitter
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+const (
+	bufferPoolDefaultSize    = 32 * 1024
+	bufferPoolDefaultMaxSize = 1024 * 1024
+)
+
+var defaultBufferPool = &BufferPool{}
+
+// BufferPool is a pool of the buffers used to read a response body when it has to be read before it is decoded, like
+// recording the schema or the result count of a span.  A buffer is returned to the pool when the response body is
+// closed.  Buffers that have grown larger than MaxSize, which defaults to 1MB, are not kept so one large response does
+// not hold on to the memory.
+type BufferPool struct {
+	MaxSize int
+	pool    sync.Pool
+}
+
+func (b *BufferPool) get() *bytes.Buffer {
+	if buf, ok := b.pool.Get().(*bytes.Buffer); ok {
+		buf.Reset()
+		return buf
+	}
+	return bytes.NewBuffer(make([]byte, 0, bufferPoolDefaultSize))
+}
+
+func (b *BufferPool) put(buf *bytes.Buffer) {
+	maxSize := b.MaxSize
+	if maxSize <= 0 {
+		maxSize = bufferPoolDefaultMaxSize
+	}
+	if buf.Cap() > maxSize {
+		return
+	}
+	b.pool.Put(buf)
+}
+
+// peek will read the response body into a pooled buffer and replace the body so it can still be decoded.  The bytes
+// are only valid until the body is closed.
+func (b *BufferPool) peek(resp *http.Response) ([]byte, error) {
+	buf := b.get()
+	_, err := buf.ReadFrom(resp.Body)
+	resp.Body = &pooledBody{
+		Reader: io.MultiReader(bytes.NewReader(buf.Bytes()), resp.Body),
+		body:   resp.Body,
+		buf:    buf,
+		pool:   b,
+	}
+	return buf.Bytes(), err
+}
+
+type pooledBody struct {
+	io.Reader
+	body  io.Closer
+	mutex sync.Mutex
+	buf   *bytes.Buffer
+	pool  *BufferPool
+}
+
+// Close will close the body and return the buffer to the pool
+func (p *pooledBody) Close() error {
+	p.mutex.Lock()
+	if p.buf != nil {
+		p.pool.put(p.buf)
+		p.buf = nil
+		p.Reader = eofReader{}
+	}
+	p.mutex.Unlock()
+	return p.body.Close()
+}
+
+type eofReader struct{}
+
+func (eofReader) Read([]byte) (int, error) {
+	return 0, io.EOF
+}
+
+func (c *Client) bodyBuffers() *BufferPool {
+	if c.Buffers != nil {
+		return c.Buffers
+	}
+	return defaultBufferPool
+}
@@ -0,0 +1,45 @@
+package twitter
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type closeCounter struct {
+	io.Reader
+	closed int
+}
+
+func (c *closeCounter) Close() error {
+	c.closed++
+	return nil
+}
+
+func TestBufferPool_peek(t *testing.T) {
+	pool := &BufferPool{
+		MaxSize: 64,
+	}
+	for _, body := range []string{`{"meta":{"result_count":1}}`, strings.Repeat("a", 1024)} {
+		original := &closeCounter{Reader: strings.NewReader(body)}
+		resp := &http.Response{Body: original}
+
+		peeked, err := pool.peek(resp)
+		if err != nil || string(peeked) != body {
+			t.Fatalf("BufferPool.peek() = %s, %v", string(peeked), err)
+		}
+		decoded, err := io.ReadAll(resp.Body)
+		if err != nil || string(decoded) != body {
+			t.Errorf("BufferPool.peek() body = %s, %v", string(decoded), err)
+		}
+		resp.Body.Close()
+		resp.Body.Close()
+		if original.closed != 2 {
+			t.Errorf("BufferPool.peek() closed the body %d times", original.closed)
+		}
+		if n, err := resp.Body.Read(make([]byte, 8)); n != 0 || err != io.EOF {
+			t.Errorf("BufferPool.peek() read after close = %d, %v", n, err)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	circuitDefaultThreshold = 5
+	circuitDefaultCooldown  = 30 * time.Second
+)
+
+// ErrCircuitOpen is returned when the client's circuit breaker is open for the request's endpoint
+var ErrCircuitOpen = errors.New("twitter circuit breaker is open")
+
+// CircuitOpenError has the endpoint of a request that was not sent because the circuit is open
+type CircuitOpenError struct {
+	Endpoint string
+	Failures int
+	Until    time.Time
+}
+
+func (c *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%s: %s after %d failures, probe at %s", ErrCircuitOpen.Error(), c.Endpoint, c.Failures, c.Until.Format(time.RFC3339))
+}
+
+// Is will match ErrCircuitOpen
+func (c *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// CircuitBreaker will open an endpoint's circuit after consecutive server errors or network failures.  While the
+// circuit is open, requests to the endpoint fail fast with a *CircuitOpenError.  After the cooldown, one request is
+// sent as a probe, if it succeeds the circuit is closed, otherwise it is open for another cooldown.
+type CircuitBreaker struct {
+	// Threshold is the number of consecutive failures that opens the circuit, defaults to 5
+	Threshold int
+	// Cooldown is the time the circuit is open before a probe is sent, defaults to 30 seconds
+	Cooldown time.Duration
+	mutex    sync.Mutex
+	circuits map[string]*circuit
+	now      func() time.Time
+}
+
+type circuit struct {
+	failures int
+	until    time.Time
+	probing  bool
+}
+
+func (b *CircuitBreaker) clock() time.Time {
+	if b.now != nil {
+		return b.now()
+	}
+	return time.Now()
+}
+
+func (b *CircuitBreaker) threshold() int {
+	if b.Threshold <= 0 {
+		return circuitDefaultThreshold
+	}
+	return b.Threshold
+}
+
+// allow returns an error if the endpoint's circuit is open or another request is the probe
+func (b *CircuitBreaker) allow(req *http.Request) error {
+	if b == nil {
+		return nil
+	}
+	endpoint := schemaEndpoint(req)
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	c, has := b.circuits[endpoint]
+	if !has || c.failures < b.threshold() {
+		return nil
+	}
+	if c.probing || b.clock().Before(c.until) {
+		return &CircuitOpenError{
+			Endpoint: endpoint,
+			Failures: c.failures,
+			Until:    c.until,
+		}
+	}
+	c.probing = true
+	return nil
+}
+
+// record will count a failure, or close the circuit when the request succeeds
+func (b *CircuitBreaker) record(req *http.Request, resp *http.Response, err error) {
+	if b == nil {
+		return
+	}
+	failed := false
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		// the caller gave up, so the request tells nothing about the endpoint
+		b.release(req)
+		return
+	case err != nil, resp.StatusCode >= http.StatusInternalServerError:
+		failed = true
+	default:
+	}
+	endpoint := schemaEndpoint(req)
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if !failed {
+		delete(b.circuits, endpoint)
+		return
+	}
+	if b.circuits == nil {
+		b.circuits = map[string]*circuit{}
+	}
+	c, has := b.circuits[endpoint]
+	if !has {
+		c = &circuit{}
+		b.circuits[endpoint] = c
+	}
+	c.failures++
+	c.probing = false
+	if c.failures >= b.threshold() {
+		cooldown := b.Cooldown
+		if cooldown <= 0 {
+			cooldown = circuitDefaultCooldown
+		}
+		c.until = b.clock().Add(cooldown)
+	}
+}
+
+// release will allow another probe when a probe was canceled
+func (b *CircuitBreaker) release(req *http.Request) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if c, has := b.circuits[schemaEndpoint(req)]; has {
+		c.probing = false
+	}
+}
@@ -0,0 +1,106 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	now := time.Date(2022, time.February, 10, 12, 0, 0, 0, time.UTC)
+	breaker := &CircuitBreaker{
+		Threshold: 2,
+		Cooldown:  time.Minute,
+		now: func() time.Time {
+			return now
+		},
+	}
+	status := http.StatusServiceUnavailable
+	sent := 0
+	client := &Client{
+		Authorizer:     &mockAuth{},
+		Host:           "https://www.test.com",
+		CircuitBreaker: breaker,
+		Client: &http.Client{
+			Transport: errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+				sent++
+				if status == 0 {
+					return nil, syscall.ECONNRESET
+				}
+				body := `{"data":{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev"}}`
+				if status >= http.StatusBadRequest {
+					body = `{"title":"Error","detail":"Error","type":"about:blank","status":503}`
+				}
+				return &http.Response{
+					StatusCode: status,
+					Header:     http.Header{},
+					Body:       io.NopCloser(strings.NewReader(body)),
+				}, nil
+			}),
+		},
+	}
+	lookup := func() error {
+		_, err := client.AuthUserLookup(context.Background(), UserLookupOpts{})
+		return err
+	}
+
+	_ = lookup()
+	status = 0
+	_ = lookup()
+	status = http.StatusOK
+	err := lookup()
+	var circuitErr *CircuitOpenError
+	if !errors.Is(err, ErrCircuitOpen) || !errors.As(err, &circuitErr) || sent != 2 {
+		t.Fatalf("Client circuit should be open, error = %v sent %d", err, sent)
+	}
+	if circuitErr.Endpoint != "GET 2/users/me" || circuitErr.Failures != 2 || !circuitErr.Until.Equal(now.Add(time.Minute)) {
+		t.Errorf("Client circuit error = %+v", circuitErr)
+	}
+	if _, err := client.UserLookup(context.Background(), []string{"2244994945"}, UserLookupOpts{}); errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Client circuit should only be open for the failed endpoint")
+	}
+
+	now = now.Add(time.Minute)
+	status = http.StatusInternalServerError
+	if err := lookup(); errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Client circuit should send a probe after the cooldown")
+	}
+	if err := lookup(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("Client circuit should open again when the probe fails, error = %v", err)
+	}
+
+	now = now.Add(time.Minute)
+	status = http.StatusOK
+	if err := lookup(); err != nil {
+		t.Fatalf("Client circuit probe error = %v", err)
+	}
+	status = http.StatusServiceUnavailable
+	if err := lookup(); errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("Client circuit should be closed after a successful probe")
+	}
+}
+
+func TestCircuitBreaker_allow(t *testing.T) {
+	breaker := &CircuitBreaker{
+		Threshold: 1,
+		Cooldown:  time.Nanosecond,
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://api.twitter.com/2/users/me", nil)
+	breaker.record(req, &http.Response{StatusCode: http.StatusBadGateway}, nil)
+	time.Sleep(time.Millisecond)
+	if err := breaker.allow(req); err != nil {
+		t.Fatalf("CircuitBreaker.allow() probe error = %v", err)
+	}
+	if err := breaker.allow(req); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("CircuitBreaker.allow() should only allow one probe, error = %v", err)
+	}
+	breaker.record(req, nil, context.Canceled)
+	if err := breaker.allow(req); err != nil {
+		t.Errorf("CircuitBreaker.allow() should allow a probe after a canceled probe, error = %v", err)
+	}
+}
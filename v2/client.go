@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -18,13 +20,17 @@ const (
 	userMaxNames                                    = 100
 	tweetRecentSearchQueryLength                    = 512
 	tweetSearchQueryLength                          = 1024
+	tweetRecentSearchMinResults                     = 10
+	tweetRecentSearchMaxResults                     = 100
+	tweetSearchMinResults                           = 10
+	tweetSearchMaxResults                           = 500
 	tweetRecentCountsQueryLength                    = 512
 	tweetAllCountsQueryLength                       = 1024
 	userBlocksMaxResults                            = 1000
 	userMutesMaxResults                             = 1000
 	likesMaxResults                                 = 100
 	likesMinResults                                 = 10
-	sampleStreamMaxBackOffMin                       = 5
+	streamMaxBackfillMinutes                        = 5
 	userListMaxResults                              = 100
 	listTweetMaxResults                             = 100
 	userListMembershipMaxResults                    = 100
@@ -41,19 +47,176 @@ const (
 	userRetweetLookupMaxResults                     = 100
 	userTweetReverseChronologicalTimelineMinResults = 1
 	userTweetReverseChronologicalTimelineMaxResults = 100
+	dmEventsMaxResults                              = 100
+	dmMaxAttachments                                = 1
+	trendsMaxTrends                                 = 50
+	communitySearchMinResults                       = 10
+	notesMaxResults                                 = 100
+	communitySearchMaxResults                       = 100
 )
 
-// Client is used to make twitter v2 API callouts.
-//
-// # Authorizer is used to add auth to the request
-//
-// # Client is the HTTP client to use for all requests
-//
-// Host is the base URL to use like, https://api.twitter.com
+// Client is used to make twitter v2 API callouts.  The Authorizer, Client and Host are required, the other fields
+// are optional and apply to every request of the client.
 type Client struct {
+	// Authorizer is used to add auth to the request
 	Authorizer Authorizer
-	Client     *http.Client
-	Host       string
+	// Client is the HTTP client to use for all requests
+	Client *http.Client
+	// Host is the base URL to use like, https://api.twitter.com
+	Host string
+	// Shims are redirects for endpoints that have been retired or renamed
+	Shims []*EndpointShim
+	// Hosts will send the requests of an endpoint family, like search or streams, to a different host than Host
+	Hosts map[EndpointFamily]string
+	// Warnings is a channel to receive usage hints, like slow requests or operations that have used many pages.  The
+	// warnings are dropped if the channel is not ready.
+	Warnings chan<- *Warning
+	// SlowRequestThreshold is the request duration that will send a slow request warning
+	SlowRequestThreshold time.Duration
+	// PaginationCostThreshold is the number of requests an operation, see StartOperation, can send before a pagination
+	// cost warning
+	PaginationCostThreshold int
+	// Budget is a set of client side caps on the number of requests
+	Budget *RequestBudget
+	// Schema is a recorder of the response keys of each endpoint
+	Schema *SchemaRecorder
+	// RateLimiter will hold or fail the requests that would exceed the rate limits
+	RateLimiter *RateLimiter
+	// Retry is a policy to send failed requests again
+	Retry *RetryPolicy
+	// OnRateLimit is called when a request is rate limited or the remaining requests are below the RateLimitThreshold,
+	// the rate limit is nil if the rate limited response did not have the headers
+	OnRateLimit func(endpoint string, rl *RateLimit)
+	// RateLimitThreshold is the number of remaining requests below which OnRateLimit is called
+	RateLimitThreshold int
+	// CircuitBreaker will fail fast the requests to an endpoint that keeps failing
+	CircuitBreaker *CircuitBreaker
+	// Cache is a cache of the responses with an ETag or Last-Modified header, which are sent as conditional requests
+	Cache *ResponseCache
+	// Logger receives the method, URL, status code, duration and rate limit of every request
+	Logger Logger
+	// Tracer will start a span for each API call, see the otel module for OpenTelemetry
+	Tracer Tracer
+	// Buffers is the pool of buffers used when a response body has to be read before it is decoded, it defaults to a
+	// pool shared by the clients
+	Buffers *BufferPool
+	// Strict will fail the decoding of a response that has fields which are not in the response struct, the error has
+	// all of the unknown fields
+	Strict bool
+	// ClampMaxResults will move a search's max results that is out of range to the nearest limit, instead of returning
+	// a parameter error
+	ClampMaxResults bool
+	// StreamRuleLint will lint the filtered stream rules before they are added, the unknown operators are sent to the
+	// warning channel
+	StreamRuleLint bool
+	// StreamRuleAccess is the app's access level, the lint checks its length and operators
+	StreamRuleAccess StreamRuleAccess
+	// SearchProvider is the source of the SearchTweets results, it defaults to the recent search
+	SearchProvider TweetSearchProvider
+	// Debug will add a curl command of the request and the response's status and headers to the Logger's request logs,
+	// so a request can be reproduced outside of the client.  WithDebug will do the same for the requests of a context.
+	Debug bool
+	// TweetCap will count the posts read against the project's monthly cap, warning and stopping the reads at its
+	// thresholds.  SeedTweetCap will set its count from the usage API.
+	TweetCap *TweetCap
+	// MaxResponseSize is the largest response body, in bytes, that is read.  A larger response fails with an error that
+	// matches ErrResponseTooLarge, so a misbehaving proxy or an unexpected payload can not use all of the memory.  It
+	// defaults to 32MB, a negative size is no limit, and the streams are not limited.
+	MaxResponseSize int64
+	rateLimits      rateLimitSnapshot
+}
+
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	var span Span
+	if c.Tracer != nil {
+		var ctx context.Context
+		ctx, span = c.Tracer.StartSpan(req.Context(), schemaEndpoint(req), req)
+		req = req.WithContext(ctx)
+	}
+	resp, err := c.call(req)
+	captureRawJSON(req, resp)
+	if span != nil {
+		span.End(c.spanResult(req, resp, err))
+	}
+	return resp, err
+}
+
+func (c *Client) call(req *http.Request) (*http.Response, error) {
+	if err := c.Budget.reserve(req); err != nil {
+		return nil, err
+	}
+	if err := c.checkTweetCap(req); err != nil {
+		return nil, err
+	}
+	c.applyShims(req)
+	c.applyHosts(req)
+	c.Cache.conditional(req)
+	resp, err := c.attempts(req)
+	if err != nil {
+		return nil, err
+	}
+	c.countTweetCap(req, resp)
+	return c.Cache.response(req, resp)
+}
+
+func (c *Client) attempts(req *http.Request) (*http.Response, error) {
+	resp, err := c.attempt(req)
+	for attempt := 1; ; attempt++ {
+		delay, retry := c.Retry.next(req, attempt, resp, err)
+		if !retry {
+			return resp, err
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+		if req, err = c.retryRequest(req, c.authorizer(req.Context())); err != nil {
+			return nil, err
+		}
+		resp, err = c.attempt(req)
+	}
+}
+
+func (c *Client) attempt(req *http.Request) (*http.Response, error) {
+	if err := c.RateLimiter.wait(req); err != nil {
+		return nil, err
+	}
+	resp, err := c.send(req)
+	switch {
+	case err != nil:
+		return nil, err
+	case resp.StatusCode == http.StatusUnauthorized:
+		return c.retryUnauthorized(req, resp)
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return c.retryRateLimited(req, resp)
+	default:
+		return resp, nil
+	}
+}
+
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if err := c.CircuitBreaker.allow(req); err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	resp, err := c.Client.Do(req)
+	d := time.Since(start)
+	if err == nil {
+		c.limitResponse(req, resp)
+	}
+	c.CircuitBreaker.record(req, resp, err)
+	c.observeRequest(req, d)
+	c.logRequest(req, resp, d, err)
+	if err == nil {
+		c.rateLimits.record(req, resp)
+		c.notifyRateLimit(req, resp)
+		c.RateLimiter.observe(req, resp)
+		c.observeRateLimit(req, resp)
+		c.recordSchema(req, resp)
+	}
+	return resp, err
 }
 
 // CreateTweet will let a user post polls, quote tweets, tweet with reply setting, tweet with geo, attach
@@ -74,9 +237,9 @@ func (c *Client) CreateTweet(ctx context.Context, tweet CreateTweetRequest) (*Cr
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create tweet response: %w", err)
 	}
@@ -90,19 +253,21 @@ func (c *Client) CreateTweet(ctx context.Context, tweet CreateTweetRequest) (*Cr
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &CreateTweetResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "create tweet",
 			Err:       err,
@@ -113,6 +278,14 @@ func (c *Client) CreateTweet(ctx context.Context, tweet CreateTweetRequest) (*Cr
 	return raw, nil
 }
 
+// QuoteTweet will post the text as a quote of the target tweet
+func (c *Client) QuoteTweet(ctx context.Context, targetID, text string, opts QuoteTweetOpts) (*CreateTweetResponse, error) {
+	if len(targetID) == 0 {
+		return nil, fmt.Errorf("quote tweet: a tweet id is required: %w", ErrParameter)
+	}
+	return c.CreateTweet(ctx, opts.request(targetID, text))
+}
+
 func (c *Client) CreateTweetAsync(ctx context.Context, tweet CreateTweetRequest) (*CreateTweetAsyncResponse, error) {
 	if err := tweet.validate(); err != nil {
 		return nil, err
@@ -129,16 +302,16 @@ func (c *Client) CreateTweetAsync(ctx context.Context, tweet CreateTweetRequest)
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create tweet response: %w", err)
 	}
 	defer resp.Body.Close()
 
 	raw := &CreateTweetAsyncResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(raw); err != nil {
+	if err := c.decode(json.NewDecoder(resp.Body), raw); err != nil {
 		return nil, err
 	}
 
@@ -154,19 +327,21 @@ func (c *Client) ParseCreateTweetAsyncResponse(resp *http.Response) (*CreateTwee
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &CreateTweetResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "create tweet",
 			Err:       err,
@@ -189,9 +364,9 @@ func (c *Client) DeleteTweet(ctx context.Context, id string) (*DeleteTweetRespon
 		return nil, fmt.Errorf("delete tweet request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("delete tweet response: %w", err)
 	}
@@ -205,19 +380,21 @@ func (c *Client) DeleteTweet(ctx context.Context, id string) (*DeleteTweetRespon
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &DeleteTweetResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "delete tweet",
 			Err:       err,
@@ -246,7 +423,7 @@ func (c *Client) TweetLookup(ctx context.Context, ids []string, opts TweetLookup
 		return nil, fmt.Errorf("tweet lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	if len(ids) > 1 {
 		q := req.URL.Query()
@@ -254,7 +431,7 @@ func (c *Client) TweetLookup(ctx context.Context, ids []string, opts TweetLookup
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet lookup response: %w", err)
 	}
@@ -268,14 +445,16 @@ func (c *Client) TweetLookup(ctx context.Context, ids []string, opts TweetLookup
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -283,7 +462,7 @@ func (c *Client) TweetLookup(ctx context.Context, ids []string, opts TweetLookup
 	switch {
 	case len(ids) == 1:
 		single := &tweetraw{}
-		if err := decoder.Decode(single); err != nil {
+		if err := c.decode(decoder, single); err != nil {
 			return nil, &ResponseDecodeError{
 				Name:      "tweet lookup",
 				Err:       err,
@@ -295,7 +474,7 @@ func (c *Client) TweetLookup(ctx context.Context, ids []string, opts TweetLookup
 		raw.Includes = single.Includes
 		raw.Errors = single.Errors
 	default:
-		if err := decoder.Decode(raw); err != nil {
+		if err := c.decode(decoder, raw); err != nil {
 			return nil, &ResponseDecodeError{
 				Name:      "tweet lookup ",
 				Err:       err,
@@ -326,7 +505,7 @@ func (c *Client) TweetLookupAsync(ctx context.Context, ids []string, opts TweetL
 		return nil, fmt.Errorf("tweet lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	if len(ids) > 1 {
 		q := req.URL.Query()
@@ -334,14 +513,14 @@ func (c *Client) TweetLookupAsync(ctx context.Context, ids []string, opts TweetL
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet lookup response: %w", err)
 	}
 	defer resp.Body.Close()
 
 	raw := &TweetLookupAsyncResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(raw); err != nil {
+	if err := c.decode(json.NewDecoder(resp.Body), raw); err != nil {
 		return nil, err
 	}
 
@@ -357,14 +536,16 @@ func (c *Client) ParseTweetLookupAsyncResponse(ids []string, resp *http.Response
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -372,7 +553,7 @@ func (c *Client) ParseTweetLookupAsyncResponse(ids []string, resp *http.Response
 	switch {
 	case len(ids) == 1:
 		single := &tweetraw{}
-		if err := decoder.Decode(single); err != nil {
+		if err := c.decode(decoder, single); err != nil {
 			return nil, &ResponseDecodeError{
 				Name:      "tweet lookup",
 				Err:       err,
@@ -384,7 +565,7 @@ func (c *Client) ParseTweetLookupAsyncResponse(ids []string, resp *http.Response
 		raw.Includes = single.Includes
 		raw.Errors = single.Errors
 	default:
-		if err := decoder.Decode(raw); err != nil {
+		if err := c.decode(decoder, raw); err != nil {
 			return nil, &ResponseDecodeError{
 				Name:      "tweet lookup ",
 				Err:       err,
@@ -416,7 +597,7 @@ func (c *Client) UserLookup(ctx context.Context, ids []string, opts UserLookupOp
 		return nil, fmt.Errorf("user lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	if len(ids) > 1 {
 		q := req.URL.Query()
@@ -424,7 +605,7 @@ func (c *Client) UserLookup(ctx context.Context, ids []string, opts UserLookupOp
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user lookup response: %w", err)
 	}
@@ -438,14 +619,16 @@ func (c *Client) UserLookup(ctx context.Context, ids []string, opts UserLookupOp
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -453,7 +636,7 @@ func (c *Client) UserLookup(ctx context.Context, ids []string, opts UserLookupOp
 	switch {
 	case len(ids) == 1:
 		single := &userraw{}
-		if err := decoder.Decode(single); err != nil {
+		if err := c.decode(decoder, single); err != nil {
 			return nil, &ResponseDecodeError{
 				Name:      "user lookup",
 				Err:       err,
@@ -465,7 +648,7 @@ func (c *Client) UserLookup(ctx context.Context, ids []string, opts UserLookupOp
 		raw.Includes = single.Includes
 		raw.Errors = single.Errors
 	default:
-		if err := decoder.Decode(raw); err != nil {
+		if err := c.decode(decoder, raw); err != nil {
 			return nil, &ResponseDecodeError{
 				Name:      "user lookup",
 				Err:       err,
@@ -496,10 +679,10 @@ func (c *Client) UserRetweetLookup(ctx context.Context, tweetID string, opts Use
 		return nil, fmt.Errorf("user retweet lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user retweet lookup response: %w", err)
 	}
@@ -513,14 +696,16 @@ func (c *Client) UserRetweetLookup(ctx context.Context, tweetID string, opts Use
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -528,7 +713,7 @@ func (c *Client) UserRetweetLookup(ctx context.Context, tweetID string, opts Use
 		*UserRetweetRaw
 		Meta *UserRetweetMeta `json:"meta"`
 	}{}
-	if err := decoder.Decode(&raw); err != nil {
+	if err := c.decode(decoder, &raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user retweet lookup",
 			Err:       err,
@@ -560,7 +745,7 @@ func (c *Client) UserNameLookup(ctx context.Context, usernames []string, opts Us
 		return nil, fmt.Errorf("username lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	if len(usernames) > 1 {
 		q := req.URL.Query()
@@ -568,7 +753,7 @@ func (c *Client) UserNameLookup(ctx context.Context, usernames []string, opts Us
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("username lookup response: %w", err)
 	}
@@ -582,14 +767,16 @@ func (c *Client) UserNameLookup(ctx context.Context, usernames []string, opts Us
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -597,7 +784,7 @@ func (c *Client) UserNameLookup(ctx context.Context, usernames []string, opts Us
 	switch {
 	case len(usernames) == 1:
 		single := &userraw{}
-		if err := decoder.Decode(single); err != nil {
+		if err := c.decode(decoder, single); err != nil {
 			return nil, &ResponseDecodeError{
 				Name:      "username lookup",
 				Err:       err,
@@ -609,7 +796,7 @@ func (c *Client) UserNameLookup(ctx context.Context, usernames []string, opts Us
 		raw.Includes = single.Includes
 		raw.Errors = single.Errors
 	default:
-		if err := decoder.Decode(raw); err != nil {
+		if err := c.decode(decoder, raw); err != nil {
 			return nil, &ResponseDecodeError{
 				Name:      "username lookup",
 				Err:       err,
@@ -640,7 +827,7 @@ func (c *Client) UserNameLookupAsync(ctx context.Context, usernames []string, op
 		return nil, fmt.Errorf("username lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	if len(usernames) > 1 {
 		q := req.URL.Query()
@@ -648,14 +835,14 @@ func (c *Client) UserNameLookupAsync(ctx context.Context, usernames []string, op
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("username lookup response: %w", err)
 	}
 	defer resp.Body.Close()
 
 	raw := new(UserNameLookupAsyncResponse)
-	if err := json.NewDecoder(resp.Body).Decode(raw); err != nil {
+	if err := c.decode(json.NewDecoder(resp.Body), raw); err != nil {
 		return nil, err
 	}
 
@@ -671,14 +858,16 @@ func (c *Client) ParseUserNameLookupAsyncResponse(usernames []string, resp *http
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -686,7 +875,7 @@ func (c *Client) ParseUserNameLookupAsyncResponse(usernames []string, resp *http
 	switch {
 	case len(usernames) == 1:
 		single := &userraw{}
-		if err := decoder.Decode(single); err != nil {
+		if err := c.decode(decoder, single); err != nil {
 			return nil, &ResponseDecodeError{
 				Name:      "username lookup",
 				Err:       err,
@@ -698,7 +887,7 @@ func (c *Client) ParseUserNameLookupAsyncResponse(usernames []string, resp *http
 		raw.Includes = single.Includes
 		raw.Errors = single.Errors
 	default:
-		if err := decoder.Decode(raw); err != nil {
+		if err := c.decode(decoder, raw); err != nil {
 			return nil, &ResponseDecodeError{
 				Name:      "username lookup",
 				Err:       err,
@@ -721,10 +910,10 @@ func (c *Client) AuthUserLookup(ctx context.Context, opts UserLookupOpts) (*User
 		return nil, fmt.Errorf("auth user lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("auth user lookup response: %w", err)
 	}
@@ -738,19 +927,21 @@ func (c *Client) AuthUserLookup(ctx context.Context, opts UserLookupOpts) (*User
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	single := &userraw{}
-	if err := decoder.Decode(single); err != nil {
+	if err := c.decode(decoder, single); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "auth user lookup",
 			Err:       err,
@@ -769,8 +960,7 @@ func (c *Client) AuthUserLookup(ctx context.Context, opts UserLookupOpts) (*User
 	}, nil
 }
 
-// TweetRecentSearch will return a recent search based of a query
-func (c *Client) TweetRecentSearch(ctx context.Context, query string, opts TweetRecentSearchOpts) (*TweetRecentSearchResponse, error) {
+func (c *Client) tweetRecentSearchRequest(ctx context.Context, query string, opts TweetRecentSearchOpts) (*http.Request, error) {
 	switch {
 	case len(query) == 0:
 		return nil, fmt.Errorf("tweet recent search: a query is required: %w", ErrParameter)
@@ -778,43 +968,72 @@ func (c *Client) TweetRecentSearch(ctx context.Context, query string, opts Tweet
 		return nil, fmt.Errorf("tweet recent search: the query over the length (%d): %w", tweetRecentSearchQueryLength, ErrParameter)
 	default:
 	}
+	maxResults, err := c.maxResults("tweet recent search", opts.MaxResults, tweetRecentSearchMinResults, tweetRecentSearchMaxResults)
+	if err != nil {
+		return nil, err
+	}
+	opts.MaxResults = maxResults
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tweetRecentSearchEndpoint.url(c.Host), nil)
 	if err != nil {
 		return nil, fmt.Errorf("tweet recent search request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	q := req.URL.Query()
 	q.Add("query", query)
 	req.URL.RawQuery = q.Encode()
+	return req, nil
+}
 
-	resp, err := c.Client.Do(req)
+// maxResults will validate the max results, or clamp it to the limits when the client clamps.  Zero is not set.
+func (c *Client) maxResults(name string, maxResults, min, max int) (int, error) {
+	switch {
+	case maxResults == 0:
+		return maxResults, nil
+	case maxResults < min && c.ClampMaxResults:
+		return min, nil
+	case maxResults > max && c.ClampMaxResults:
+		return max, nil
+	case maxResults < min || maxResults > max:
+		return 0, fmt.Errorf("%s: max results [%d] must be between [%d] and [%d]: %w", name, maxResults, min, max, ErrParameter)
+	default:
+		return maxResults, nil
+	}
+}
+
+// TweetRecentSearch will return a recent search based of a query
+func (c *Client) TweetRecentSearch(ctx context.Context, query string, opts TweetRecentSearchOpts) (*TweetRecentSearchResponse, error) {
+	req, err := c.tweetRecentSearchRequest(ctx, query, opts)
 	if err != nil {
-		return nil, fmt.Errorf("tweet recent search response: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	respBytes, err := io.ReadAll(resp.Body)
+	resp, err := c.do(req)
 	if err != nil {
-		return nil, fmt.Errorf("tweet recent search response read: %w", err)
+		return nil, fmt.Errorf("tweet recent search response: %w", err)
 	}
+	defer resp.Body.Close()
+
+	decoder := json.NewDecoder(resp.Body)
 
 	rl := rateFromHeader(resp.Header)
 
 	if resp.StatusCode != http.StatusOK {
 		e := &ErrorResponse{}
-		if err := json.Unmarshal(respBytes, e); err != nil {
+		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -824,15 +1043,14 @@ func (c *Client) TweetRecentSearch(ctx context.Context, query string, opts Tweet
 		RateLimit: rl,
 	}
 
-	if err := json.Unmarshal(respBytes, recentSearch.Raw); err != nil {
-		return nil, &ResponseDecodeError{
-			Name:      "tweet recent search",
-			Err:       err,
-			RateLimit: rl,
-		}
+	body := struct {
+		*TweetRaw
+		Meta *TweetRecentSearchMeta `json:"meta"`
+	}{
+		TweetRaw: recentSearch.Raw,
+		Meta:     recentSearch.Meta,
 	}
-
-	if err := json.Unmarshal(respBytes, recentSearch); err != nil {
+	if err := c.decode(decoder, &body); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet recent search",
 			Err:       err,
@@ -851,26 +1069,31 @@ func (c *Client) TweetRecentSearchAsync(ctx context.Context, query string, opts
 		return nil, fmt.Errorf("tweet recent search: the query over the length (%d): %w", tweetRecentSearchQueryLength, ErrParameter)
 	default:
 	}
+	maxResults, err := c.maxResults("tweet recent search", opts.MaxResults, tweetRecentSearchMinResults, tweetRecentSearchMaxResults)
+	if err != nil {
+		return nil, err
+	}
+	opts.MaxResults = maxResults
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tweetRecentSearchEndpoint.url(c.Host), nil)
 	if err != nil {
 		return nil, fmt.Errorf("tweet recent search request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	q := req.URL.Query()
 	q.Add("query", query)
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet recent search response: %w", err)
 	}
 	defer resp.Body.Close()
 
 	raw := &TweetRecentSearchAsyncResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(raw); err != nil {
+	if err := c.decode(json.NewDecoder(resp.Body), raw); err != nil {
 		return nil, err
 	}
 
@@ -878,25 +1101,24 @@ func (c *Client) TweetRecentSearchAsync(ctx context.Context, query string, opts
 }
 
 func (c *Client) ParseTweetRecentSearchAsyncResponse(resp *http.Response) (*TweetRecentSearchResponse, error) {
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("tweet recent search response read: %w", err)
-	}
+	decoder := json.NewDecoder(resp.Body)
 
 	rl := rateFromHeader(resp.Header)
 
 	if resp.StatusCode != http.StatusOK {
 		e := &ErrorResponse{}
-		if err := json.Unmarshal(respBytes, e); err != nil {
+		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -906,15 +1128,14 @@ func (c *Client) ParseTweetRecentSearchAsyncResponse(resp *http.Response) (*Twee
 		RateLimit: rl,
 	}
 
-	if err := json.Unmarshal(respBytes, recentSearch.Raw); err != nil {
-		return nil, &ResponseDecodeError{
-			Name:      "tweet recent search",
-			Err:       err,
-			RateLimit: rl,
-		}
+	body := struct {
+		*TweetRaw
+		Meta *TweetRecentSearchMeta `json:"meta"`
+	}{
+		TweetRaw: recentSearch.Raw,
+		Meta:     recentSearch.Meta,
 	}
-
-	if err := json.Unmarshal(respBytes, recentSearch); err != nil {
+	if err := c.decode(decoder, &body); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet recent search",
 			Err:       err,
@@ -925,10 +1146,7 @@ func (c *Client) ParseTweetRecentSearchAsyncResponse(resp *http.Response) (*Twee
 	return recentSearch, nil
 }
 
-// TweetSearch is a full-archive search endpoint returns the complete history of public Tweets matching a search query.
-//
-// This endpoint is only available to those users who have been approved for Academic Research access.
-func (c *Client) TweetSearch(ctx context.Context, query string, opts TweetSearchOpts) (*TweetSearchResponse, error) {
+func (c *Client) tweetSearchRequest(ctx context.Context, query string, opts TweetSearchOpts) (*http.Request, error) {
 	switch {
 	case len(query) == 0:
 		return nil, fmt.Errorf("tweet search: a query is required: %w", ErrParameter)
@@ -936,19 +1154,35 @@ func (c *Client) TweetSearch(ctx context.Context, query string, opts TweetSearch
 		return nil, fmt.Errorf("tweet search: the query over the length (%d): %w", tweetSearchQueryLength, ErrParameter)
 	default:
 	}
+	maxResults, err := c.maxResults("tweet search", opts.MaxResults, tweetSearchMinResults, tweetSearchMaxResults)
+	if err != nil {
+		return nil, err
+	}
+	opts.MaxResults = maxResults
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tweetSearchEndpoint.url(c.Host), nil)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	q := req.URL.Query()
 	q.Add("query", query)
 	req.URL.RawQuery = q.Encode()
+	return req, nil
+}
 
-	resp, err := c.Client.Do(req)
+// TweetSearch is a full-archive search endpoint returns the complete history of public Tweets matching a search query.
+//
+// This endpoint is only available to those users who have been approved for Academic Research access.
+func (c *Client) TweetSearch(ctx context.Context, query string, opts TweetSearchOpts) (*TweetSearchResponse, error) {
+	req, err := c.tweetSearchRequest(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search response: %w", err)
 	}
@@ -962,14 +1196,16 @@ func (c *Client) TweetSearch(ctx context.Context, query string, opts TweetSearch
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -978,7 +1214,7 @@ func (c *Client) TweetSearch(ctx context.Context, query string, opts TweetSearch
 		Meta *TweetSearchMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet search",
 			Err:       err,
@@ -1003,9 +1239,18 @@ func (c *Client) TweetSearchStreamAddRule(ctx context.Context, rules []TweetSear
 	}{
 		Add: tweetSearchStreamRules(rules),
 	}
-	if err := body.Add.validate(); err != nil {
+	warnings, err := body.Add.validate(c.StreamRuleLint, c.StreamRuleAccess)
+	if err != nil {
 		return nil, err
 	}
+	for _, finding := range warnings {
+		c.warn(&Warning{
+			Type:    WarningStreamRule,
+			Method:  http.MethodPost,
+			URL:     tweetSearchStreamRulesEndpoint.url(c.Host),
+			Message: finding.String(),
+		})
+	}
 	enc, err := json.Marshal(body)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search stream add rule body encoding %w", err)
@@ -1016,14 +1261,14 @@ func (c *Client) TweetSearchStreamAddRule(ctx context.Context, rules []TweetSear
 	}
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	if dryRun {
 		q := req.URL.Query()
 		q.Add("dry_run", "true")
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search stream add rule http response %w", err)
 	}
@@ -1037,19 +1282,21 @@ func (c *Client) TweetSearchStreamAddRule(ctx context.Context, rules []TweetSear
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	ruleResponse := &TweetSearchStreamAddRuleResponse{}
-	if err := decoder.Decode(ruleResponse); err != nil {
+	if err := c.decode(decoder, ruleResponse); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet search stream add rule",
 			Err:       err,
@@ -1090,14 +1337,14 @@ func (c *Client) TweetSearchStreamDeleteRuleByID(ctx context.Context, ruleIDs []
 	}
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	if dryRun {
 		q := req.URL.Query()
 		q.Add("dry_run", "true")
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search stream delete rule http response %w", err)
 	}
@@ -1111,19 +1358,21 @@ func (c *Client) TweetSearchStreamDeleteRuleByID(ctx context.Context, ruleIDs []
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	ruleResponse := &TweetSearchStreamDeleteRuleResponse{}
-	if err := decoder.Decode(ruleResponse); err != nil {
+	if err := c.decode(decoder, ruleResponse); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet search stream delete rule ny id",
 			Err:       err,
@@ -1160,14 +1409,14 @@ func (c *Client) TweetSearchStreamDeleteRuleByValue(ctx context.Context, ruleVal
 	}
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	if dryRun {
 		q := req.URL.Query()
 		q.Add("dry_run", "true")
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search stream delete rule http response %w", err)
 	}
@@ -1181,19 +1430,21 @@ func (c *Client) TweetSearchStreamDeleteRuleByValue(ctx context.Context, ruleVal
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	ruleResponse := &TweetSearchStreamDeleteRuleResponse{}
-	if err := decoder.Decode(ruleResponse); err != nil {
+	if err := c.decode(decoder, ruleResponse); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet search stream delete rule by value",
 			Err:       err,
@@ -1204,6 +1455,40 @@ func (c *Client) TweetSearchStreamDeleteRuleByValue(ctx context.Context, ruleVal
 	return ruleResponse, nil
 }
 
+// TweetSearchStreamDeleteRulesByTag will look up the current rules and delete the rules that have one of the tags.  If no
+// rule has the tags, nothing is deleted and the summary is empty.  Set dry run to true to validate the rules before commit
+func (c *Client) TweetSearchStreamDeleteRulesByTag(ctx context.Context, tags []string, dryRun bool) (*TweetSearchStreamDeleteRuleResponse, error) {
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("tweet search stream delete rules by tag: tags are required: %w", ErrParameter)
+	}
+	current, err := c.TweetSearchStreamRules(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("tweet search stream delete rules by tag: %w", err)
+	}
+
+	deleteTags := map[string]bool{}
+	for _, tag := range tags {
+		deleteTags[tag] = true
+	}
+	ruleIDs := []TweetSearchStreamRuleID{}
+	for _, rule := range current.Rules {
+		if rule != nil && deleteTags[rule.Tag] {
+			ruleIDs = append(ruleIDs, rule.ID)
+		}
+	}
+	if len(ruleIDs) == 0 {
+		return &TweetSearchStreamDeleteRuleResponse{
+			Meta:      &TweetSearchStreamRuleMeta{},
+			RateLimit: current.RateLimit,
+		}, nil
+	}
+	resp, err := c.TweetSearchStreamDeleteRuleByID(ctx, ruleIDs, dryRun)
+	if err != nil {
+		return nil, fmt.Errorf("tweet search stream delete rules by tag: %w", err)
+	}
+	return resp, nil
+}
+
 // TweetSearchStreamRules will return a list of rules active on the streaming endpoint
 func (c *Client) TweetSearchStreamRules(ctx context.Context, ruleIDs []TweetSearchStreamRuleID) (*TweetSearchStreamRulesResponse, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tweetSearchStreamRulesEndpoint.url(c.Host), nil)
@@ -1211,7 +1496,7 @@ func (c *Client) TweetSearchStreamRules(ctx context.Context, ruleIDs []TweetSear
 		return nil, fmt.Errorf("tweet search stream rules http request %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	if len(ruleIDs) > 0 {
 		ruleArr := tweetSearchStreamRuleIDs(ruleIDs)
 		if err := ruleArr.validate(); err != nil {
@@ -1222,7 +1507,7 @@ func (c *Client) TweetSearchStreamRules(ctx context.Context, ruleIDs []TweetSear
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search stream rules http response %w", err)
 	}
@@ -1236,19 +1521,21 @@ func (c *Client) TweetSearchStreamRules(ctx context.Context, ruleIDs []TweetSear
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	ruleResponse := &TweetSearchStreamRulesResponse{}
-	if err := decoder.Decode(ruleResponse); err != nil {
+	if err := c.decode(decoder, ruleResponse); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet search stream rules",
 			Err:       err,
@@ -1262,9 +1549,8 @@ func (c *Client) TweetSearchStreamRules(ctx context.Context, ruleIDs []TweetSear
 // TweetSearchStream will stream in real-time based on a specific set of filter rules
 func (c *Client) TweetSearchStream(ctx context.Context, opts TweetSearchStreamOpts) (*TweetStream, error) {
 	switch {
-	case opts.BackfillMinutes == 0:
-	case opts.BackfillMinutes > sampleStreamMaxBackOffMin:
-		return nil, fmt.Errorf("tweet search stream: a max back off minutes [%d] is [current: %d]: %w", sampleStreamMaxBackOffMin, opts.BackfillMinutes, ErrParameter)
+	case opts.BackfillMinutes < 0 || opts.BackfillMinutes > streamMaxBackfillMinutes:
+		return nil, fmt.Errorf("tweet search stream: backfill minutes [%d] must be between [0] and [%d]: %w", opts.BackfillMinutes, streamMaxBackfillMinutes, ErrParameter)
 	default:
 	}
 
@@ -1273,10 +1559,10 @@ func (c *Client) TweetSearchStream(ctx context.Context, opts TweetSearchStreamOp
 		return nil, fmt.Errorf("tweet search stream request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet search stream response: %w", err)
 	}
@@ -1288,18 +1574,25 @@ func (c *Client) TweetSearchStream(ctx context.Context, opts TweetSearchStreamOp
 		e := &ErrorResponse{}
 		if err := json.NewDecoder(resp.Body).Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
-	stream := StartTweetStream(resp.Body)
+	stream := StartTweetStreamWithOpts(resp.Body, TweetStreamOpts{
+		StallTimeout: opts.StallTimeout,
+		Buffer:       opts.Buffer,
+		Overflow:     opts.Overflow,
+		consumed:     c.tweetCapConsumer(req),
+	})
 	stream.RateLimit = rl
 	return stream, nil
 }
@@ -1319,13 +1612,13 @@ func (c *Client) TweetRecentCounts(ctx context.Context, query string, opts Tweet
 		return nil, fmt.Errorf("tweet recent counts request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	q := req.URL.Query()
 	q.Add("query", query)
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet recent counts response: %w", err)
 	}
@@ -1333,22 +1626,22 @@ func (c *Client) TweetRecentCounts(ctx context.Context, query string, opts Tweet
 
 	rl := rateFromHeader(resp.Header)
 
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("tweet recent counts response read: %w", err)
-	}
+	decoder := json.NewDecoder(resp.Body)
+
 	if resp.StatusCode != http.StatusOK {
 		e := &ErrorResponse{}
-		if err := json.Unmarshal(respBytes, e); err != nil {
+		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -1357,7 +1650,7 @@ func (c *Client) TweetRecentCounts(ctx context.Context, query string, opts Tweet
 		Meta:        &TweetRecentCountsMeta{},
 	}
 
-	if err := json.Unmarshal(respBytes, recentCounts); err != nil {
+	if err := c.decode(decoder, recentCounts); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet recent counts",
 			Err:       err,
@@ -1383,13 +1676,13 @@ func (c *Client) TweetAllCounts(ctx context.Context, query string, opts TweetAll
 		return nil, fmt.Errorf("tweet all counts request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	q := req.URL.Query()
 	q.Add("query", query)
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet all counts response: %w", err)
 	}
@@ -1403,14 +1696,16 @@ func (c *Client) TweetAllCounts(ctx context.Context, query string, opts TweetAll
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -1419,7 +1714,7 @@ func (c *Client) TweetAllCounts(ctx context.Context, query string, opts TweetAll
 		Meta:        &TweetAllCountsMeta{},
 	}
 
-	if err := decoder.Decode(allCounts); err != nil {
+	if err := c.decode(decoder, allCounts); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet all counts",
 			Err:       err,
@@ -1441,12 +1736,12 @@ func (c *Client) UserFollowingLookup(ctx context.Context, id string, opts UserFo
 		return nil, fmt.Errorf("user following lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	q := req.URL.Query()
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user following lookup response: %w", err)
 	}
@@ -1454,22 +1749,22 @@ func (c *Client) UserFollowingLookup(ctx context.Context, id string, opts UserFo
 
 	rl := rateFromHeader(resp.Header)
 
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("user following lookup response read: %w", err)
-	}
+	decoder := json.NewDecoder(resp.Body)
+
 	if resp.StatusCode != http.StatusOK {
 		e := &ErrorResponse{}
-		if err := json.Unmarshal(respBytes, e); err != nil {
+		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -1478,15 +1773,14 @@ func (c *Client) UserFollowingLookup(ctx context.Context, id string, opts UserFo
 		Meta: &UserFollowingMeta{},
 	}
 
-	if err := json.Unmarshal(respBytes, followingLookup.Raw); err != nil {
-		return nil, &ResponseDecodeError{
-			Name:      "user following lookup",
-			Err:       err,
-			RateLimit: rl,
-		}
+	body := struct {
+		*UserRaw
+		Meta *UserFollowingMeta `json:"meta"`
+	}{
+		UserRaw: followingLookup.Raw,
+		Meta:    followingLookup.Meta,
 	}
-
-	if err := json.Unmarshal(respBytes, followingLookup); err != nil {
+	if err := c.decode(decoder, &body); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user following lookup",
 			Err:       err,
@@ -1522,9 +1816,9 @@ func (c *Client) UserFollows(ctx context.Context, userID, targetUserID string) (
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user follows response: %w", err)
 	}
@@ -1538,19 +1832,21 @@ func (c *Client) UserFollows(ctx context.Context, userID, targetUserID string) (
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &UserFollowsResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user follows",
 			Err:       err,
@@ -1577,9 +1873,9 @@ func (c *Client) DeleteUserFollows(ctx context.Context, userID, targetUserID str
 		return nil, fmt.Errorf("user delete follows request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user delete follows response: %w", err)
 	}
@@ -1593,19 +1889,21 @@ func (c *Client) DeleteUserFollows(ctx context.Context, userID, targetUserID str
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &UserDeleteFollowsResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "delete user follows",
 			Err:       err,
@@ -1627,12 +1925,12 @@ func (c *Client) UserFollowersLookup(ctx context.Context, id string, opts UserFo
 		return nil, fmt.Errorf("user followers lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	q := req.URL.Query()
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user followers lookup response: %w", err)
 	}
@@ -1640,22 +1938,22 @@ func (c *Client) UserFollowersLookup(ctx context.Context, id string, opts UserFo
 
 	rl := rateFromHeader(resp.Header)
 
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("user followers lookup response read: %w", err)
-	}
+	decoder := json.NewDecoder(resp.Body)
+
 	if resp.StatusCode != http.StatusOK {
 		e := &ErrorResponse{}
-		if err := json.Unmarshal(respBytes, e); err != nil {
+		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -1664,15 +1962,14 @@ func (c *Client) UserFollowersLookup(ctx context.Context, id string, opts UserFo
 		Meta: &UserFollowershMeta{},
 	}
 
-	if err := json.Unmarshal(respBytes, followersLookup.Raw); err != nil {
-		return nil, &ResponseDecodeError{
-			Name:      "user followers lookup",
-			Err:       err,
-			RateLimit: rl,
-		}
+	body := struct {
+		*UserRaw
+		Meta *UserFollowershMeta `json:"meta"`
+	}{
+		UserRaw: followersLookup.Raw,
+		Meta:    followersLookup.Meta,
 	}
-
-	if err := json.Unmarshal(respBytes, followersLookup); err != nil {
+	if err := c.decode(decoder, &body); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user followers lookup",
 			Err:       err,
@@ -1701,10 +1998,10 @@ func (c *Client) UserTweetTimeline(ctx context.Context, userID string, opts User
 		return nil, fmt.Errorf("user tweet timeline request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user tweet timeline response: %w", err)
 	}
@@ -1712,22 +2009,22 @@ func (c *Client) UserTweetTimeline(ctx context.Context, userID string, opts User
 
 	rl := rateFromHeader(resp.Header)
 
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("user tweet timeline response read: %w", err)
-	}
+	decoder := json.NewDecoder(resp.Body)
+
 	if resp.StatusCode != http.StatusOK {
 		e := &ErrorResponse{}
-		if err := json.Unmarshal(respBytes, e); err != nil {
+		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -1736,15 +2033,14 @@ func (c *Client) UserTweetTimeline(ctx context.Context, userID string, opts User
 		Meta: &UserTimelineMeta{},
 	}
 
-	if err := json.Unmarshal(respBytes, timeline.Raw); err != nil {
-		return nil, &ResponseDecodeError{
-			Name:      "user tweet timeline",
-			Err:       err,
-			RateLimit: rl,
-		}
+	body := struct {
+		*TweetRaw
+		Meta *UserTimelineMeta `json:"meta"`
+	}{
+		TweetRaw: timeline.Raw,
+		Meta:     timeline.Meta,
 	}
-
-	if err := json.Unmarshal(respBytes, timeline); err != nil {
+	if err := c.decode(decoder, &body); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user tweet timeline",
 			Err:       err,
@@ -1772,17 +2068,17 @@ func (c *Client) UserTweetTimelineAsync(ctx context.Context, userID string, opts
 		return nil, fmt.Errorf("user tweet timeline request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user tweet timeline response: %w", err)
 	}
 	defer resp.Body.Close()
 
 	raw := &UserTweetTimelineAsyncResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(raw); err != nil {
+	if err := c.decode(json.NewDecoder(resp.Body), raw); err != nil {
 		return nil, err
 	}
 
@@ -1792,22 +2088,22 @@ func (c *Client) UserTweetTimelineAsync(ctx context.Context, userID string, opts
 func (c *Client) ParseUserTweetTimelineAsyncResponse(resp *http.Response) (*UserTweetTimelineResponse, error) {
 	rl := rateFromHeader(resp.Header)
 
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("user tweet timeline response read: %w", err)
-	}
+	decoder := json.NewDecoder(resp.Body)
+
 	if resp.StatusCode != http.StatusOK {
 		e := &ErrorResponse{}
-		if err := json.Unmarshal(respBytes, e); err != nil {
+		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -1816,15 +2112,14 @@ func (c *Client) ParseUserTweetTimelineAsyncResponse(resp *http.Response) (*User
 		Meta: &UserTimelineMeta{},
 	}
 
-	if err := json.Unmarshal(respBytes, timeline.Raw); err != nil {
-		return nil, &ResponseDecodeError{
-			Name:      "user tweet timeline",
-			Err:       err,
-			RateLimit: rl,
-		}
+	body := struct {
+		*TweetRaw
+		Meta *UserTimelineMeta `json:"meta"`
+	}{
+		TweetRaw: timeline.Raw,
+		Meta:     timeline.Meta,
 	}
-
-	if err := json.Unmarshal(respBytes, timeline); err != nil {
+	if err := c.decode(decoder, &body); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user tweet timeline",
 			Err:       err,
@@ -1853,10 +2148,10 @@ func (c *Client) UserMentionTimeline(ctx context.Context, userID string, opts Us
 		return nil, fmt.Errorf("user mention timeline request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user mention timeline response: %w", err)
 	}
@@ -1864,22 +2159,22 @@ func (c *Client) UserMentionTimeline(ctx context.Context, userID string, opts Us
 
 	rl := rateFromHeader(resp.Header)
 
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("user mention timeline response read: %w", err)
-	}
+	decoder := json.NewDecoder(resp.Body)
+
 	if resp.StatusCode != http.StatusOK {
 		e := &ErrorResponse{}
-		if err := json.Unmarshal(respBytes, e); err != nil {
+		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -1888,15 +2183,14 @@ func (c *Client) UserMentionTimeline(ctx context.Context, userID string, opts Us
 		Meta: &UserTimelineMeta{},
 	}
 
-	if err := json.Unmarshal(respBytes, timeline.Raw); err != nil {
-		return nil, &ResponseDecodeError{
-			Name:      "user mention timeline",
-			Err:       err,
-			RateLimit: rl,
-		}
+	body := struct {
+		*TweetRaw
+		Meta *UserTimelineMeta `json:"meta"`
+	}{
+		TweetRaw: timeline.Raw,
+		Meta:     timeline.Meta,
 	}
-
-	if err := json.Unmarshal(respBytes, timeline); err != nil {
+	if err := c.decode(decoder, &body); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user mention timeline",
 			Err:       err,
@@ -1926,10 +2220,10 @@ func (c *Client) UserTweetReverseChronologicalTimeline(ctx context.Context, user
 		return nil, fmt.Errorf("user tweet reverse chronological timeline request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user tweet reverse chronological timeline response: %w", err)
 	}
@@ -1943,14 +2237,16 @@ func (c *Client) UserTweetReverseChronologicalTimeline(ctx context.Context, user
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -1959,7 +2255,7 @@ func (c *Client) UserTweetReverseChronologicalTimeline(ctx context.Context, user
 		Meta UserReverseChronologicalTimelineMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&timeline); err != nil {
+	if err := c.decode(decoder, &timeline); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user tweet reverse chronological timeline",
 			Err:       err,
@@ -1974,8 +2270,9 @@ func (c *Client) UserTweetReverseChronologicalTimeline(ctx context.Context, user
 	}, nil
 }
 
-// TweetHideReplies will hide the replies for a given tweet
-func (c Client) TweetHideReplies(ctx context.Context, id string, hide bool) (*TweetHideReplyResponse, error) {
+// TweetHideReplies will hide or unhide a reply to a tweet.  The id is the reply being hidden and the
+// authenticated user must be the author of the conversation.
+func (c *Client) TweetHideReplies(ctx context.Context, id string, hide bool) (*TweetHideReplyResponse, error) {
 	if len(id) == 0 {
 		return nil, fmt.Errorf("tweet hide replies: id must be present %w", ErrParameter)
 	}
@@ -1995,9 +2292,9 @@ func (c Client) TweetHideReplies(ctx context.Context, id string, hide bool) (*Tw
 	}
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet hide replies response: %w", err)
 	}
@@ -2011,19 +2308,21 @@ func (c Client) TweetHideReplies(ctx context.Context, id string, hide bool) (*Tw
 		errResp := &ErrorResponse{}
 		if err := decoder.Decode(errResp); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		errResp.StatusCode = resp.StatusCode
 		errResp.RateLimit = rl
+		errResp.TransactionID = transactionID(resp.Header)
 		return nil, errResp
 	}
 
 	rd := &TweetHideReplyResponse{}
-	if err := decoder.Decode(rd); err != nil {
+	if err := c.decode(decoder, rd); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet hide replies",
 			Err:       err,
@@ -2059,9 +2358,9 @@ func (c *Client) UserRetweet(ctx context.Context, userID, tweetID string) (*User
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user retweet response: %w", err)
 	}
@@ -2075,19 +2374,21 @@ func (c *Client) UserRetweet(ctx context.Context, userID, tweetID string) (*User
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &UserRetweetResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user retweet",
 			Err:       err,
@@ -2114,9 +2415,9 @@ func (c *Client) DeleteUserRetweet(ctx context.Context, userID, tweetID string)
 		return nil, fmt.Errorf("user delete retweet request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user delete retweet response: %w", err)
 	}
@@ -2130,19 +2431,21 @@ func (c *Client) DeleteUserRetweet(ctx context.Context, userID, tweetID string)
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &DeleteUserRetweetResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "delete user retweet",
 			Err:       err,
@@ -2168,12 +2471,12 @@ func (c *Client) UserBlocksLookup(ctx context.Context, userID string, opts UserB
 		return nil, fmt.Errorf("user blocked lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	q := req.URL.Query()
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user blocked lookup response: %w", err)
 	}
@@ -2181,22 +2484,22 @@ func (c *Client) UserBlocksLookup(ctx context.Context, userID string, opts UserB
 
 	rl := rateFromHeader(resp.Header)
 
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("user blocked lookup response read: %w", err)
-	}
+	decoder := json.NewDecoder(resp.Body)
+
 	if resp.StatusCode != http.StatusOK {
 		e := &ErrorResponse{}
-		if err := json.Unmarshal(respBytes, e); err != nil {
+		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -2205,15 +2508,14 @@ func (c *Client) UserBlocksLookup(ctx context.Context, userID string, opts UserB
 		Meta: &UserBlocksLookupMeta{},
 	}
 
-	if err := json.Unmarshal(respBytes, blockedLookup.Raw); err != nil {
-		return nil, &ResponseDecodeError{
-			Name:      "user blocked lookup",
-			Err:       err,
-			RateLimit: rl,
-		}
+	body := struct {
+		*UserRaw
+		Meta *UserBlocksLookupMeta `json:"meta"`
+	}{
+		UserRaw: blockedLookup.Raw,
+		Meta:    blockedLookup.Meta,
 	}
-
-	if err := json.Unmarshal(respBytes, blockedLookup); err != nil {
+	if err := c.decode(decoder, &body); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user blocked lookup",
 			Err:       err,
@@ -2249,9 +2551,9 @@ func (c *Client) UserBlocks(ctx context.Context, userID, targetUserID string) (*
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user blocks response: %w", err)
 	}
@@ -2265,19 +2567,21 @@ func (c *Client) UserBlocks(ctx context.Context, userID, targetUserID string) (*
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &UserBlocksResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user blocks",
 			Err:       err,
@@ -2304,9 +2608,9 @@ func (c *Client) DeleteUserBlocks(ctx context.Context, userID, targetUserID stri
 		return nil, fmt.Errorf("user delete blocks request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user delete blocks response: %w", err)
 	}
@@ -2320,19 +2624,21 @@ func (c *Client) DeleteUserBlocks(ctx context.Context, userID, targetUserID stri
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &UserDeleteBlocksResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "delete user blocks",
 			Err:       err,
@@ -2358,12 +2664,12 @@ func (c *Client) UserMutesLookup(ctx context.Context, userID string, opts UserMu
 		return nil, fmt.Errorf("user muted lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	q := req.URL.Query()
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user muted lookup response: %w", err)
 	}
@@ -2371,22 +2677,22 @@ func (c *Client) UserMutesLookup(ctx context.Context, userID string, opts UserMu
 
 	rl := rateFromHeader(resp.Header)
 
-	respBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("user muted lookup response read: %w", err)
-	}
+	decoder := json.NewDecoder(resp.Body)
+
 	if resp.StatusCode != http.StatusOK {
 		e := &ErrorResponse{}
-		if err := json.Unmarshal(respBytes, e); err != nil {
+		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -2395,15 +2701,14 @@ func (c *Client) UserMutesLookup(ctx context.Context, userID string, opts UserMu
 		Meta: &UserMutesLookupMeta{},
 	}
 
-	if err := json.Unmarshal(respBytes, mutedLookup.Raw); err != nil {
-		return nil, &ResponseDecodeError{
-			Name:      "user muted lookup",
-			Err:       err,
-			RateLimit: rl,
-		}
+	body := struct {
+		*UserRaw
+		Meta *UserMutesLookupMeta `json:"meta"`
+	}{
+		UserRaw: mutedLookup.Raw,
+		Meta:    mutedLookup.Meta,
 	}
-
-	if err := json.Unmarshal(respBytes, mutedLookup); err != nil {
+	if err := c.decode(decoder, &body); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user muted lookup",
 			Err:       err,
@@ -2439,9 +2744,9 @@ func (c *Client) UserMutes(ctx context.Context, userID, targetUserID string) (*U
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user mutes response: %w", err)
 	}
@@ -2455,19 +2760,21 @@ func (c *Client) UserMutes(ctx context.Context, userID, targetUserID string) (*U
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &UserMutesResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user mutes",
 			Err:       err,
@@ -2494,9 +2801,9 @@ func (c *Client) DeleteUserMutes(ctx context.Context, userID, targetUserID strin
 		return nil, fmt.Errorf("user delete mutes request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user delete mutes response: %w", err)
 	}
@@ -2510,19 +2817,21 @@ func (c *Client) DeleteUserMutes(ctx context.Context, userID, targetUserID strin
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &UserDeleteMutesResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user delete mutes",
 			Err:       err,
@@ -2553,10 +2862,10 @@ func (c *Client) TweetLikesLookup(ctx context.Context, tweetID string, opts Twee
 		return nil, fmt.Errorf("user tweet likes lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user tweet likes lookup response: %w", err)
 	}
@@ -2570,14 +2879,16 @@ func (c *Client) TweetLikesLookup(ctx context.Context, tweetID string, opts Twee
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -2586,7 +2897,7 @@ func (c *Client) TweetLikesLookup(ctx context.Context, tweetID string, opts Twee
 		Meta *TweetLikesMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet likes lookup",
 			Err:       err,
@@ -2621,10 +2932,10 @@ func (c *Client) UserLikesLookup(ctx context.Context, userID string, opts UserLi
 		return nil, fmt.Errorf("tweet user likes lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet user likes lookup response: %w", err)
 	}
@@ -2638,14 +2949,16 @@ func (c *Client) UserLikesLookup(ctx context.Context, userID string, opts UserLi
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -2654,7 +2967,7 @@ func (c *Client) UserLikesLookup(ctx context.Context, userID string, opts UserLi
 		Meta *UserLikesMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user likes lookup",
 			Err:       err,
@@ -2693,9 +3006,9 @@ func (c *Client) UserLikes(ctx context.Context, userID, tweetID string) (*UserLi
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user likes response: %w", err)
 	}
@@ -2709,19 +3022,21 @@ func (c *Client) UserLikes(ctx context.Context, userID, tweetID string) (*UserLi
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &UserLikesResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user likes",
 			Err:       err,
@@ -2756,16 +3071,16 @@ func (c *Client) UserLikesAsync(ctx context.Context, userID, tweetID string) (*U
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user likes response: %w", err)
 	}
 	defer resp.Body.Close()
 
 	raw := &UserLikesAsyncResponse{}
-	if err := json.NewDecoder(resp.Body).Decode(resp.Body); err != nil {
+	if err := c.decode(json.NewDecoder(resp.Body), raw); err != nil {
 		return nil, err
 	}
 
@@ -2781,19 +3096,21 @@ func (c *Client) ParseUserLikesAsyncResponse(resp *http.Response) (*UserLikesRes
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &UserLikesResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user likes",
 			Err:       err,
@@ -2820,9 +3137,9 @@ func (c *Client) DeleteUserLikes(ctx context.Context, userID, tweetID string) (*
 		return nil, fmt.Errorf("user delete likes request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user delete likes response: %w", err)
 	}
@@ -2836,19 +3153,21 @@ func (c *Client) DeleteUserLikes(ctx context.Context, userID, tweetID string) (*
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &DeleteUserLikesResponse{}
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "delete user likes",
 			Err:       err,
@@ -2862,9 +3181,8 @@ func (c *Client) DeleteUserLikes(ctx context.Context, userID, tweetID string) (*
 // TweetSampleStream will return a streamer for streaming 1% of all tweets real-time
 func (c *Client) TweetSampleStream(ctx context.Context, opts TweetSampleStreamOpts) (*TweetStream, error) {
 	switch {
-	case opts.BackfillMinutes == 0:
-	case opts.BackfillMinutes > sampleStreamMaxBackOffMin:
-		return nil, fmt.Errorf("tweet sample stream: a max back off minutes [%d] is [current: %d]: %w", sampleStreamMaxBackOffMin, opts.BackfillMinutes, ErrParameter)
+	case opts.BackfillMinutes < 0 || opts.BackfillMinutes > streamMaxBackfillMinutes:
+		return nil, fmt.Errorf("tweet sample stream: backfill minutes [%d] must be between [0] and [%d]: %w", opts.BackfillMinutes, streamMaxBackfillMinutes, ErrParameter)
 	default:
 	}
 
@@ -2873,10 +3191,10 @@ func (c *Client) TweetSampleStream(ctx context.Context, opts TweetSampleStreamOp
 		return nil, fmt.Errorf("tweet sample stream request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet sample stream response: %w", err)
 	}
@@ -2888,18 +3206,25 @@ func (c *Client) TweetSampleStream(ctx context.Context, opts TweetSampleStreamOp
 		e := &ErrorResponse{}
 		if err := json.NewDecoder(resp.Body).Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
-	stream := StartTweetStream(resp.Body)
+	stream := StartTweetStreamWithOpts(resp.Body, TweetStreamOpts{
+		StallTimeout: opts.StallTimeout,
+		Buffer:       opts.Buffer,
+		Overflow:     opts.Overflow,
+		consumed:     c.tweetCapConsumer(req),
+	})
 	stream.RateLimit = rl
 	return stream, nil
 }
@@ -2919,10 +3244,10 @@ func (c *Client) ListLookup(ctx context.Context, listID string, opts ListLookupO
 		return nil, fmt.Errorf("list lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("list lookup response: %w", err)
 	}
@@ -2936,14 +3261,16 @@ func (c *Client) ListLookup(ctx context.Context, listID string, opts ListLookupO
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -2951,7 +3278,7 @@ func (c *Client) ListLookup(ctx context.Context, listID string, opts ListLookupO
 		*ListRaw
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "list lookup",
 			Err:       err,
@@ -2983,10 +3310,10 @@ func (c *Client) UserListLookup(ctx context.Context, userID string, opts UserLis
 		return nil, fmt.Errorf("user list lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user list lookup response: %w", err)
 	}
@@ -3000,14 +3327,16 @@ func (c *Client) UserListLookup(ctx context.Context, userID string, opts UserLis
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -3016,7 +3345,7 @@ func (c *Client) UserListLookup(ctx context.Context, userID string, opts UserLis
 		Meta *UserListLookupMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user list lookup",
 			Err:       err,
@@ -3049,10 +3378,10 @@ func (c *Client) ListTweetLookup(ctx context.Context, listID string, opts ListTw
 		return nil, fmt.Errorf("list tweet lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("list tweet lookup response: %w", err)
 	}
@@ -3066,14 +3395,16 @@ func (c *Client) ListTweetLookup(ctx context.Context, listID string, opts ListTw
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -3082,7 +3413,7 @@ func (c *Client) ListTweetLookup(ctx context.Context, listID string, opts ListTw
 		Meta *ListTweetLookupMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "list tweet lookup",
 			Err:       err,
@@ -3117,9 +3448,9 @@ func (c *Client) CreateList(ctx context.Context, list ListMetaData) (*ListCreate
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create list response: %w", err)
 	}
@@ -3133,20 +3464,22 @@ func (c *Client) CreateList(ctx context.Context, list ListMetaData) (*ListCreate
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	respBody := &ListCreateResponse{}
 
-	if err := decoder.Decode(respBody); err != nil {
+	if err := c.decode(decoder, respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "create list",
 			Err:       err,
@@ -3177,9 +3510,9 @@ func (c *Client) UpdateList(ctx context.Context, listID string, update ListMetaD
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("update list response: %w", err)
 	}
@@ -3193,20 +3526,22 @@ func (c *Client) UpdateList(ctx context.Context, listID string, update ListMetaD
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	respBody := &ListUpdateResponse{}
 
-	if err := decoder.Decode(respBody); err != nil {
+	if err := c.decode(decoder, respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "update list",
 			Err:       err,
@@ -3231,9 +3566,9 @@ func (c *Client) DeleteList(ctx context.Context, listID string) (*ListDeleteResp
 		return nil, fmt.Errorf("delete list request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("delete list response: %w", err)
 	}
@@ -3247,20 +3582,22 @@ func (c *Client) DeleteList(ctx context.Context, listID string) (*ListDeleteResp
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	respBody := &ListDeleteResponse{}
 
-	if err := decoder.Decode(respBody); err != nil {
+	if err := c.decode(decoder, respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "delete list",
 			Err:       err,
@@ -3299,9 +3636,9 @@ func (c *Client) AddListMember(ctx context.Context, listID, userID string) (*Lis
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create list member response: %w", err)
 	}
@@ -3315,20 +3652,22 @@ func (c *Client) AddListMember(ctx context.Context, listID, userID string) (*Lis
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	respBody := &ListAddMemberResponse{}
 
-	if err := decoder.Decode(respBody); err != nil {
+	if err := c.decode(decoder, respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "add list member",
 			Err:       err,
@@ -3355,9 +3694,9 @@ func (c *Client) RemoveListMember(ctx context.Context, listID, userID string) (*
 		return nil, fmt.Errorf("remove list member request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("remove list member response: %w", err)
 	}
@@ -3371,20 +3710,22 @@ func (c *Client) RemoveListMember(ctx context.Context, listID, userID string) (*
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	respBody := &ListRemoveMemberResponse{}
 
-	if err := decoder.Decode(respBody); err != nil {
+	if err := c.decode(decoder, respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "remove list member",
 			Err:       err,
@@ -3413,10 +3754,10 @@ func (c *Client) ListUserMembers(ctx context.Context, listID string, opts ListUs
 		return nil, fmt.Errorf("list user members request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("list user members response: %w", err)
 	}
@@ -3430,14 +3771,16 @@ func (c *Client) ListUserMembers(ctx context.Context, listID string, opts ListUs
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -3446,7 +3789,7 @@ func (c *Client) ListUserMembers(ctx context.Context, listID string, opts ListUs
 		Meta *ListUserMembersMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "list user members",
 			Err:       err,
@@ -3479,10 +3822,10 @@ func (c *Client) UserListMemberships(ctx context.Context, userID string, opts Us
 		return nil, fmt.Errorf("user list membership request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user list membership response: %w", err)
 	}
@@ -3496,14 +3839,16 @@ func (c *Client) UserListMemberships(ctx context.Context, userID string, opts Us
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -3512,7 +3857,7 @@ func (c *Client) UserListMemberships(ctx context.Context, userID string, opts Us
 		Meta *UserListMembershipsMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user list memberships",
 			Err:       err,
@@ -3555,9 +3900,9 @@ func (c *Client) UserPinList(ctx context.Context, userID, listID string) (*UserP
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user pin list response: %w", err)
 	}
@@ -3571,20 +3916,22 @@ func (c *Client) UserPinList(ctx context.Context, userID, listID string) (*UserP
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	respBody := &UserPinListResponse{}
 
-	if err := decoder.Decode(respBody); err != nil {
+	if err := c.decode(decoder, respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user pin list",
 			Err:       err,
@@ -3611,9 +3958,9 @@ func (c *Client) UserUnpinList(ctx context.Context, userID, listID string) (*Use
 		return nil, fmt.Errorf("user unpin list request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user unpin list response: %w", err)
 	}
@@ -3627,20 +3974,22 @@ func (c *Client) UserUnpinList(ctx context.Context, userID, listID string) (*Use
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	respBody := &UserUnpinListResponse{}
 
-	if err := decoder.Decode(respBody); err != nil {
+	if err := c.decode(decoder, respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user unpin list",
 			Err:       err,
@@ -3666,10 +4015,10 @@ func (c *Client) UserPinnedLists(ctx context.Context, userID string, opts UserPi
 		return nil, fmt.Errorf("user pinned list request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user pinned list response: %w", err)
 	}
@@ -3683,14 +4032,16 @@ func (c *Client) UserPinnedLists(ctx context.Context, userID string, opts UserPi
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -3699,7 +4050,7 @@ func (c *Client) UserPinnedLists(ctx context.Context, userID string, opts UserPi
 		Meta *UserPinnedListsMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user pinned list",
 			Err:       err,
@@ -3742,9 +4093,9 @@ func (c *Client) UserFollowList(ctx context.Context, userID, listID string) (*Us
 	}
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user follow list response: %w", err)
 	}
@@ -3758,20 +4109,22 @@ func (c *Client) UserFollowList(ctx context.Context, userID, listID string) (*Us
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	respBody := &UserFollowListResponse{}
 
-	if err := decoder.Decode(respBody); err != nil {
+	if err := c.decode(decoder, respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user follow list",
 			Err:       err,
@@ -3798,9 +4151,9 @@ func (c *Client) UserUnfollowList(ctx context.Context, userID, listID string) (*
 		return nil, fmt.Errorf("user unfollow list request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user unfollow list response: %w", err)
 	}
@@ -3814,20 +4167,22 @@ func (c *Client) UserUnfollowList(ctx context.Context, userID, listID string) (*
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	respBody := &UserUnfollowListResponse{}
 
-	if err := decoder.Decode(respBody); err != nil {
+	if err := c.decode(decoder, respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user unfollow list",
 			Err:       err,
@@ -3856,10 +4211,10 @@ func (c *Client) UserFollowedLists(ctx context.Context, userID string, opts User
 		return nil, fmt.Errorf("user followed list request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("user followed list response: %w", err)
 	}
@@ -3873,14 +4228,16 @@ func (c *Client) UserFollowedLists(ctx context.Context, userID string, opts User
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -3889,7 +4246,7 @@ func (c *Client) UserFollowedLists(ctx context.Context, userID string, opts User
 		Meta *UserFollowedListsMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "user followed list",
 			Err:       err,
@@ -3922,10 +4279,10 @@ func (c *Client) ListUserFollowers(ctx context.Context, listID string, opts List
 		return nil, fmt.Errorf("list user followers request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("list user followers response: %w", err)
 	}
@@ -3939,14 +4296,16 @@ func (c *Client) ListUserFollowers(ctx context.Context, listID string, opts List
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -3955,7 +4314,7 @@ func (c *Client) ListUserFollowers(ctx context.Context, listID string, opts List
 		Meta *ListUserFollowersMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "list user followers",
 			Err:       err,
@@ -3988,7 +4347,7 @@ func (c *Client) SpacesLookup(ctx context.Context, ids []string, opts SpacesLook
 		return nil, fmt.Errorf("space lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	if len(ids) > 1 {
 		q := req.URL.Query()
@@ -3996,7 +4355,7 @@ func (c *Client) SpacesLookup(ctx context.Context, ids []string, opts SpacesLook
 		req.URL.RawQuery = q.Encode()
 	}
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("space lookup response: %w", err)
 	}
@@ -4010,14 +4369,16 @@ func (c *Client) SpacesLookup(ctx context.Context, ids []string, opts SpacesLook
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -4025,7 +4386,7 @@ func (c *Client) SpacesLookup(ctx context.Context, ids []string, opts SpacesLook
 	switch {
 	case len(ids) == 1:
 		single := &spaceRaw{}
-		if err := decoder.Decode(single); err != nil {
+		if err := c.decode(decoder, single); err != nil {
 			return nil, &ResponseDecodeError{
 				Name:      "space lookup",
 				Err:       err,
@@ -4037,7 +4398,7 @@ func (c *Client) SpacesLookup(ctx context.Context, ids []string, opts SpacesLook
 		raw.Includes = single.Includes
 		raw.Errors = single.Errors
 	default:
-		if err := decoder.Decode(raw); err != nil {
+		if err := c.decode(decoder, raw); err != nil {
 			return nil, &ResponseDecodeError{
 				Name:      "space lookup ",
 				Err:       err,
@@ -4051,28 +4412,47 @@ func (c *Client) SpacesLookup(ctx context.Context, ids []string, opts SpacesLook
 	}, nil
 }
 
-// SpacesByCreatorLookup returns live or scheduled spaces created by a specific user ids
+// SpacesByCreatorLookup returns live or scheduled spaces created by a specific user ids.  More than 100 user ids are
+// looked up in batches of 100 and the responses are combined, with the rate limit of the last batch.
 func (c *Client) SpacesByCreatorLookup(ctx context.Context, userIDs []string, opts SpacesByCreatorLookupOpts) (*SpacesByCreatorLookupResponse, error) {
-	switch {
-	case len(userIDs) == 0:
+	if len(userIDs) == 0 {
 		return nil, fmt.Errorf("space by creator lookup: an id is required: %w", ErrParameter)
-	case len(userIDs) > spaceByCreatorMaxIDs:
-		return nil, fmt.Errorf("space by creator lookup: ids %d is greater than max %d: %w", len(userIDs), spaceByCreatorMaxIDs, ErrParameter)
-	default:
+	}
+	if len(userIDs) <= spaceByCreatorMaxIDs {
+		return c.spacesByCreatorLookup(ctx, userIDs, opts)
 	}
 
+	combined := &SpacesByCreatorLookupResponse{
+		Raw:  &SpacesRaw{},
+		Meta: &SpacesByCreatorMeta{},
+	}
+	for _, batch := range chunk(unique(userIDs), spaceByCreatorMaxIDs) {
+		resp, err := c.spacesByCreatorLookup(ctx, batch, opts)
+		if err != nil {
+			return nil, err
+		}
+		combined.RateLimit = resp.RateLimit
+		combined.Raw.merge(resp.Raw)
+		if resp.Meta != nil {
+			combined.Meta.ResultCount += resp.Meta.ResultCount
+		}
+	}
+	return combined, nil
+}
+
+func (c *Client) spacesByCreatorLookup(ctx context.Context, userIDs []string, opts SpacesByCreatorLookupOpts) (*SpacesByCreatorLookupResponse, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, spaceByCreatorLookupEndpoint.url(c.Host), nil)
 	if err != nil {
 		return nil, fmt.Errorf("space by creator lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	q := req.URL.Query()
 	q.Add("user_ids", strings.Join(userIDs, ","))
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("space by creator lookup response: %w", err)
 	}
@@ -4086,14 +4466,16 @@ func (c *Client) SpacesByCreatorLookup(ctx context.Context, userIDs []string, op
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -4102,7 +4484,7 @@ func (c *Client) SpacesByCreatorLookup(ctx context.Context, userIDs []string, op
 		Meta *SpacesByCreatorMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&raw); err != nil {
+	if err := c.decode(decoder, &raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "space by creator lookup ",
 			Err:       err,
@@ -4129,10 +4511,10 @@ func (c *Client) SpaceBuyersLookup(ctx context.Context, spaceID string, opts Spa
 		return nil, fmt.Errorf("space buyers lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("space buyers lookup response: %w", err)
 	}
@@ -4146,20 +4528,22 @@ func (c *Client) SpaceBuyersLookup(ctx context.Context, spaceID string, opts Spa
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &UserRaw{}
 
-	if err := decoder.Decode(raw); err != nil {
+	if err := c.decode(decoder, raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "space buyers lookup ",
 			Err:       err,
@@ -4185,10 +4569,10 @@ func (c *Client) SpaceTweetsLookup(ctx context.Context, spaceID string, opts Spa
 		return nil, fmt.Errorf("space tweets lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("space tweets lookup response: %w", err)
 	}
@@ -4202,14 +4586,16 @@ func (c *Client) SpaceTweetsLookup(ctx context.Context, spaceID string, opts Spa
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -4218,7 +4604,7 @@ func (c *Client) SpaceTweetsLookup(ctx context.Context, spaceID string, opts Spa
 		Meta *SpaceTweetsLookupMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&raw); err != nil {
+	if err := c.decode(decoder, &raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "space tweets lookup ",
 			Err:       err,
@@ -4245,13 +4631,13 @@ func (c *Client) SpacesSearch(ctx context.Context, query string, opts SpacesSear
 		return nil, fmt.Errorf("space search request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 	q := req.URL.Query()
 	q.Add("query", query)
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("space search response: %w", err)
 	}
@@ -4265,14 +4651,16 @@ func (c *Client) SpacesSearch(ctx context.Context, query string, opts SpacesSear
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -4281,7 +4669,7 @@ func (c *Client) SpacesSearch(ctx context.Context, query string, opts SpacesSear
 		Meta *SpacesSearchMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&raw); err != nil {
+	if err := c.decode(decoder, &raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "space search",
 			Err:       err,
@@ -4326,7 +4714,7 @@ func (c *Client) CreateComplianceBatchJob(ctx context.Context, jobType Complianc
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("create compliance batch job response: %w", err)
 	}
@@ -4340,20 +4728,22 @@ func (c *Client) CreateComplianceBatchJob(ctx context.Context, jobType Complianc
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &ComplianceBatchJobRaw{}
 
-	if err := decoder.Decode(&raw); err != nil {
+	if err := c.decode(decoder, &raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "create compliance batch job",
 			Err:       err,
@@ -4383,7 +4773,7 @@ func (c *Client) ComplianceBatchJob(ctx context.Context, id string) (*Compliance
 	}
 	req.Header.Add("Accept", "application/json")
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("compliance batch job response: %w", err)
 	}
@@ -4397,20 +4787,22 @@ func (c *Client) ComplianceBatchJob(ctx context.Context, id string) (*Compliance
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &ComplianceBatchJobRaw{}
 
-	if err := decoder.Decode(&raw); err != nil {
+	if err := c.decode(decoder, &raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "compliance batch job",
 			Err:       err,
@@ -4445,7 +4837,7 @@ func (c *Client) ComplianceBatchJobLookup(ctx context.Context, jobType Complianc
 	q.Add("type", string(jobType))
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("compliance batch job lookup response: %w", err)
 	}
@@ -4459,20 +4851,22 @@ func (c *Client) ComplianceBatchJobLookup(ctx context.Context, jobType Complianc
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	raw := &ComplianceBatchJobsRaw{}
 
-	if err := decoder.Decode(&raw); err != nil {
+	if err := c.decode(decoder, &raw); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "compliance batch job lookup",
 			Err:       err,
@@ -4510,10 +4904,10 @@ func (c *Client) QuoteTweetsLookup(ctx context.Context, tweetID string, opts Quo
 		return nil, fmt.Errorf("quote tweets lookup request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("quote tweets lookup response: %w", err)
 	}
@@ -4527,14 +4921,16 @@ func (c *Client) QuoteTweetsLookup(ctx context.Context, tweetID string, opts Quo
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -4543,7 +4939,7 @@ func (c *Client) QuoteTweetsLookup(ctx context.Context, tweetID string, opts Quo
 		Meta *QuoteTweetsLookupMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "quote tweets lookup",
 			Err:       err,
@@ -4570,18 +4966,64 @@ func (c *Client) TweetBookmarksLookup(ctx context.Context, userID string, opts T
 	}
 
 	ep := tweetBookmarksEndpoint.urlID(c.Host, userID)
+	return c.tweetBookmarksLookup(ctx, "tweet bookmarks lookup", ep, opts)
+}
+
+// TweetBookmarkFolders returns the bookmark folders of the authenticated user
+func (c *Client) TweetBookmarkFolders(ctx context.Context, userID string, opts TweetBookmarkFoldersOpts) (*TweetBookmarkFoldersResponse, error) {
+	switch {
+	case len(userID) == 0:
+		return nil, fmt.Errorf("tweet bookmark folders: an id is required: %w", ErrParameter)
+	case opts.MaxResults > tweetBookmarksMaxResults:
+		return nil, fmt.Errorf("tweet bookmark folders: max results [%d] is greater than max [%d]: %w", opts.MaxResults, tweetBookmarksMaxResults, ErrParameter)
+	default:
+	}
 
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tweetBookmarkFoldersEndpoint.urlID(c.Host, userID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("tweet bookmark folders request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.authorize(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("tweet bookmark folders response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[[]*BookmarkFolderObj, *TweetBookmarksLookupMeta](resp, "tweet bookmark folders", http.StatusOK, c.Strict)
+}
+
+// TweetBookmarkFolderLookup returns the bookmarked tweets in one of the authenticated user's bookmark folders
+func (c *Client) TweetBookmarkFolderLookup(ctx context.Context, userID, folderID string, opts TweetBookmarksLookupOpts) (*TweetBookmarksLookupResponse, error) {
+	switch {
+	case len(userID) == 0:
+		return nil, fmt.Errorf("tweet bookmark folder lookup: an id is required: %w", ErrParameter)
+	case len(folderID) == 0:
+		return nil, fmt.Errorf("tweet bookmark folder lookup: a folder id is required: %w", ErrParameter)
+	case opts.MaxResults > tweetBookmarksMaxResults:
+		return nil, fmt.Errorf("tweet bookmark folder lookup: max results [%d] is greater than max [%d]: %w", opts.MaxResults, tweetBookmarksMaxResults, ErrParameter)
+	default:
+	}
+
+	ep := tweetBookmarkFoldersEndpoint.urlID(c.Host, userID) + fmt.Sprintf("/%s", folderID)
+	return c.tweetBookmarksLookup(ctx, "tweet bookmark folder lookup", ep, opts)
+}
+
+func (c *Client) tweetBookmarksLookup(ctx context.Context, name, ep string, opts TweetBookmarksLookupOpts) (*TweetBookmarksLookupResponse, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
 	if err != nil {
-		return nil, fmt.Errorf("tweet bookmarks lookup request: %w", err)
+		return nil, fmt.Errorf("%s request: %w", name, err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 	opts.addQuery(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
-		return nil, fmt.Errorf("tweet bookmarks lookup response: %w", err)
+		return nil, fmt.Errorf("%s response: %w", name, err)
 	}
 	defer resp.Body.Close()
 
@@ -4593,14 +5035,16 @@ func (c *Client) TweetBookmarksLookup(ctx context.Context, userID string, opts T
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -4609,9 +5053,9 @@ func (c *Client) TweetBookmarksLookup(ctx context.Context, userID string, opts T
 		Meta *TweetBookmarksLookupMeta `json:"meta"`
 	}{}
 
-	if err := decoder.Decode(&respBody); err != nil {
+	if err := c.decode(decoder, &respBody); err != nil {
 		return nil, &ResponseDecodeError{
-			Name:      "tweet bookmarks lookup",
+			Name:      name,
 			Err:       err,
 			RateLimit: rl,
 		}
@@ -4652,9 +5096,9 @@ func (c *Client) AddTweetBookmark(ctx context.Context, userID, tweetID string) (
 	}
 	req.Header.Add("Accept", "application/json")
 	req.Header.Add("Content-Type", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet bookmarks add response: %w", err)
 	}
@@ -4668,20 +5112,22 @@ func (c *Client) AddTweetBookmark(ctx context.Context, userID, tweetID string) (
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	respBody := &AddTweetBookmarkResponse{}
 
-	if err := decoder.Decode(respBody); err != nil {
+	if err := c.decode(decoder, respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet bookmarks add",
 			Err:       err,
@@ -4711,9 +5157,9 @@ func (c *Client) RemoveTweetBookmark(ctx context.Context, userID, tweetID string
 		return nil, fmt.Errorf("tweet bookmarks remove request: %w", err)
 	}
 	req.Header.Add("Accept", "application/json")
-	c.Authorizer.Add(req)
+	c.authorize(req)
 
-	resp, err := c.Client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("tweet bookmarks remove response: %w", err)
 	}
@@ -4727,20 +5173,22 @@ func (c *Client) RemoveTweetBookmark(ctx context.Context, userID, tweetID string
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
 	respBody := &RemoveTweetBookmarkResponse{}
 
-	if err := decoder.Decode(respBody); err != nil {
+	if err := c.decode(decoder, respBody); err != nil {
 		return nil, &ResponseDecodeError{
 			Name:      "tweet bookmarks remove",
 			Err:       err,
@@ -4752,3 +5200,591 @@ func (c *Client) RemoveTweetBookmark(ctx context.Context, userID, tweetID string
 
 	return respBody, nil
 }
+
+// DMEventsLookup returns the direct message events, messages and group conversation joins and leaves, of the authenticated user
+func (c *Client) DMEventsLookup(ctx context.Context, opts DMEventsLookupOpts) (*DMEventsLookupResponse, error) {
+	return c.dmEventsLookup(ctx, "dm events lookup", dmEventsEndpoint.url(c.Host), opts)
+}
+
+// DMConversationEventsLookup returns the direct message events of a conversation
+func (c *Client) DMConversationEventsLookup(ctx context.Context, conversationID string, opts DMEventsLookupOpts) (*DMEventsLookupResponse, error) {
+	if len(conversationID) == 0 {
+		return nil, fmt.Errorf("dm conversation events lookup: a conversation id is required: %w", ErrParameter)
+	}
+	return c.dmEventsLookup(ctx, "dm conversation events lookup", dmConversationEventsEndpoint.urlID(c.Host, conversationID), opts)
+}
+
+// DMParticipantEventsLookup returns the direct message events of the one to one conversation with the participant
+func (c *Client) DMParticipantEventsLookup(ctx context.Context, participantID string, opts DMEventsLookupOpts) (*DMEventsLookupResponse, error) {
+	if len(participantID) == 0 {
+		return nil, fmt.Errorf("dm participant events lookup: a participant id is required: %w", ErrParameter)
+	}
+	return c.dmEventsLookup(ctx, "dm participant events lookup", dmParticipantEventsEndpoint.urlID(c.Host, participantID), opts)
+}
+
+func (c *Client) dmEventsLookup(ctx context.Context, name, ep string, opts DMEventsLookupOpts) (*DMEventsLookupResponse, error) {
+	switch {
+	case opts.MaxResults == 0:
+	case opts.MaxResults > dmEventsMaxResults:
+		return nil, fmt.Errorf("%s: max results [%d] is greater than max [%d]: %w", name, opts.MaxResults, dmEventsMaxResults, ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ep, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s request: %w", name, err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.authorize(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s response: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[[]*DMEventObj, *DMEventsLookupMeta](resp, name, http.StatusOK, c.Strict)
+}
+
+// CreateDMConversation creates a new group conversation with the participants and sends the first message
+func (c *Client) CreateDMConversation(ctx context.Context, conversation CreateDMConversationRequest) (*CreateDMEventResponse, error) {
+	if err := conversation.validate(); err != nil {
+		return nil, fmt.Errorf("create dm conversation: %w", err)
+	}
+	rb := struct {
+		ConversationType string          `json:"conversation_type"`
+		ParticipantIDs   []string        `json:"participant_ids"`
+		Message          CreateDMMessage `json:"message"`
+	}{
+		ConversationType: "Group",
+		ParticipantIDs:   conversation.ParticipantIDs,
+		Message:          conversation.Message,
+	}
+	return c.sendDM(ctx, "create dm conversation", dmConversationsEndpoint.url(c.Host), rb)
+}
+
+// SendDMToParticipant sends a message to the one to one conversation with the participant, creating the conversation if needed
+func (c *Client) SendDMToParticipant(ctx context.Context, participantID string, message CreateDMMessage) (*CreateDMEventResponse, error) {
+	if len(participantID) == 0 {
+		return nil, fmt.Errorf("send dm to participant: a participant id is required: %w", ErrParameter)
+	}
+	if err := message.validate(); err != nil {
+		return nil, fmt.Errorf("send dm to participant: %w", err)
+	}
+	return c.sendDM(ctx, "send dm to participant", dmParticipantMessagesEndpoint.urlID(c.Host, participantID), message)
+}
+
+// SendDMToConversation sends a message to an existing conversation
+func (c *Client) SendDMToConversation(ctx context.Context, conversationID string, message CreateDMMessage) (*CreateDMEventResponse, error) {
+	if len(conversationID) == 0 {
+		return nil, fmt.Errorf("send dm to conversation: a conversation id is required: %w", ErrParameter)
+	}
+	if err := message.validate(); err != nil {
+		return nil, fmt.Errorf("send dm to conversation: %w", err)
+	}
+	return c.sendDM(ctx, "send dm to conversation", dmConversationMessagesEndpoint.urlID(c.Host, conversationID), message)
+}
+
+func (c *Client) sendDM(ctx context.Context, name, ep string, body interface{}) (*CreateDMEventResponse, error) {
+	enc, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("%s body encoding: %w", name, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, ep, bytes.NewReader(enc))
+	if err != nil {
+		return nil, fmt.Errorf("%s request: %w", name, err)
+	}
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Content-Type", "application/json")
+	c.authorize(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s response: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[*CreateDMEventData, NoMeta](resp, name, http.StatusCreated, c.Strict)
+}
+
+// TrendsByWOEID returns the trending topics for a location.  The location is the Yahoo! where on earth id, like 1 for worldwide.
+func (c *Client) TrendsByWOEID(ctx context.Context, woeid int, opts TrendsByWOEIDOpts) (*TrendsByWOEIDResponse, error) {
+	switch {
+	case woeid <= 0:
+		return nil, fmt.Errorf("trends by woeid: a woeid is required: %w", ErrParameter)
+	case opts.MaxTrends > trendsMaxTrends:
+		return nil, fmt.Errorf("trends by woeid: max trends [%d] is greater than max [%d]: %w", opts.MaxTrends, trendsMaxTrends, ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, trendsByWOEIDEndpoint.urlID(c.Host, strconv.Itoa(woeid)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("trends by woeid request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.authorize(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("trends by woeid response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[[]*TrendObj, NoMeta](resp, "trends by woeid", http.StatusOK, c.Strict)
+}
+
+// PersonalizedTrends returns the trending topics for the authenticated user
+func (c *Client) PersonalizedTrends(ctx context.Context, opts PersonalizedTrendsOpts) (*PersonalizedTrendsResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, personalizedTrendsEndpoint.url(c.Host), nil)
+	if err != nil {
+		return nil, fmt.Errorf("personalized trends request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.authorize(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("personalized trends response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[[]*PersonalizedTrendObj, NoMeta](resp, "personalized trends", http.StatusOK, c.Strict)
+}
+
+// CommunityLookup returns a community
+func (c *Client) CommunityLookup(ctx context.Context, id string, opts CommunityLookupOpts) (*CommunityLookupResponse, error) {
+	if len(id) == 0 {
+		return nil, fmt.Errorf("community lookup: an id is required: %w", ErrParameter)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, communityLookupEndpoint.urlID(c.Host, id), nil)
+	if err != nil {
+		return nil, fmt.Errorf("community lookup request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.authorize(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("community lookup response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[*CommunityObj, NoMeta](resp, "community lookup", http.StatusOK, c.Strict)
+}
+
+// CommunitySearch returns the communities that match the query
+func (c *Client) CommunitySearch(ctx context.Context, query string, opts CommunitySearchOpts) (*CommunitySearchResponse, error) {
+	switch {
+	case len(query) == 0:
+		return nil, fmt.Errorf("community search: a query is required: %w", ErrParameter)
+	case opts.MaxResults == 0:
+	case opts.MaxResults < communitySearchMinResults || opts.MaxResults > communitySearchMaxResults:
+		return nil, fmt.Errorf("community search: max results [%d] must be between [%d] and [%d]: %w", opts.MaxResults, communitySearchMinResults, communitySearchMaxResults, ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, communitySearchEndpoint.url(c.Host), nil)
+	if err != nil {
+		return nil, fmt.Errorf("community search request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.authorize(req)
+	opts.addQuery(req)
+	q := req.URL.Query()
+	q.Add("query", query)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("community search response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[[]*CommunityObj, *CommunitySearchMeta](resp, "community search", http.StatusOK, c.Strict)
+}
+
+// NotesEligiblePosts returns the posts that the authorized user can write community notes for
+func (c *Client) NotesEligiblePosts(ctx context.Context, opts NotesEligiblePostsOpts) (*NotesEligiblePostsResponse, error) {
+	if opts.MaxResults > notesMaxResults {
+		return nil, fmt.Errorf("notes eligible posts: max results [%d] is greater than max [%d]: %w", opts.MaxResults, notesMaxResults, ErrParameter)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, notesEligiblePostsEndpoint.url(c.Host), nil)
+	if err != nil {
+		return nil, fmt.Errorf("notes eligible posts request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.authorize(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("notes eligible posts response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[[]*TweetObj, *NotesSearchMeta](resp, "notes eligible posts", http.StatusOK, c.Strict)
+}
+
+// NotesWritten returns the community notes written by the authorized user
+func (c *Client) NotesWritten(ctx context.Context, opts NotesWrittenOpts) (*NotesWrittenResponse, error) {
+	if opts.MaxResults > notesMaxResults {
+		return nil, fmt.Errorf("notes written: max results [%d] is greater than max [%d]: %w", opts.MaxResults, notesMaxResults, ErrParameter)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, notesWrittenEndpoint.url(c.Host), nil)
+	if err != nil {
+		return nil, fmt.Errorf("notes written request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.authorize(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("notes written response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[[]*NoteObj, *NotesSearchMeta](resp, "notes written", http.StatusOK, c.Strict)
+}
+
+// CreateNote submits a community note for a post
+func (c *Client) CreateNote(ctx context.Context, note CreateNoteRequest) (*CreateNoteResponse, error) {
+	if err := note.validate(); err != nil {
+		return nil, err
+	}
+	enc, err := json.Marshal(note)
+	if err != nil {
+		return nil, fmt.Errorf("create note body encoding: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, notesEndpoint.url(c.Host), bytes.NewReader(enc))
+	if err != nil {
+		return nil, fmt.Errorf("create note request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Content-Type", "application/json")
+	c.authorize(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("create note response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[*CreateNoteData, NoMeta](resp, "create note", http.StatusCreated, c.Strict)
+}
+
+// EvaluateNote scores the text of a community note for a post before it is submitted
+func (c *Client) EvaluateNote(ctx context.Context, evaluate EvaluateNoteRequest) (*EvaluateNoteResponse, error) {
+	switch {
+	case len(evaluate.PostID) == 0:
+		return nil, fmt.Errorf("evaluate note: a post id is required: %w", ErrParameter)
+	case len(evaluate.NoteText) == 0:
+		return nil, fmt.Errorf("evaluate note: the note text is required: %w", ErrParameter)
+	default:
+	}
+	enc, err := json.Marshal(evaluate)
+	if err != nil {
+		return nil, fmt.Errorf("evaluate note body encoding: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, evaluateNoteEndpoint.url(c.Host), bytes.NewReader(enc))
+	if err != nil {
+		return nil, fmt.Errorf("evaluate note request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Content-Type", "application/json")
+	c.authorize(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("evaluate note response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[*EvaluateNoteData, NoMeta](resp, "evaluate note", http.StatusOK, c.Strict)
+}
+
+// TweetUsage returns the number of posts the project has consumed in the current cap period and its monthly cap
+func (c *Client) TweetUsage(ctx context.Context, opts TweetUsageOpts) (*TweetUsageResponse, error) {
+	switch {
+	case opts.Days != 0 && (opts.Days < tweetUsageMinDays || opts.Days > tweetUsageMaxDays):
+		return nil, fmt.Errorf("tweet usage: days [%d] must be between [%d] and [%d]: %w", opts.Days, tweetUsageMinDays, tweetUsageMaxDays, ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tweetUsageEndpoint.url(c.Host), nil)
+	if err != nil {
+		return nil, fmt.Errorf("tweet usage request: %w", err)
+	}
+	req.Header.Add("Accept", "application/json")
+	c.authorize(req)
+	opts.addQuery(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("tweet usage response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[*TweetUsageObj, NoMeta](resp, "tweet usage", http.StatusOK, c.Strict)
+}
+
+// CreateAccountActivityWebhook registers the webhook URL to the account activity environment.  Twitter will send a CRC
+// challenge to the URL, see WebhookHandler, before the webhook is registered.
+func (c *Client) CreateAccountActivityWebhook(ctx context.Context, env, webhookURL string) (*AccountActivityWebhookResponse, error) {
+	switch {
+	case len(env) == 0:
+		return nil, fmt.Errorf("create account activity webhook: an environment is required: %w", ErrParameter)
+	case len(webhookURL) == 0:
+		return nil, fmt.Errorf("create account activity webhook: a url is required: %w", ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, accountActivityWebhooksEndpoint.urlID(c.Host, env), nil)
+	if err != nil {
+		return nil, fmt.Errorf("create account activity webhook request: %w", err)
+	}
+	q := req.URL.Query()
+	q.Add("url", webhookURL)
+	req.URL.RawQuery = q.Encode()
+
+	webhook := &AccountActivityWebhook{}
+	rl, err := c.classic(req, "create account activity webhook", http.StatusOK, webhook)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountActivityWebhookResponse{
+		Webhook:   webhook,
+		RateLimit: rl,
+	}, nil
+}
+
+// AccountActivityWebhooks returns the webhooks registered to the account activity environment
+func (c *Client) AccountActivityWebhooks(ctx context.Context, env string) (*AccountActivityWebhooksResponse, error) {
+	if len(env) == 0 {
+		return nil, fmt.Errorf("account activity webhooks: an environment is required: %w", ErrParameter)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, accountActivityWebhooksEndpoint.urlID(c.Host, env), nil)
+	if err != nil {
+		return nil, fmt.Errorf("account activity webhooks request: %w", err)
+	}
+
+	webhooks := []*AccountActivityWebhook{}
+	rl, err := c.classic(req, "account activity webhooks", http.StatusOK, &webhooks)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountActivityWebhooksResponse{
+		Webhooks:  webhooks,
+		RateLimit: rl,
+	}, nil
+}
+
+// DeleteAccountActivityWebhook removes the webhook from the account activity environment, the subscriptions are kept
+func (c *Client) DeleteAccountActivityWebhook(ctx context.Context, env, webhookID string) (*AccountActivityResponse, error) {
+	switch {
+	case len(env) == 0:
+		return nil, fmt.Errorf("delete account activity webhook: an environment is required: %w", ErrParameter)
+	case len(webhookID) == 0:
+		return nil, fmt.Errorf("delete account activity webhook: a webhook id is required: %w", ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, accountActivityWebhookURL(c.Host, env, webhookID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("delete account activity webhook request: %w", err)
+	}
+
+	rl, err := c.classic(req, "delete account activity webhook", http.StatusNoContent, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountActivityResponse{
+		RateLimit: rl,
+	}, nil
+}
+
+// TriggerAccountActivityCRC asks twitter to send a CRC challenge to the webhook, which will revalidate an invalid webhook
+func (c *Client) TriggerAccountActivityCRC(ctx context.Context, env, webhookID string) (*AccountActivityResponse, error) {
+	switch {
+	case len(env) == 0:
+		return nil, fmt.Errorf("trigger account activity crc: an environment is required: %w", ErrParameter)
+	case len(webhookID) == 0:
+		return nil, fmt.Errorf("trigger account activity crc: a webhook id is required: %w", ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, accountActivityWebhookURL(c.Host, env, webhookID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("trigger account activity crc request: %w", err)
+	}
+
+	rl, err := c.classic(req, "trigger account activity crc", http.StatusNoContent, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountActivityResponse{
+		RateLimit: rl,
+	}, nil
+}
+
+// AddAccountActivitySubscription subscribes the authorizing user to the account activity environment.  The request
+// requires the user context of the user that is subscribed.
+func (c *Client) AddAccountActivitySubscription(ctx context.Context, env string) (*AccountActivityResponse, error) {
+	if len(env) == 0 {
+		return nil, fmt.Errorf("add account activity subscription: an environment is required: %w", ErrParameter)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, accountActivitySubscriptionsEndpoint.urlID(c.Host, env), nil)
+	if err != nil {
+		return nil, fmt.Errorf("add account activity subscription request: %w", err)
+	}
+
+	rl, err := c.classic(req, "add account activity subscription", http.StatusNoContent, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountActivityResponse{
+		RateLimit: rl,
+	}, nil
+}
+
+// AccountActivitySubscribed returns if the authorizing user is subscribed to the account activity environment.  The
+// request requires the user context of the user.
+func (c *Client) AccountActivitySubscribed(ctx context.Context, env string) (*AccountActivitySubscribedResponse, error) {
+	if len(env) == 0 {
+		return nil, fmt.Errorf("account activity subscribed: an environment is required: %w", ErrParameter)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, accountActivitySubscriptionsEndpoint.urlID(c.Host, env), nil)
+	if err != nil {
+		return nil, fmt.Errorf("account activity subscribed request: %w", err)
+	}
+
+	rl, err := c.classic(req, "account activity subscribed", http.StatusNoContent, nil)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return &AccountActivitySubscribedResponse{
+			Subscribed: false,
+		}, nil
+	case err != nil:
+		return nil, err
+	default:
+	}
+	return &AccountActivitySubscribedResponse{
+		Subscribed: true,
+		RateLimit:  rl,
+	}, nil
+}
+
+// DeleteAccountActivitySubscription removes the user's subscription from the account activity environment
+func (c *Client) DeleteAccountActivitySubscription(ctx context.Context, env, userID string) (*AccountActivityResponse, error) {
+	switch {
+	case len(env) == 0:
+		return nil, fmt.Errorf("delete account activity subscription: an environment is required: %w", ErrParameter)
+	case len(userID) == 0:
+		return nil, fmt.Errorf("delete account activity subscription: a user id is required: %w", ErrParameter)
+	default:
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, accountActivitySubscriptionURL(c.Host, env, userID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("delete account activity subscription request: %w", err)
+	}
+
+	rl, err := c.classic(req, "delete account activity subscription", http.StatusNoContent, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountActivityResponse{
+		RateLimit: rl,
+	}, nil
+}
+
+// AccountActivitySubscriptions returns the users subscribed to the account activity environment
+func (c *Client) AccountActivitySubscriptions(ctx context.Context, env string) (*AccountActivitySubscriptionsResponse, error) {
+	if len(env) == 0 {
+		return nil, fmt.Errorf("account activity subscriptions: an environment is required: %w", ErrParameter)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, accountActivitySubscriptionsListEndpoint.urlID(c.Host, env), nil)
+	if err != nil {
+		return nil, fmt.Errorf("account activity subscriptions request: %w", err)
+	}
+
+	subscriptions := &AccountActivitySubscriptionsResponse{}
+	rl, err := c.classic(req, "account activity subscriptions", http.StatusOK, subscriptions)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions.RateLimit = rl
+	return subscriptions, nil
+}
+
+// GeoPlaceLookup returns the place of the id, like the place id of a geo tagged tweet
+func (c *Client) GeoPlaceLookup(ctx context.Context, placeID string) (*GeoPlaceResponse, error) {
+	if len(placeID) == 0 {
+		return nil, fmt.Errorf("geo place lookup: an id is required: %w", ErrParameter)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, geoPlaceEndpoint.urlID(c.Host, placeID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("geo place lookup request: %w", err)
+	}
+
+	place := &GeoPlace{}
+	rl, err := c.classic(req, "geo place lookup", http.StatusOK, place)
+	if err != nil {
+		return nil, err
+	}
+	return &GeoPlaceResponse{
+		Place:     place,
+		RateLimit: rl,
+	}, nil
+}
+
+// GeoSearch returns the places of a name, the places near a latitude and longitude or the places of an IP address
+func (c *Client) GeoSearch(ctx context.Context, opts GeoSearchOpts) (*GeoSearchResponse, error) {
+	if len(opts.Query) == 0 && len(opts.IP) == 0 && !opts.coordinates() {
+		return nil, fmt.Errorf("geo search: a query, coordinates or ip is required: %w", ErrParameter)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, geoSearchEndpoint.url(c.Host), nil)
+	if err != nil {
+		return nil, fmt.Errorf("geo search request: %w", err)
+	}
+	opts.addQuery(req)
+
+	result := &geoSearchResult{}
+	rl, err := c.classic(req, "geo search", http.StatusOK, result)
+	if err != nil {
+		return nil, err
+	}
+	return &GeoSearchResponse{
+		Places:    result.Result.Places,
+		RateLimit: rl,
+	}, nil
+}
+
+func (c *Client) classic(req *http.Request, name string, status int, v interface{}) (*RateLimit, error) {
+	req.Header.Add("Accept", "application/json")
+	c.authorize(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s response: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	return decodeClassic(resp, name, status, v)
+}
@@ -0,0 +1,163 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func classicTestClient(method, path string, status int, body string) *Client {
+	return &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != method {
+				log.Panicf("the method is not correct %s %s", req.Method, method)
+			}
+			if req.URL.Path != path {
+				log.Panicf("the url is not correct %s %s", req.URL.Path, path)
+			}
+			return &http.Response{
+				StatusCode: status,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     responseTestHeader(),
+				Request:    req,
+			}
+		}),
+	}
+}
+
+func TestClient_CreateAccountActivityWebhook(t *testing.T) {
+	body := `{"id":"1234","url":"https://example.com/webhooks/twitter","valid":true,"created_timestamp":"2016-06-02T23:54:02Z"}`
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodPost {
+				log.Panicf("the method is not correct %s %s", req.Method, http.MethodPost)
+			}
+			if req.URL.Path != "/1.1/account_activity/all/dev/webhooks.json" {
+				log.Panicf("the url is not correct %s", req.URL.Path)
+			}
+			if req.URL.Query().Get("url") != "https://example.com/webhooks/twitter" {
+				log.Panicf("the webhook url is not correct %s", req.URL.Query().Get("url"))
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+	got, err := c.CreateAccountActivityWebhook(context.Background(), "dev", "https://example.com/webhooks/twitter")
+	if err != nil {
+		t.Fatalf("Client.CreateAccountActivityWebhook() error = %v", err)
+	}
+	want := &AccountActivityWebhook{
+		ID:               "1234",
+		URL:              "https://example.com/webhooks/twitter",
+		Valid:            true,
+		CreatedTimestamp: "2016-06-02T23:54:02Z",
+	}
+	if !reflect.DeepEqual(got.Webhook, want) {
+		t.Errorf("Client.CreateAccountActivityWebhook() = %v, want %v", got.Webhook, want)
+	}
+	if got.RateLimit == nil || got.RateLimit.Remaining != 12 {
+		t.Errorf("Client.CreateAccountActivityWebhook() rate limit = %v", got.RateLimit)
+	}
+
+	if _, err := c.CreateAccountActivityWebhook(context.Background(), "dev", ""); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.CreateAccountActivityWebhook() error = %v, want %v", err, ErrParameter)
+	}
+}
+
+func TestClient_AccountActivityWebhooks(t *testing.T) {
+	body := `[{"id":"1234","url":"https://example.com/webhooks/twitter","valid":false,"created_timestamp":"2016-06-02T23:54:02Z"}]`
+	c := classicTestClient(http.MethodGet, "/1.1/account_activity/all/dev/webhooks.json", http.StatusOK, body)
+	got, err := c.AccountActivityWebhooks(context.Background(), "dev")
+	if err != nil {
+		t.Fatalf("Client.AccountActivityWebhooks() error = %v", err)
+	}
+	if len(got.Webhooks) != 1 || got.Webhooks[0].ID != "1234" || got.Webhooks[0].Valid {
+		t.Errorf("Client.AccountActivityWebhooks() = %v", got.Webhooks)
+	}
+}
+
+func TestClient_DeleteAccountActivityWebhook(t *testing.T) {
+	c := classicTestClient(http.MethodDelete, "/1.1/account_activity/all/dev/webhooks/1234.json", http.StatusNoContent, "")
+	if _, err := c.DeleteAccountActivityWebhook(context.Background(), "dev", "1234"); err != nil {
+		t.Errorf("Client.DeleteAccountActivityWebhook() error = %v", err)
+	}
+
+	c = classicTestClient(http.MethodDelete, "/1.1/account_activity/all/dev/webhooks/1234.json", http.StatusUnauthorized, `{"errors":[{"code":32,"message":"Could not authenticate you."}]}`)
+	_, err := c.DeleteAccountActivityWebhook(context.Background(), "dev", "1234")
+	errResp := &ErrorResponse{}
+	if !errors.As(err, &errResp) || !errors.Is(err, ErrUnauthorized) || errResp.Errors[0].Message != "Could not authenticate you." {
+		t.Errorf("Client.DeleteAccountActivityWebhook() error = %v", err)
+	}
+}
+
+func TestClient_TriggerAccountActivityCRC(t *testing.T) {
+	c := classicTestClient(http.MethodPut, "/1.1/account_activity/all/dev/webhooks/1234.json", http.StatusNoContent, "")
+	if _, err := c.TriggerAccountActivityCRC(context.Background(), "dev", "1234"); err != nil {
+		t.Errorf("Client.TriggerAccountActivityCRC() error = %v", err)
+	}
+}
+
+func TestClient_AccountActivitySubscriptions(t *testing.T) {
+	c := classicTestClient(http.MethodPost, "/1.1/account_activity/all/dev/subscriptions.json", http.StatusNoContent, "")
+	if _, err := c.AddAccountActivitySubscription(context.Background(), "dev"); err != nil {
+		t.Errorf("Client.AddAccountActivitySubscription() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   bool
+	}{
+		{
+			name:   "subscribed",
+			status: http.StatusNoContent,
+			want:   true,
+		},
+		{
+			name:   "not subscribed",
+			status: http.StatusNotFound,
+			body:   `{"errors":[{"code":34,"message":"Sorry, that page does not exist."}]}`,
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := classicTestClient(http.MethodGet, "/1.1/account_activity/all/dev/subscriptions.json", tt.status, tt.body)
+			got, err := c.AccountActivitySubscribed(context.Background(), "dev")
+			if err != nil {
+				t.Fatalf("Client.AccountActivitySubscribed() error = %v", err)
+			}
+			if got.Subscribed != tt.want {
+				t.Errorf("Client.AccountActivitySubscribed() = %v, want %v", got.Subscribed, tt.want)
+			}
+		})
+	}
+
+	body := `{"environment":"dev","application_id":"13090192","subscriptions":[{"user_id":"3001969357"}]}`
+	c = classicTestClient(http.MethodGet, "/1.1/account_activity/all/dev/subscriptions/list.json", http.StatusOK, body)
+	got, err := c.AccountActivitySubscriptions(context.Background(), "dev")
+	if err != nil {
+		t.Fatalf("Client.AccountActivitySubscriptions() error = %v", err)
+	}
+	if got.Environment != "dev" || len(got.Subscriptions) != 1 || got.Subscriptions[0].UserID != "3001969357" {
+		t.Errorf("Client.AccountActivitySubscriptions() = %v", got)
+	}
+
+	c = classicTestClient(http.MethodDelete, "/1.1/account_activity/all/dev/subscriptions/3001969357.json", http.StatusNoContent, "")
+	if _, err := c.DeleteAccountActivitySubscription(context.Background(), "dev", "3001969357"); err != nil {
+		t.Errorf("Client.DeleteAccountActivitySubscription() error = %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func notesTestClient(method string, ep endpoint, status int, check func(req *http.Request), body string) *Client {
+	return &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != method {
+				log.Panicf("the method is not correct %s %s", req.Method, method)
+			}
+			if req.URL.Path != ep.url("") {
+				log.Panicf("the url is not correct %s %s", req.URL.Path, ep)
+			}
+			check(req)
+			return &http.Response{
+				StatusCode: status,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+}
+
+func TestClient_NotesEligiblePosts(t *testing.T) {
+	c := notesTestClient(http.MethodGet, notesEligiblePostsEndpoint, http.StatusOK, func(req *http.Request) {
+		if q := req.URL.Query(); q.Get("test_mode") != "true" || q.Get("max_results") != "10" || q.Get("tweet.fields") != "author_id" {
+			log.Panicf("the query is not correct %s", req.URL.RawQuery)
+		}
+	}, `{"data":[{"id":"1933207126262096118","text":"a claim","author_id":"2244994945"}],"meta":{"result_count":1,"next_token":"n1"}}`)
+
+	got, err := c.NotesEligiblePosts(context.Background(), NotesEligiblePostsOpts{
+		TestMode:    true,
+		MaxResults:  10,
+		TweetFields: []TweetField{TweetFieldAuthorID},
+	})
+	if err != nil {
+		t.Fatalf("Client.NotesEligiblePosts() error = %v", err)
+	}
+	if len(got.Data) != 1 || got.Data[0].AuthorID != "2244994945" || got.Meta.NextToken != "n1" || got.RateLimit.Remaining != 12 {
+		t.Errorf("Client.NotesEligiblePosts() = %+v", got)
+	}
+
+	if _, err := c.NotesEligiblePosts(context.Background(), NotesEligiblePostsOpts{MaxResults: 101}); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.NotesEligiblePosts() error = %v, want %v", err, ErrParameter)
+	}
+}
+
+func TestClient_NotesWritten(t *testing.T) {
+	c := notesTestClient(http.MethodGet, notesWrittenEndpoint, http.StatusOK, func(req *http.Request) {
+		if q := req.URL.Query(); q.Get("test_mode") != "true" || q.Get("note.fields") != "id,info,status" {
+			log.Panicf("the query is not correct %s", req.URL.RawQuery)
+		}
+	}, `{
+		"data":[{
+			"id":"1933207562377461884",
+			"info":{"text":"The claim is disputed https://example.com","classification":"misinformed_or_potentially_misleading","misleading_tags":["disputed_claim_as_fact"],"trustworthy_sources":true,"post_id":"1933207126262096118"},
+			"status":"needs_more_ratings"
+		}],
+		"meta":{"result_count":1}
+	}`)
+
+	got, err := c.NotesWritten(context.Background(), NotesWrittenOpts{
+		TestMode:   true,
+		NoteFields: []NoteField{NoteFieldID, NoteFieldInfo, NoteFieldStatus},
+	})
+	if err != nil {
+		t.Fatalf("Client.NotesWritten() error = %v", err)
+	}
+	note := got.Data[0]
+	if note.Info.Classification != NoteClassificationMisleading || note.Info.MisleadingTags[0] != NoteMisleadingTagDisputedClaimAsFact || note.Status != "needs_more_ratings" {
+		t.Errorf("Client.NotesWritten() = %+v", note.Info)
+	}
+}
+
+func TestClient_CreateNote(t *testing.T) {
+	c := notesTestClient(http.MethodPost, notesEndpoint, http.StatusCreated, func(req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		want := `{"test_mode":true,"post_id":"1933207126262096118","info":{"text":"The claim is disputed https://example.com","classification":"misinformed_or_potentially_misleading","misleading_tags":["disputed_claim_as_fact"],"trustworthy_sources":true}}`
+		if string(body) != want {
+			log.Panicf("the body is not correct %s", body)
+		}
+	}, `{"data":{"note_id":"1933207562377461884"}}`)
+
+	note := CreateNoteRequest{
+		TestMode: true,
+		PostID:   "1933207126262096118",
+		Info: NoteInfoObj{
+			Text:               "The claim is disputed https://example.com",
+			Classification:     NoteClassificationMisleading,
+			MisleadingTags:     []NoteMisleadingTag{NoteMisleadingTagDisputedClaimAsFact},
+			TrustworthySources: true,
+		},
+	}
+	got, err := c.CreateNote(context.Background(), note)
+	if err != nil {
+		t.Fatalf("Client.CreateNote() error = %v", err)
+	}
+	if got.Data.NoteID != "1933207562377461884" {
+		t.Errorf("Client.CreateNote() = %+v", got.Data)
+	}
+
+	note.Info.MisleadingTags = nil
+	if _, err := c.CreateNote(context.Background(), note); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.CreateNote() error = %v, want %v", err, ErrParameter)
+	}
+}
+
+func TestClient_EvaluateNote(t *testing.T) {
+	c := notesTestClient(http.MethodPost, evaluateNoteEndpoint, http.StatusOK, func(req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		if want := `{"post_id":"1933207126262096118","note_text":"The claim is disputed"}`; string(body) != want {
+			log.Panicf("the body is not correct %s", body)
+		}
+	}, `{"data":{"claim_opinion_score":0.25}}`)
+
+	got, err := c.EvaluateNote(context.Background(), EvaluateNoteRequest{PostID: "1933207126262096118", NoteText: "The claim is disputed"})
+	if err != nil {
+		t.Fatalf("Client.EvaluateNote() error = %v", err)
+	}
+	if got.Data.ClaimOpinionScore != 0.25 {
+		t.Errorf("Client.EvaluateNote() = %+v", got.Data)
+	}
+
+	if _, err := c.EvaluateNote(context.Background(), EvaluateNoteRequest{PostID: "1933207126262096118"}); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.EvaluateNote() error = %v, want %v", err, ErrParameter)
+	}
+}
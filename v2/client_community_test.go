@@ -0,0 +1,270 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_CommunityLookup(t *testing.T) {
+	type fields struct {
+		Authorizer Authorizer
+		Client     *http.Client
+		Host       string
+	}
+	type args struct {
+		id   string
+		opts CommunityLookupOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *CommunityLookupResponse
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodGet {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+					}
+					if strings.Contains(req.URL.String(), communityLookupEndpoint.urlID("", "1471580197908586507")) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), communityLookupEndpoint)
+					}
+					if req.URL.Query().Get("community.fields") != "access,member_count" {
+						log.Panicf("the fields are not correct %s", req.URL.Query().Get("community.fields"))
+					}
+					body := `{
+						"data": {
+							"id": "1471580197908586507",
+							"name": "Gophers",
+							"access": "Public",
+							"member_count": 5120
+						}
+					}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header:     responseTestHeader(),
+					}
+				}),
+			},
+			args: args{
+				id: "1471580197908586507",
+				opts: CommunityLookupOpts{
+					CommunityFields: []CommunityField{CommunityFieldAccess, CommunityFieldMemberCount},
+				},
+			},
+			want: &CommunityLookupResponse{
+				Data: &CommunityObj{
+					ID:          "1471580197908586507",
+					Name:        "Gophers",
+					Access:      CommunityAccessPublic,
+					MemberCount: 5120,
+				},
+				RateLimit: &RateLimit{
+					Limit:     15,
+					Remaining: 12,
+					Reset:     Epoch(1644461060),
+				},
+				ResponseMeta: &ResponseMeta{
+					StatusCode: http.StatusOK,
+					Header:     responseTestHeader(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no id",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be sent %s", req.URL.String())
+					return nil
+				}),
+			},
+			args:    args{},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: tt.fields.Authorizer,
+				Client:     tt.fields.Client,
+				Host:       tt.fields.Host,
+			}
+			got, err := c.CommunityLookup(context.Background(), tt.args.id, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.CommunityLookup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.CommunityLookup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_CommunitySearch(t *testing.T) {
+	type fields struct {
+		Authorizer Authorizer
+		Client     *http.Client
+		Host       string
+	}
+	type args struct {
+		query string
+		opts  CommunitySearchOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *CommunitySearchResponse
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodGet {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+					}
+					if strings.Contains(req.URL.String(), communitySearchEndpoint.url("")) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), communitySearchEndpoint)
+					}
+					if req.URL.Query().Get("query") != "golang" || req.URL.Query().Get("max_results") != "10" {
+						log.Panicf("the query is not correct %s", req.URL.RawQuery)
+					}
+					body := `{
+						"data": [
+							{
+								"id": "1471580197908586507",
+								"name": "Gophers",
+								"access": "Public",
+								"join_policy": "Open",
+								"member_count": 5120
+							},
+							{
+								"id": "1471580197908586508",
+								"name": "Go Maintainers",
+								"access": "Closed",
+								"join_policy": "RestrictedJoinRequestsRequireAdminApproval",
+								"member_count": 42
+							}
+						],
+						"meta": {
+							"result_count": 2,
+							"next_token": "7140dibdnow9c7btw3w29n4v5pr5n8tpch3oe6uoqnz8n"
+						}
+					}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header:     responseTestHeader(),
+					}
+				}),
+			},
+			args: args{
+				query: "golang",
+				opts: CommunitySearchOpts{
+					MaxResults: 10,
+				},
+			},
+			want: &CommunitySearchResponse{
+				Data: []*CommunityObj{
+					{
+						ID:          "1471580197908586507",
+						Name:        "Gophers",
+						Access:      CommunityAccessPublic,
+						JoinPolicy:  CommunityJoinPolicyOpen,
+						MemberCount: 5120,
+					},
+					{
+						ID:          "1471580197908586508",
+						Name:        "Go Maintainers",
+						Access:      CommunityAccessClosed,
+						JoinPolicy:  CommunityJoinPolicyRestrictedJoinRequests,
+						MemberCount: 42,
+					},
+				},
+				Meta: &CommunitySearchMeta{
+					ResultCount: 2,
+					NextToken:   "7140dibdnow9c7btw3w29n4v5pr5n8tpch3oe6uoqnz8n",
+				},
+				RateLimit: &RateLimit{
+					Limit:     15,
+					Remaining: 12,
+					Reset:     Epoch(1644461060),
+				},
+				ResponseMeta: &ResponseMeta{
+					StatusCode: http.StatusOK,
+					Header:     responseTestHeader(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no query",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be sent %s", req.URL.String())
+					return nil
+				}),
+			},
+			args:    args{},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "max results",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be sent %s", req.URL.String())
+					return nil
+				}),
+			},
+			args: args{
+				query: "golang",
+				opts: CommunitySearchOpts{
+					MaxResults: 5,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: tt.fields.Authorizer,
+				Client:     tt.fields.Client,
+				Host:       tt.fields.Host,
+			}
+			got, err := c.CommunitySearch(context.Background(), tt.args.query, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.CommunitySearch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.CommunitySearch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_DMEventsLookup(t *testing.T) {
+	type fields struct {
+		Authorizer Authorizer
+		Client     *http.Client
+		Host       string
+	}
+	type args struct {
+		opts DMEventsLookupOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *DMEventsLookupResponse
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodGet {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+					}
+					if strings.Contains(req.URL.String(), dmEventsEndpoint.url("")) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), dmEventsEndpoint)
+					}
+					if req.URL.Query().Get("event_types") != "MessageCreate,ParticipantsJoin" {
+						log.Panicf("the event types are not correct %s", req.URL.Query().Get("event_types"))
+					}
+					body := `{
+						"data": [
+							{
+								"id": "1580705921830768643",
+								"event_type": "MessageCreate",
+								"text": "Hello just you...",
+								"sender_id": "906948460078698496",
+								"dm_conversation_id": "1346889436626259968"
+							},
+							{
+								"id": "1578900353814519810",
+								"event_type": "ParticipantsJoin",
+								"participant_ids": ["906948460078698496"],
+								"dm_conversation_id": "1578900353814519808"
+							}
+						],
+						"meta": {
+							"result_count": 2,
+							"next_token": "18LAA581J5II7LA00C00ZZZZ"
+						}
+					}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header:     responseTestHeader(),
+					}
+				}),
+			},
+			args: args{
+				opts: DMEventsLookupOpts{
+					EventTypes: []DMEventType{DMEventTypeMessageCreate, DMEventTypeParticipantsJoin},
+				},
+			},
+			want: &DMEventsLookupResponse{
+				Data: []*DMEventObj{
+					{
+						ID:               "1580705921830768643",
+						EventType:        DMEventTypeMessageCreate,
+						Text:             "Hello just you...",
+						SenderID:         "906948460078698496",
+						DMConversationID: "1346889436626259968",
+					},
+					{
+						ID:               "1578900353814519810",
+						EventType:        DMEventTypeParticipantsJoin,
+						ParticipantIDs:   []string{"906948460078698496"},
+						DMConversationID: "1578900353814519808",
+					},
+				},
+				Meta: &DMEventsLookupMeta{
+					ResultCount: 2,
+					NextToken:   "18LAA581J5II7LA00C00ZZZZ",
+				},
+				RateLimit: &RateLimit{
+					Limit:     15,
+					Remaining: 12,
+					Reset:     Epoch(1644461060),
+				},
+				ResponseMeta: &ResponseMeta{
+					StatusCode: http.StatusOK,
+					Header:     responseTestHeader(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "max results",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+			},
+			args: args{
+				opts: DMEventsLookupOpts{
+					MaxResults: 101,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "bad request",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					body := `{
+						"title": "Unauthorized",
+						"type": "about:blank",
+						"status": 401,
+						"detail": "Unauthorized"
+					}`
+					return &http.Response{
+						StatusCode: http.StatusUnauthorized,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header:     responseTestHeader(),
+					}
+				}),
+			},
+			args:    args{},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: tt.fields.Authorizer,
+				Client:     tt.fields.Client,
+				Host:       tt.fields.Host,
+			}
+			got, err := c.DMEventsLookup(context.Background(), tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.DMEventsLookup() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.DMEventsLookup() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_DMConversationEventsLookup(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if strings.Contains(req.URL.String(), dmConversationEventsEndpoint.urlID("", "1346889436626259968")) == false {
+				log.Panicf("the url is not correct %s %s", req.URL.String(), dmConversationEventsEndpoint)
+			}
+			if req.URL.Query().Get("pagination_token") != "next" {
+				log.Panicf("the pagination token is not correct %s", req.URL.Query().Get("pagination_token"))
+			}
+			body := `{"data":[{"id":"1580705921830768643","event_type":"MessageCreate","text":"Hello"}],"meta":{"result_count":1}}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{},
+			}
+		}),
+	}
+	got, err := c.DMConversationEventsLookup(context.Background(), "1346889436626259968", DMEventsLookupOpts{PaginationToken: "next"})
+	if err != nil {
+		t.Fatalf("Client.DMConversationEventsLookup() error = %v", err)
+	}
+	if len(got.Data) != 1 || !got.Data[0].IsMessage() {
+		t.Errorf("Client.DMConversationEventsLookup() events = %v", got.Data)
+	}
+	if _, err := c.DMConversationEventsLookup(context.Background(), "", DMEventsLookupOpts{}); err == nil {
+		t.Errorf("Client.DMConversationEventsLookup() expected a parameter error")
+	}
+}
+
+func TestClient_DMParticipantEventsLookup(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if strings.Contains(req.URL.String(), dmParticipantEventsEndpoint.urlID("", "906948460078698496")) == false {
+				log.Panicf("the url is not correct %s %s", req.URL.String(), dmParticipantEventsEndpoint)
+			}
+			body := `{"data":[{"id":"1580705921830768643","event_type":"ParticipantsLeave","participant_ids":["906948460078698496"]}],"meta":{"result_count":1}}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{},
+			}
+		}),
+	}
+	got, err := c.DMParticipantEventsLookup(context.Background(), "906948460078698496", DMEventsLookupOpts{})
+	if err != nil {
+		t.Fatalf("Client.DMParticipantEventsLookup() error = %v", err)
+	}
+	if len(got.Data) != 1 || !got.Data[0].IsParticipantsLeave() {
+		t.Errorf("Client.DMParticipantEventsLookup() events = %v", got.Data)
+	}
+}
@@ -0,0 +1,189 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_SendDMToParticipant(t *testing.T) {
+	type fields struct {
+		Authorizer Authorizer
+		Client     *http.Client
+		Host       string
+	}
+	type args struct {
+		participantID string
+		message       CreateDMMessage
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *CreateDMEventResponse
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodPost {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodPost)
+					}
+					if strings.Contains(req.URL.String(), dmParticipantMessagesEndpoint.urlID("", "906948460078698496")) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), dmParticipantMessagesEndpoint)
+					}
+					msg := CreateDMMessage{}
+					if err := json.NewDecoder(req.Body).Decode(&msg); err != nil {
+						log.Panicf("the body is not correct %v", err)
+					}
+					if msg.Text != "Hello" {
+						log.Panicf("the text is not correct %s", msg.Text)
+					}
+					body := `{"data":{"dm_conversation_id":"1346889436626259968","dm_event_id":"128341038123"}}`
+					return &http.Response{
+						StatusCode: http.StatusCreated,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header:     responseTestHeader(),
+					}
+				}),
+			},
+			args: args{
+				participantID: "906948460078698496",
+				message: CreateDMMessage{
+					Text: "Hello",
+				},
+			},
+			want: &CreateDMEventResponse{
+				Data: &CreateDMEventData{
+					DMConversationID: "1346889436626259968",
+					DMEventID:        "128341038123",
+				},
+				RateLimit: &RateLimit{
+					Limit:     15,
+					Remaining: 12,
+					Reset:     Epoch(1644461060),
+				},
+				ResponseMeta: &ResponseMeta{
+					StatusCode: http.StatusCreated,
+					Header:     responseTestHeader(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no text",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+			},
+			args: args{
+				participantID: "906948460078698496",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "no participant",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+			},
+			args: args{
+				message: CreateDMMessage{
+					Text: "Hello",
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: tt.fields.Authorizer,
+				Client:     tt.fields.Client,
+				Host:       tt.fields.Host,
+			}
+			got, err := c.SendDMToParticipant(context.Background(), tt.args.participantID, tt.args.message)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.SendDMToParticipant() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.SendDMToParticipant() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_SendDMToConversation(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if strings.Contains(req.URL.String(), dmConversationMessagesEndpoint.urlID("", "1346889436626259968")) == false {
+				log.Panicf("the url is not correct %s %s", req.URL.String(), dmConversationMessagesEndpoint)
+			}
+			body := `{"errors":[{"message":"conversation not found"}],"title":"Forbidden","detail":"Forbidden","type":"about:blank"}`
+			return &http.Response{
+				StatusCode: http.StatusForbidden,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{},
+			}
+		}),
+	}
+	_, err := c.SendDMToConversation(context.Background(), "1346889436626259968", CreateDMMessage{Text: "Hello"})
+	er := &ErrorResponse{}
+	if !errors.As(err, &er) || er.StatusCode != http.StatusForbidden {
+		t.Errorf("Client.SendDMToConversation() error = %v", err)
+	}
+}
+
+func TestClient_CreateDMConversation(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if strings.HasSuffix(req.URL.Path, dmConversationsEndpoint.url("")) == false {
+				log.Panicf("the url is not correct %s %s", req.URL.String(), dmConversationsEndpoint)
+			}
+			rb := map[string]interface{}{}
+			if err := json.NewDecoder(req.Body).Decode(&rb); err != nil {
+				log.Panicf("the body is not correct %v", err)
+			}
+			if rb["conversation_type"] != "Group" {
+				log.Panicf("the conversation type is not correct %v", rb["conversation_type"])
+			}
+			body := `{"data":{"dm_conversation_id":"1346889436626259968","dm_event_id":"128341038123"}}`
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{},
+			}
+		}),
+	}
+	got, err := c.CreateDMConversation(context.Background(), CreateDMConversationRequest{
+		ParticipantIDs: []string{"944480690", "906948460078698496"},
+		Message: CreateDMMessage{
+			Text: "Hello group",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Client.CreateDMConversation() error = %v", err)
+	}
+	if got.Data.DMConversationID != "1346889436626259968" {
+		t.Errorf("Client.CreateDMConversation() = %v", got.Data)
+	}
+	if _, err := c.CreateDMConversation(context.Background(), CreateDMConversationRequest{}); err == nil {
+		t.Errorf("Client.CreateDMConversation() expected a parameter error")
+	}
+}
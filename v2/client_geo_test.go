@@ -0,0 +1,107 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const geoPlaceTestBody = `{
+	"id": "5a110d312052166f",
+	"url": "https://api.twitter.com/1.1/geo/id/5a110d312052166f.json",
+	"place_type": "city",
+	"name": "San Francisco",
+	"full_name": "San Francisco, CA",
+	"country_code": "US",
+	"country": "United States",
+	"contained_within": [{"id":"fbd6d2f5a4e4a15e","name":"California","full_name":"California, USA","place_type":"admin"}],
+	"bounding_box": {
+		"type": "Polygon",
+		"coordinates": [[[-122.514926,37.708075],[-122.357031,37.708075],[-122.357031,37.833238],[-122.514926,37.833238]]]
+	},
+	"centroid": [-122.4461400159226,37.759828999999996]
+}`
+
+func TestClient_GeoPlaceLookup(t *testing.T) {
+	c := classicTestClient(http.MethodGet, "/1.1/geo/id/5a110d312052166f.json", http.StatusOK, geoPlaceTestBody)
+	got, err := c.GeoPlaceLookup(context.Background(), "5a110d312052166f")
+	if err != nil {
+		t.Fatalf("Client.GeoPlaceLookup() error = %v", err)
+	}
+	if got.Place.FullName != "San Francisco, CA" || got.Place.ContainedWithin[0].Name != "California" {
+		t.Errorf("Client.GeoPlaceLookup() = %+v", got.Place)
+	}
+	if got.RateLimit == nil || got.RateLimit.Remaining != 12 {
+		t.Errorf("Client.GeoPlaceLookup() rate limit = %v", got.RateLimit)
+	}
+
+	place := got.Place.PlaceObj()
+	want := &PlaceObj{
+		ID:              "5a110d312052166f",
+		FullName:        "San Francisco, CA",
+		Name:            "San Francisco",
+		Country:         "United States",
+		CountryCode:     "US",
+		PlaceType:       "city",
+		ContainedWithin: []string{"fbd6d2f5a4e4a15e"},
+		Geo: &PlaceGeoObj{
+			Type:       "Feature",
+			BBox:       []float64{-122.514926, 37.708075, -122.357031, 37.833238},
+			Properties: map[string]interface{}{},
+		},
+	}
+	if !reflect.DeepEqual(place, want) {
+		t.Errorf("GeoPlace.PlaceObj() = %+v, want %+v", place, want)
+	}
+
+	if _, err := c.GeoPlaceLookup(context.Background(), ""); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.GeoPlaceLookup() error = %v, want %v", err, ErrParameter)
+	}
+}
+
+func TestClient_GeoPlaceLookupNotFound(t *testing.T) {
+	c := classicTestClient(http.MethodGet, "/1.1/geo/id/0.json", http.StatusNotFound, `{"errors":[{"code":6,"message":"No data available for specified ID."}]}`)
+	if _, err := c.GeoPlaceLookup(context.Background(), "0"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Client.GeoPlaceLookup() error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestClient_GeoSearch(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != "/1.1/geo/search.json" {
+				log.Panicf("the url is not correct %s", req.URL.Path)
+			}
+			if q := req.URL.Query(); q.Get("lat") != "37.7821" || q.Get("long") != "-122.4093" || q.Get("granularity") != "city" {
+				log.Panicf("the query is not correct %s", req.URL.RawQuery)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"query":{"type":"reverse_geocode"},"result":{"places":[` + geoPlaceTestBody + `]}}`)),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+	got, err := c.GeoSearch(context.Background(), GeoSearchOpts{
+		Latitude:    37.7821,
+		Longitude:   -122.4093,
+		Granularity: GeoGranularityCity,
+	})
+	if err != nil {
+		t.Fatalf("Client.GeoSearch() error = %v", err)
+	}
+	if len(got.Places) != 1 || got.Places[0].ID != "5a110d312052166f" {
+		t.Errorf("Client.GeoSearch() = %+v", got.Places)
+	}
+
+	if _, err := c.GeoSearch(context.Background(), GeoSearchOpts{}); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.GeoSearch() error = %v, want %v", err, ErrParameter)
+	}
+}
@@ -2,6 +2,7 @@ package twitter
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"log"
 	"net/http"
@@ -690,3 +691,42 @@ func TestClient_SpaceTweetsLookup(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_SpacesByCreatorLookupBatches(t *testing.T) {
+	userIDs := []string{}
+	for i := 0; i < 150; i++ {
+		userIDs = append(userIDs, fmt.Sprintf("%d", i))
+	}
+	batches := []int{}
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			ids := strings.Split(req.URL.Query().Get("user_ids"), ",")
+			batches = append(batches, len(ids))
+			body := fmt.Sprintf(`{
+				"data": [{"id":"space-%s","state":"live","creator_id":"%s"}],
+				"includes": {"users": [{"id":"%s","name":"creator","username":"creator"},{"id":"host","name":"host","username":"host"}]},
+				"meta": {"result_count": 1}
+			}`, ids[0], ids[0], ids[0])
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+	got, err := c.SpacesByCreatorLookup(context.Background(), userIDs, SpacesByCreatorLookupOpts{})
+	if err != nil {
+		t.Fatalf("Client.SpacesByCreatorLookup() error = %v", err)
+	}
+	if !reflect.DeepEqual(batches, []int{100, 50}) {
+		t.Errorf("Client.SpacesByCreatorLookup() batches = %v", batches)
+	}
+	if len(got.Raw.Spaces) != 2 || got.Raw.Spaces[1].ID != "space-100" || len(got.Raw.Includes.Users) != 3 {
+		t.Errorf("Client.SpacesByCreatorLookup() = %+v", got.Raw)
+	}
+	if got.Meta.ResultCount != 2 || got.RateLimit.Remaining != 12 {
+		t.Errorf("Client.SpacesByCreatorLookup() meta = %+v rate limit = %+v", got.Meta, got.RateLimit)
+	}
+}
@@ -0,0 +1,265 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_TrendsByWOEID(t *testing.T) {
+	type fields struct {
+		Authorizer Authorizer
+		Client     *http.Client
+		Host       string
+	}
+	type args struct {
+		woeid int
+		opts  TrendsByWOEIDOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *TrendsByWOEIDResponse
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodGet {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+					}
+					if strings.Contains(req.URL.String(), trendsByWOEIDEndpoint.urlID("", "2357536")) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), trendsByWOEIDEndpoint)
+					}
+					if req.URL.Query().Get("max_trends") != "2" {
+						log.Panicf("the max trends is not correct %s", req.URL.Query().Get("max_trends"))
+					}
+					body := `{
+						"data": [
+							{
+								"trend_name": "#AustinFC",
+								"tweet_count": 15234
+							},
+							{
+								"trend_name": "SXSW"
+							}
+						]
+					}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header:     responseTestHeader(),
+					}
+				}),
+			},
+			args: args{
+				woeid: 2357536,
+				opts: TrendsByWOEIDOpts{
+					MaxTrends:   2,
+					TrendFields: []TrendField{TrendFieldTrendName, TrendFieldTweetCount},
+				},
+			},
+			want: &TrendsByWOEIDResponse{
+				Data: []*TrendObj{
+					{
+						TrendName:  "#AustinFC",
+						TweetCount: 15234,
+					},
+					{
+						TrendName: "SXSW",
+					},
+				},
+				RateLimit: &RateLimit{
+					Limit:     15,
+					Remaining: 12,
+					Reset:     Epoch(1644461060),
+				},
+				ResponseMeta: &ResponseMeta{
+					StatusCode: http.StatusOK,
+					Header:     responseTestHeader(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no woeid",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be sent %s", req.URL.String())
+					return nil
+				}),
+			},
+			args: args{
+				woeid: 0,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "too many trends",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be sent %s", req.URL.String())
+					return nil
+				}),
+			},
+			args: args{
+				woeid: 1,
+				opts: TrendsByWOEIDOpts{
+					MaxTrends: 51,
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: tt.fields.Authorizer,
+				Client:     tt.fields.Client,
+				Host:       tt.fields.Host,
+			}
+			got, err := c.TrendsByWOEID(context.Background(), tt.args.woeid, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.TrendsByWOEID() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.TrendsByWOEID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_PersonalizedTrends(t *testing.T) {
+	type fields struct {
+		Authorizer Authorizer
+		Client     *http.Client
+		Host       string
+	}
+	type args struct {
+		opts PersonalizedTrendsOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *PersonalizedTrendsResponse
+		wantErr bool
+	}{
+		{
+			name: "success",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodGet {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+					}
+					if strings.Contains(req.URL.String(), personalizedTrendsEndpoint.url("")) == false {
+						log.Panicf("the url is not correct %s %s", req.URL.String(), personalizedTrendsEndpoint)
+					}
+					if req.URL.Query().Get("personalized_trend.fields") != "category,post_count,trend_name,trending_since" {
+						log.Panicf("the fields are not correct %s", req.URL.Query().Get("personalized_trend.fields"))
+					}
+					body := `{
+						"data": [
+							{
+								"category": "Technology",
+								"post_count": "12.5K posts",
+								"trend_name": "Golang",
+								"trending_since": "Trending now"
+							}
+						]
+					}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+						Header:     responseTestHeader(),
+					}
+				}),
+			},
+			args: args{
+				opts: PersonalizedTrendsOpts{
+					PersonalizedTrendFields: []PersonalizedTrendField{
+						PersonalizedTrendFieldCategory,
+						PersonalizedTrendFieldPostCount,
+						PersonalizedTrendFieldTrendName,
+						PersonalizedTrendFieldTrendingSince,
+					},
+				},
+			},
+			want: &PersonalizedTrendsResponse{
+				Data: []*PersonalizedTrendObj{
+					{
+						Category:      "Technology",
+						PostCount:     "12.5K posts",
+						TrendName:     "Golang",
+						TrendingSince: "Trending now",
+					},
+				},
+				RateLimit: &RateLimit{
+					Limit:     15,
+					Remaining: 12,
+					Reset:     Epoch(1644461060),
+				},
+				ResponseMeta: &ResponseMeta{
+					StatusCode: http.StatusOK,
+					Header:     responseTestHeader(),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unauthorized",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					body := `{
+						"title": "Unauthorized",
+						"type": "about:blank",
+						"status": 401,
+						"detail": "Unauthorized"
+					}`
+					return &http.Response{
+						StatusCode: http.StatusUnauthorized,
+						Body:       io.NopCloser(strings.NewReader(body)),
+					}
+				}),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Authorizer: tt.fields.Authorizer,
+				Client:     tt.fields.Client,
+				Host:       tt.fields.Host,
+			}
+			got, err := c.PersonalizedTrends(context.Background(), tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client.PersonalizedTrends() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.PersonalizedTrends() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package twitter
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log"
 	"net/http"
@@ -270,3 +271,96 @@ func TestClient_RemoveTweetBookmark(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_TweetBookmarkFolders(t *testing.T) {
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodGet {
+				log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+			}
+			if req.URL.Path != tweetBookmarkFoldersEndpoint.urlID("", "user-1234") {
+				log.Panicf("the url is not correct %s", req.URL.Path)
+			}
+			if req.URL.Query().Get("max_results") != "10" || req.URL.Query().Get("pagination_token") != "token" {
+				log.Panicf("the query is not correct %s", req.URL.RawQuery)
+			}
+			body := `{
+				"data": [
+					{"id": "1146654567674912769", "name": "Reading"},
+					{"id": "1146654567674912770", "name": "Recipes"}
+				],
+				"meta": {"result_count": 2, "next_token": "next"}
+			}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+
+	got, err := client.TweetBookmarkFolders(context.Background(), "user-1234", TweetBookmarkFoldersOpts{MaxResults: 10, PaginationToken: "token"})
+	if err != nil {
+		t.Fatalf("Client.TweetBookmarkFolders() error = %v", err)
+	}
+	want := []*BookmarkFolderObj{
+		{ID: "1146654567674912769", Name: "Reading"},
+		{ID: "1146654567674912770", Name: "Recipes"},
+	}
+	if !reflect.DeepEqual(got.Data, want) {
+		t.Errorf("Client.TweetBookmarkFolders() data = %v, want %v", got.Data, want)
+	}
+	if got.Meta == nil || got.Meta.ResultCount != 2 || got.Meta.NextToken != "next" {
+		t.Errorf("Client.TweetBookmarkFolders() meta = %+v", got.Meta)
+	}
+	if got.RateLimit == nil || got.RateLimit.Remaining != 12 {
+		t.Errorf("Client.TweetBookmarkFolders() rate limit = %+v", got.RateLimit)
+	}
+
+	if _, err := client.TweetBookmarkFolders(context.Background(), "user-1234", TweetBookmarkFoldersOpts{MaxResults: 101}); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.TweetBookmarkFolders() max results error = %v", err)
+	}
+}
+
+func TestClient_TweetBookmarkFolderLookup(t *testing.T) {
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != tweetBookmarkFoldersEndpoint.urlID("", "user-1234")+"/folder-1" {
+				log.Panicf("the url is not correct %s", req.URL.Path)
+			}
+			if req.URL.Query().Get("tweet.fields") != "created_at" {
+				log.Panicf("the query is not correct %s", req.URL.RawQuery)
+			}
+			body := `{
+				"data": [
+					{"id": "1294346980072624128", "text": "I awake from five years of slumber", "created_at": "2020-08-14T18:00:00.000Z"}
+				],
+				"meta": {"result_count": 1}
+			}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+
+	got, err := client.TweetBookmarkFolderLookup(context.Background(), "user-1234", "folder-1", TweetBookmarksLookupOpts{TweetFields: []TweetField{TweetFieldCreatedAt}})
+	if err != nil {
+		t.Fatalf("Client.TweetBookmarkFolderLookup() error = %v", err)
+	}
+	if len(got.Raw.Tweets) != 1 || got.Raw.Tweets[0].ID != "1294346980072624128" || got.Raw.Tweets[0].CreatedAt != "2020-08-14T18:00:00.000Z" {
+		t.Errorf("Client.TweetBookmarkFolderLookup() tweets = %+v", got.Raw.Tweets)
+	}
+	if got.Meta == nil || got.Meta.ResultCount != 1 {
+		t.Errorf("Client.TweetBookmarkFolderLookup() meta = %+v", got.Meta)
+	}
+
+	if _, err := client.TweetBookmarkFolderLookup(context.Background(), "user-1234", "", TweetBookmarksLookupOpts{}); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.TweetBookmarkFolderLookup() folder id error = %v", err)
+	}
+}
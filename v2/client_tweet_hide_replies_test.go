@@ -69,10 +69,85 @@ func TestClient_TweetHideReplies(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "unhide",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					if req.Method != http.MethodPut {
+						log.Panicf("the method is not correct %s %s", req.Method, http.MethodPut)
+					}
+					reqBody, err := io.ReadAll(req.Body)
+					if err != nil {
+						log.Panicf("the request body read error %v", err)
+					}
+					if string(reqBody) != `{"hidden":false}` {
+						log.Panicf("the request body is not correct %s", string(reqBody))
+					}
+					body := `{"data":{"hidden":false}}`
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+					}
+				}),
+			},
+			args: args{
+				id:   "63046977",
+				hide: false,
+			},
+			want: &TweetHideReplyResponse{
+				Reply: &TweetHideReplyData{
+					Hidden: false,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "no id",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be sent %s", req.URL.String())
+					return nil
+				}),
+			},
+			args: args{
+				hide: true,
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "not the conversation author",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					body := `{
+						"title": "Forbidden",
+						"type": "about:blank",
+						"status": 403,
+						"detail": "You are not permitted to hide replies to this Tweet."
+					}`
+					return &http.Response{
+						StatusCode: http.StatusForbidden,
+						Body:       io.NopCloser(strings.NewReader(body)),
+					}
+				}),
+			},
+			args: args{
+				id:   "63046977",
+				hide: true,
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			c := Client{
+			c := &Client{
 				Authorizer: tt.fields.Authorizer,
 				Client:     tt.fields.Client,
 				Host:       tt.fields.Host,
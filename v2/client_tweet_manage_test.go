@@ -2,6 +2,7 @@ package twitter
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log"
 	"net/http"
@@ -104,6 +105,43 @@ func TestClient_CreateTweet(t *testing.T) {
 	}
 }
 
+func TestClient_QuoteTweet(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			body, _ := io.ReadAll(req.Body)
+			if want := `{"quote_tweet_id":"1455953449422516226","text":"worth a read","reply_settings":"following"}`; string(body) != want {
+				log.Panicf("the body is not correct %s", body)
+			}
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"1445880548472328192","text":"worth a read https://t.co/1"}}`)),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+	got, err := c.QuoteTweet(context.Background(), "1455953449422516226", "worth a read", QuoteTweetOpts{ReplySettings: "following"})
+	if err != nil {
+		t.Fatalf("Client.QuoteTweet() error = %v", err)
+	}
+	if got.Tweet.ID != "1445880548472328192" {
+		t.Errorf("Client.QuoteTweet() = %v", got.Tweet)
+	}
+
+	if _, err := c.QuoteTweet(context.Background(), "", "worth a read", QuoteTweetOpts{}); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.QuoteTweet() error = %v, want %v", err, ErrParameter)
+	}
+	poll := CreateTweetRequest{
+		Text:         "which?",
+		QuoteTweetID: "1455953449422516226",
+		Poll:         &CreateTweetPoll{Options: []string{"a", "b"}, DurationMinutes: 5},
+	}
+	if _, err := c.CreateTweet(context.Background(), poll); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.CreateTweet() error = %v, want %v", err, ErrParameter)
+	}
+}
+
 func TestClient_DeleteTweet(t *testing.T) {
 	type fields struct {
 		Authorizer Authorizer
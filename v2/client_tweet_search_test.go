@@ -2,6 +2,7 @@ package twitter
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log"
 	"net/http"
@@ -1252,6 +1253,52 @@ func TestClient_TweetSearchStreamDeleteRuleByValue(t *testing.T) {
 	}
 }
 
+func TestClient_TweetSearchStreamDeleteRulesByTag(t *testing.T) {
+	deleted := ""
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			body := `{
+				"data": [
+					{"id": "1", "value": "golang", "tag": "go"},
+					{"id": "2", "value": "rustlang", "tag": "rust"},
+					{"id": "3", "value": "gopher", "tag": "go"}
+				],
+				"meta": {"sent": "2019-08-29T01:48:54.633Z"}
+			}`
+			if req.Method == http.MethodPost {
+				b, _ := io.ReadAll(req.Body)
+				deleted = string(b)
+				body = `{"meta": {"sent": "2019-08-29T01:48:54.633Z", "summary": {"deleted": 2}}}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+	ctx := context.Background()
+	resp, err := c.TweetSearchStreamDeleteRulesByTag(ctx, []string{"go"}, false)
+	if err != nil {
+		t.Fatalf("Client.TweetSearchStreamDeleteRulesByTag() error = %v", err)
+	}
+	if deleted != `{"delete":{"ids":["1","3"]}}` || resp.Meta.Summary.Deleted != 2 {
+		t.Errorf("Client.TweetSearchStreamDeleteRulesByTag() deleted %s, summary %+v", deleted, resp.Meta.Summary)
+	}
+
+	deleted = ""
+	resp, err = c.TweetSearchStreamDeleteRulesByTag(ctx, []string{"python"}, false)
+	if err != nil || deleted != "" || resp.Meta.Summary.Deleted != 0 {
+		t.Errorf("Client.TweetSearchStreamDeleteRulesByTag() error = %v, deleted %s, nothing should be deleted", err, deleted)
+	}
+
+	if _, err := c.TweetSearchStreamDeleteRulesByTag(ctx, nil, false); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.TweetSearchStreamDeleteRulesByTag() error = %v, want a parameter error", err)
+	}
+}
+
 func TestClient_TweetSearchStream(t *testing.T) {
 	type fields struct {
 		Authorizer Authorizer
@@ -1375,6 +1422,23 @@ func TestClient_TweetSearchStream(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "backfill minutes over the limit",
+			fields: fields{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					log.Panicf("the request should not be sent %s", req.URL.String())
+					return nil
+				}),
+			},
+			args: args{
+				opts: TweetSearchStreamOpts{
+					BackfillMinutes: 6,
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1396,24 +1460,13 @@ func TestClient_TweetSearchStream(t *testing.T) {
 
 			tweets := []*TweetMessage{}
 			systems := []map[SystemMessageType]SystemMessage{}
-			timer := time.NewTimer(time.Second * 2)
-
-			func() {
-				defer stream.Close()
-				for {
-					select {
-					case sysMsg := <-stream.SystemMessages():
-						systems = append(systems, sysMsg)
-					case tweetMsg := <-stream.Tweets():
-						tweets = append(tweets, tweetMsg)
-					case <-timer.C:
-						return
-					case err := <-stream.Err():
-						t.Errorf("Client.TweetSearchStream() error %v", err)
-						return
-					}
-				}
-			}()
+			waitStreamEnd(t, stream)
+			for sysMsg := range stream.SystemMessages() {
+				systems = append(systems, sysMsg)
+			}
+			for tweetMsg := range stream.Tweets() {
+				tweets = append(tweets, tweetMsg)
+			}
 
 			if !reflect.DeepEqual(tweets, tt.wantTweet) {
 				t.Errorf("Client.TweetSearchStream() tweets = %v, want %v", tweets, tt.wantTweet)
@@ -1424,3 +1477,37 @@ func TestClient_TweetSearchStream(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_TweetSearchMaxResults(t *testing.T) {
+	maxResults := ""
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			maxResults = req.URL.Query().Get("max_results")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"data":[],"meta":{"result_count":0}}`)),
+			}
+		}),
+	}
+	ctx := context.Background()
+	if _, err := c.TweetRecentSearch(ctx, "golang", TweetRecentSearchOpts{MaxResults: 500}); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.TweetRecentSearch() error = %v, want a parameter error", err)
+	}
+	if _, err := c.TweetSearch(ctx, "golang", TweetSearchOpts{MaxResults: 5}); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.TweetSearch() error = %v, want a parameter error", err)
+	}
+	if _, err := c.TweetSearch(ctx, "golang", TweetSearchOpts{MaxResults: 500}); err != nil || maxResults != "500" {
+		t.Errorf("Client.TweetSearch() error = %v, max results = %s", err, maxResults)
+	}
+
+	c.ClampMaxResults = true
+	if _, err := c.TweetRecentSearch(ctx, "golang", TweetRecentSearchOpts{MaxResults: 500}); err != nil || maxResults != "100" {
+		t.Errorf("Client.TweetRecentSearch() error = %v, max results = %s, want 100", err, maxResults)
+	}
+	if _, err := c.TweetSearch(ctx, "golang", TweetSearchOpts{MaxResults: 5}); err != nil || maxResults != "10" {
+		t.Errorf("Client.TweetSearch() error = %v, max results = %s, want 10", err, maxResults)
+	}
+}
@@ -154,24 +154,13 @@ func TestClient_TweetSampleStream(t *testing.T) {
 
 			tweets := []*TweetMessage{}
 			systems := []map[SystemMessageType]SystemMessage{}
-			timer := time.NewTimer(time.Second * 5)
-
-			func() {
-				defer stream.Close()
-				for {
-					select {
-					case sysMsg := <-stream.SystemMessages():
-						systems = append(systems, sysMsg)
-					case tweetMsg := <-stream.Tweets():
-						tweets = append(tweets, tweetMsg)
-					case <-timer.C:
-						return
-					case err := <-stream.Err():
-						t.Errorf("Client.TweetSampleStream() error %v", err)
-						return
-					}
-				}
-			}()
+			waitStreamEnd(t, stream)
+			for sysMsg := range stream.SystemMessages() {
+				systems = append(systems, sysMsg)
+			}
+			for tweetMsg := range stream.Tweets() {
+				tweets = append(tweets, tweetMsg)
+			}
 
 			if !reflect.DeepEqual(tweets, tt.wantTweet) {
 				t.Errorf("Client.TweetSampleStream() tweets = %v, want %v", tweets, tt.wantTweet)
@@ -0,0 +1,72 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_TweetUsage(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodGet {
+				log.Panicf("the method is not correct %s %s", req.Method, http.MethodGet)
+			}
+			if strings.Contains(req.URL.String(), tweetUsageEndpoint.url("")) == false {
+				log.Panicf("the url is not correct %s %s", req.URL.String(), tweetUsageEndpoint)
+			}
+			if got := req.URL.Query().Get("usage.fields"); got != "project_cap,project_usage" {
+				log.Panicf("the usage fields are not correct %s", got)
+			}
+			if got := req.URL.Query().Get("days"); got != "7" {
+				log.Panicf("the days are not correct %s", got)
+			}
+			body := `{
+				"data": {
+					"cap_reset_day": 19,
+					"project_cap": "2000000",
+					"project_id": "1234",
+					"project_usage": "1500000",
+					"daily_project_usage": {
+						"project_id": "1234",
+						"usage": [{"date": "2022-06-01T00:00:00.000Z", "usage": "1000"}]
+					}
+				}
+			}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+
+	got, err := c.TweetUsage(context.Background(), TweetUsageOpts{
+		Days:        7,
+		UsageFields: []UsageField{UsageFieldProjectCap, UsageFieldProjectUsage},
+	})
+	if err != nil {
+		t.Fatalf("Client.TweetUsage() error = %v", err)
+	}
+	switch {
+	case got.Data.CapResetDay != 19:
+		t.Errorf("Client.TweetUsage() cap reset day = %d", got.Data.CapResetDay)
+	case got.Data.ProjectCap != "2000000" || got.Data.ProjectUsage != "1500000":
+		t.Errorf("Client.TweetUsage() cap = %s usage = %s", got.Data.ProjectCap, got.Data.ProjectUsage)
+	case got.Data.DailyProjectUsage == nil || len(got.Data.DailyProjectUsage.Usage) != 1 || got.Data.DailyProjectUsage.Usage[0].Usage != "1000":
+		t.Errorf("Client.TweetUsage() daily project usage = %+v", got.Data.DailyProjectUsage)
+	case got.RateLimit == nil || got.RateLimit.Remaining != 12:
+		t.Errorf("Client.TweetUsage() rate limit = %+v", got.RateLimit)
+	default:
+	}
+
+	if _, err := c.TweetUsage(context.Background(), TweetUsageOpts{Days: 91}); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.TweetUsage() error = %v, want %v", err, ErrParameter)
+	}
+}
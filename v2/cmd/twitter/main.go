@@ -0,0 +1,105 @@
+// Command twitter has tooling for the go-twitter client.
+//
+//	twitter doctor -token <bearer token> [-proxy <proxy url>]
+//
+// The doctor command will check the credentials against a read only set of endpoints and report which
+// capabilities the token has, the current rate limits and any misconfigurations.  The token can also be
+// set with the TWITTER_BEARER_TOKEN environment variable.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+const tokenEnv = "TWITTER_BEARER_TOKEN"
+
+type authorize struct {
+	Token string
+}
+
+func (a authorize) Add(req *http.Request) {
+	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", a.Token))
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	switch os.Args[1] {
+	case "doctor":
+		os.Exit(doctor(os.Args[2:]))
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: twitter <command> [flags]")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "commands:")
+	fmt.Fprintln(os.Stderr, "  doctor    check the credentials against a read only set of endpoints")
+}
+
+func doctor(args []string) int {
+	fs := flag.NewFlagSet("doctor", flag.ExitOnError)
+	token := fs.String("token", "", "twitter API token, the "+tokenEnv+" environment variable is read when it is not set")
+	host := fs.String("host", "https://api.twitter.com", "twitter API host")
+	timeout := fs.Duration("timeout", 30*time.Second, "time allowed for all of the checks")
+	proxy := fs.String("proxy", "", "http, https or socks5 proxy url, defaults to the proxy environment variables")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if len(*token) == 0 {
+		*token = os.Getenv(tokenEnv)
+	}
+	if len(*token) == 0 {
+		fmt.Fprintf(os.Stderr, "doctor: a token is required, use -token or %s\n", tokenEnv)
+		return 2
+	}
+
+	httpClient, err := twitter.NewHTTPClient(twitter.TransportOptions{
+		Proxy: *proxy,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "doctor: %v\n", err)
+		return 2
+	}
+	client := &twitter.Client{
+		Authorizer: authorize{
+			Token: *token,
+		},
+		Client: httpClient,
+		Host:   *host,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	report, err := client.Doctor(ctx)
+	if report != nil {
+		enc, jerr := json.MarshalIndent(report, "", "    ")
+		if jerr != nil {
+			fmt.Fprintf(os.Stderr, "doctor: %v\n", jerr)
+			return 1
+		}
+		fmt.Println(string(enc))
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "doctor: %v\n", err)
+		return 1
+	}
+	if len(report.Problems) > 0 || len(report.Capabilities()) == 0 {
+		return 1
+	}
+	return 0
+}
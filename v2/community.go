@@ -0,0 +1,57 @@
+package twitter
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// CommunityLookupOpts are the community lookup options
+type CommunityLookupOpts struct {
+	CommunityFields []CommunityField
+}
+
+func (c CommunityLookupOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if len(c.CommunityFields) > 0 {
+		q.Add("community.fields", strings.Join(communityFieldStringArray(c.CommunityFields), ","))
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// CommunityLookupResponse is the response from the community lookup
+type CommunityLookupResponse = Response[*CommunityObj, NoMeta]
+
+// CommunitySearchOpts are the community search options
+type CommunitySearchOpts struct {
+	CommunityFields []CommunityField
+	MaxResults      int
+	NextToken       string
+}
+
+func (c CommunitySearchOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if len(c.CommunityFields) > 0 {
+		q.Add("community.fields", strings.Join(communityFieldStringArray(c.CommunityFields), ","))
+	}
+	if c.MaxResults > 0 {
+		q.Add("max_results", strconv.Itoa(c.MaxResults))
+	}
+	if len(c.NextToken) > 0 {
+		q.Add("next_token", c.NextToken)
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// CommunitySearchMeta is the community search meta
+type CommunitySearchMeta struct {
+	ResultCount int    `json:"result_count"`
+	NextToken   string `json:"next_token"`
+}
+
+// CommunitySearchResponse is the response from the community search
+type CommunitySearchResponse = Response[[]*CommunityObj, *CommunitySearchMeta]
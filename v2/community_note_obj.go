@@ -0,0 +1,73 @@
+package twitter
+
+import "encoding/json"
+
+// NoteField are the community note field options
+type NoteField string
+
+const (
+	// NoteFieldID is the note id field
+	NoteFieldID NoteField = "id"
+	// NoteFieldInfo is the note's text, classification and post field
+	NoteFieldInfo NoteField = "info"
+	// NoteFieldStatus is the note's rating status field
+	NoteFieldStatus NoteField = "status"
+	// NoteFieldTestResult is the note's test mode evaluation field
+	NoteFieldTestResult NoteField = "test_result"
+)
+
+func noteFieldStringArray(arr []NoteField) []string {
+	strs := make([]string, len(arr))
+	for i, field := range arr {
+		strs[i] = string(field)
+	}
+	return strs
+}
+
+// NoteClassification is the writer's classification of the post
+type NoteClassification string
+
+const (
+	// NoteClassificationMisleading is a post that is misinformed or potentially misleading
+	NoteClassificationMisleading NoteClassification = "misinformed_or_potentially_misleading"
+	// NoteClassificationNotMisleading is a post that is not misleading
+	NoteClassificationNotMisleading NoteClassification = "not_misleading"
+)
+
+// NoteMisleadingTag is why a post is misleading
+type NoteMisleadingTag string
+
+const (
+	// NoteMisleadingTagDisputedClaimAsFact is a disputed claim presented as a fact
+	NoteMisleadingTagDisputedClaimAsFact NoteMisleadingTag = "disputed_claim_as_fact"
+	// NoteMisleadingTagFactualError is a factual error
+	NoteMisleadingTagFactualError NoteMisleadingTag = "factual_error"
+	// NoteMisleadingTagManipulatedMedia is altered or manipulated media
+	NoteMisleadingTagManipulatedMedia NoteMisleadingTag = "manipulated_media"
+	// NoteMisleadingTagMisinterpretedSatire is satire that may be taken as a fact
+	NoteMisleadingTagMisinterpretedSatire NoteMisleadingTag = "misinterpreted_satire"
+	// NoteMisleadingTagMissingImportantContext is missing important context
+	NoteMisleadingTagMissingImportantContext NoteMisleadingTag = "missing_important_context"
+	// NoteMisleadingTagOutdatedInformation is information that was true, but is now outdated
+	NoteMisleadingTagOutdatedInformation NoteMisleadingTag = "outdated_information"
+	// NoteMisleadingTagOther is any other reason
+	NoteMisleadingTagOther NoteMisleadingTag = "other"
+)
+
+// NoteInfoObj is the content of a community note
+type NoteInfoObj struct {
+	Text               string              `json:"text"`
+	Classification     NoteClassification  `json:"classification"`
+	MisleadingTags     []NoteMisleadingTag `json:"misleading_tags,omitempty"`
+	TrustworthySources bool                `json:"trustworthy_sources"`
+	PostID             string              `json:"post_id,omitempty"`
+}
+
+// NoteObj is a community note written by the authorized user.  The test result is the evaluation of a note written
+// in test mode.
+type NoteObj struct {
+	ID         string          `json:"id"`
+	Info       *NoteInfoObj    `json:"info,omitempty"`
+	Status     string          `json:"status,omitempty"`
+	TestResult json.RawMessage `json:"test_result,omitempty"`
+}
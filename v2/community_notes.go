@@ -0,0 +1,132 @@
+package twitter
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// NotesEligiblePostsOpts are the options of the posts eligible for community notes.  The test mode is required
+// while the writer is not admitted, it returns the posts that test notes can be written for.
+type NotesEligiblePostsOpts struct {
+	TestMode        bool
+	Expansions      []Expansion
+	MediaFields     []MediaField
+	PlaceFields     []PlaceField
+	PollFields      []PollField
+	TweetFields     []TweetField
+	UserFields      []UserField
+	MaxResults      int
+	PaginationToken string
+}
+
+func (n NotesEligiblePostsOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	q.Add("test_mode", strconv.FormatBool(n.TestMode))
+	if len(n.Expansions) > 0 {
+		q.Add("expansions", strings.Join(expansionStringArray(n.Expansions), ","))
+	}
+	if len(n.MediaFields) > 0 {
+		q.Add("media.fields", strings.Join(mediaFieldStringArray(n.MediaFields), ","))
+	}
+	if len(n.PlaceFields) > 0 {
+		q.Add("place.fields", strings.Join(placeFieldStringArray(n.PlaceFields), ","))
+	}
+	if len(n.PollFields) > 0 {
+		q.Add("poll.fields", strings.Join(pollFieldStringArray(n.PollFields), ","))
+	}
+	if len(n.TweetFields) > 0 {
+		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(n.TweetFields), ","))
+	}
+	if len(n.UserFields) > 0 {
+		q.Add("user.fields", strings.Join(userFieldStringArray(n.UserFields), ","))
+	}
+	if n.MaxResults > 0 {
+		q.Add("max_results", strconv.Itoa(n.MaxResults))
+	}
+	if len(n.PaginationToken) > 0 {
+		q.Add("pagination_token", n.PaginationToken)
+	}
+	req.URL.RawQuery = q.Encode()
+}
+
+// NotesSearchMeta is the meta of the community notes searches
+type NotesSearchMeta struct {
+	ResultCount int    `json:"result_count"`
+	NextToken   string `json:"next_token"`
+}
+
+// NotesEligiblePostsResponse is the response of the posts eligible for community notes
+type NotesEligiblePostsResponse = Response[[]*TweetObj, *NotesSearchMeta]
+
+// NotesWrittenOpts are the options of the community notes written by the authorized user
+type NotesWrittenOpts struct {
+	TestMode        bool
+	NoteFields      []NoteField
+	MaxResults      int
+	PaginationToken string
+}
+
+func (n NotesWrittenOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	q.Add("test_mode", strconv.FormatBool(n.TestMode))
+	if len(n.NoteFields) > 0 {
+		q.Add("note.fields", strings.Join(noteFieldStringArray(n.NoteFields), ","))
+	}
+	if n.MaxResults > 0 {
+		q.Add("max_results", strconv.Itoa(n.MaxResults))
+	}
+	if len(n.PaginationToken) > 0 {
+		q.Add("pagination_token", n.PaginationToken)
+	}
+	req.URL.RawQuery = q.Encode()
+}
+
+// NotesWrittenResponse is the response of the community notes written by the authorized user
+type NotesWrittenResponse = Response[[]*NoteObj, *NotesSearchMeta]
+
+// CreateNoteRequest is a community note of a post.  A misleading post requires at least one misleading tag.
+type CreateNoteRequest struct {
+	TestMode bool        `json:"test_mode"`
+	PostID   string      `json:"post_id"`
+	Info     NoteInfoObj `json:"info"`
+}
+
+func (n CreateNoteRequest) validate() error {
+	switch {
+	case len(n.PostID) == 0:
+		return fmt.Errorf("create note: a post id is required: %w", ErrParameter)
+	case len(n.Info.Text) == 0:
+		return fmt.Errorf("create note: the text is required: %w", ErrParameter)
+	case len(n.Info.Classification) == 0:
+		return fmt.Errorf("create note: the classification is required: %w", ErrParameter)
+	case n.Info.Classification == NoteClassificationMisleading && len(n.Info.MisleadingTags) == 0:
+		return fmt.Errorf("create note: a misleading tag is required for a misleading post: %w", ErrParameter)
+	default:
+		return nil
+	}
+}
+
+// CreateNoteData is the submitted community note
+type CreateNoteData struct {
+	NoteID string `json:"note_id"`
+}
+
+// CreateNoteResponse is the response of submitting a community note
+type CreateNoteResponse = Response[*CreateNoteData, NoMeta]
+
+// EvaluateNoteRequest is the text of a community note to evaluate for a post
+type EvaluateNoteRequest struct {
+	PostID   string `json:"post_id"`
+	NoteText string `json:"note_text"`
+}
+
+// EvaluateNoteData is the evaluation of a community note.  The claim opinion score is how much the note is a claim
+// of opinion instead of fact, the lower the better.
+type EvaluateNoteData struct {
+	ClaimOpinionScore float64 `json:"claim_opinion_score"`
+}
+
+// EvaluateNoteResponse is the response of evaluating a community note
+type EvaluateNoteResponse = Response[*EvaluateNoteData, NoMeta]
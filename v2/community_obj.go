@@ -0,0 +1,64 @@
+package twitter
+
+// CommunityField are the community field options
+type CommunityField string
+
+const (
+	// CommunityFieldID is the community id field
+	CommunityFieldID CommunityField = "id"
+	// CommunityFieldName is the community name field
+	CommunityFieldName CommunityField = "name"
+	// CommunityFieldDescription is the community description field
+	CommunityFieldDescription CommunityField = "description"
+	// CommunityFieldCreatedAt is the community created at field
+	CommunityFieldCreatedAt CommunityField = "created_at"
+	// CommunityFieldAccess is the community access field
+	CommunityFieldAccess CommunityField = "access"
+	// CommunityFieldJoinPolicy is the community join policy field
+	CommunityFieldJoinPolicy CommunityField = "join_policy"
+	// CommunityFieldMemberCount is the community member count field
+	CommunityFieldMemberCount CommunityField = "member_count"
+)
+
+func communityFieldStringArray(arr []CommunityField) []string {
+	strs := make([]string, len(arr))
+	for i, field := range arr {
+		strs[i] = string(field)
+	}
+	return strs
+}
+
+// CommunityAccess is who can see the community
+type CommunityAccess string
+
+const (
+	// CommunityAccessPublic is a community anyone can see
+	CommunityAccessPublic CommunityAccess = "Public"
+	// CommunityAccessClosed is a community only members can see
+	CommunityAccessClosed CommunityAccess = "Closed"
+)
+
+// CommunityJoinPolicy is how users can join the community
+type CommunityJoinPolicy string
+
+const (
+	// CommunityJoinPolicyOpen is anyone can join
+	CommunityJoinPolicyOpen CommunityJoinPolicy = "Open"
+	// CommunityJoinPolicyRestrictedJoinRequests is users must request to join
+	CommunityJoinPolicyRestrictedJoinRequests CommunityJoinPolicy = "RestrictedJoinRequestsRequireAdminApproval"
+	// CommunityJoinPolicyRestrictedJoinRequestsModerator is users must request to join and be approved by a moderator
+	CommunityJoinPolicyRestrictedJoinRequestsModerator CommunityJoinPolicy = "RestrictedJoinRequestsRequireModeratorApproval"
+	// CommunityJoinPolicySuperFollowsRequired is users must super follow to join
+	CommunityJoinPolicySuperFollowsRequired CommunityJoinPolicy = "SuperFollowRequired"
+)
+
+// CommunityObj is the community object
+type CommunityObj struct {
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	CreatedAt   string              `json:"created_at,omitempty"`
+	Access      CommunityAccess     `json:"access,omitempty"`
+	JoinPolicy  CommunityJoinPolicy `json:"join_policy,omitempty"`
+	MemberCount int                 `json:"member_count,omitempty"`
+}
@@ -36,13 +36,39 @@ const (
 	ComplianceBatchJobTypeUsers ComplianceBatchJobType = "users"
 )
 
+// ComplianceBatchJobAction is the action that must be taken on the result
+type ComplianceBatchJobAction string
+
+const (
+	// ComplianceBatchJobActionDelete is the content must be deleted
+	ComplianceBatchJobActionDelete ComplianceBatchJobAction = "delete"
+	// ComplianceBatchJobActionScrubGeo is the geo information must be removed
+	ComplianceBatchJobActionScrubGeo ComplianceBatchJobAction = "scrub_geo"
+)
+
+// ComplianceBatchJobReason is the reason for the result's action
+type ComplianceBatchJobReason string
+
+const (
+	// ComplianceBatchJobReasonDeleted is the content or account was deleted
+	ComplianceBatchJobReasonDeleted ComplianceBatchJobReason = "deleted"
+	// ComplianceBatchJobReasonDeactivated is the account was deactivated
+	ComplianceBatchJobReasonDeactivated ComplianceBatchJobReason = "deactivated"
+	// ComplianceBatchJobReasonProtected is the account was protected
+	ComplianceBatchJobReasonProtected ComplianceBatchJobReason = "protected"
+	// ComplianceBatchJobReasonSuspended is the account was suspended
+	ComplianceBatchJobReasonSuspended ComplianceBatchJobReason = "suspended"
+	// ComplianceBatchJobReasonScrubGeo is the geo information was removed
+	ComplianceBatchJobReasonScrubGeo ComplianceBatchJobReason = "scrub_geo"
+)
+
 // ComplianceBatchJobResult is the downloaded result
 type ComplianceBatchJobResult struct {
-	ID         string `json:"id"`
-	Action     string `json:"action"`
-	CreatedAt  string `json:"created_at"`
-	RedactedAt string `json:"redacted_at"`
-	Reason     string `json:"reason"`
+	ID         string                   `json:"id"`
+	Action     ComplianceBatchJobAction `json:"action"`
+	CreatedAt  string                   `json:"created_at"`
+	RedactedAt string                   `json:"redacted_at"`
+	Reason     ComplianceBatchJobReason `json:"reason"`
 }
 
 // ComplianceBatchJobDownloadResponse is the response from dowload results
@@ -51,6 +77,26 @@ type ComplianceBatchJobDownloadResponse struct {
 	RateLimit *RateLimit
 }
 
+// ResultsByReason will group the results by the reason of the action
+func (c *ComplianceBatchJobDownloadResponse) ResultsByReason() map[ComplianceBatchJobReason][]*ComplianceBatchJobResult {
+	results := map[ComplianceBatchJobReason][]*ComplianceBatchJobResult{}
+	for _, result := range c.Results {
+		results[result.Reason] = append(results[result.Reason], result)
+	}
+	return results
+}
+
+// DeletedIDs returns the ids of the results that must be deleted
+func (c *ComplianceBatchJobDownloadResponse) DeletedIDs() []string {
+	ids := []string{}
+	for _, result := range c.Results {
+		if result.Action == ComplianceBatchJobActionDelete {
+			ids = append(ids, result.ID)
+		}
+	}
+	return ids
+}
+
 // ComplianceBatchJobObj is the compliance batch job
 type ComplianceBatchJobObj struct {
 	Resumable         bool                     `json:"resumable"`
@@ -89,14 +135,16 @@ func (c ComplianceBatchJobObj) Upload(ctx context.Context, ids io.Reader) error
 		e := &ErrorResponse{}
 		if err := decoder.Decode(e); err != nil {
 			return &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return e
 	}
 	return nil
@@ -121,14 +169,16 @@ func (c ComplianceBatchJobObj) Download(ctx context.Context) (*ComplianceBatchJo
 		e := &ErrorResponse{}
 		if err := json.NewDecoder(resp.Body).Decode(e); err != nil {
 			return nil, &HTTPError{
-				Status:     resp.Status,
-				StatusCode: resp.StatusCode,
-				URL:        resp.Request.URL.String(),
-				RateLimit:  rl,
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
 			}
 		}
 		e.StatusCode = resp.StatusCode
 		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
 		return nil, e
 	}
 
@@ -137,6 +187,9 @@ func (c ComplianceBatchJobObj) Download(ctx context.Context) (*ComplianceBatchJo
 	scanner := bufio.NewScanner(resp.Body)
 	scanner.Split(batchResultsSeparator)
 	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
 		result := &ComplianceBatchJobResult{}
 		if err := json.Unmarshal(scanner.Bytes(), result); err != nil {
 			return nil, &ResponseDecodeError{
@@ -148,19 +201,26 @@ func (c ComplianceBatchJobObj) Download(ctx context.Context) (*ComplianceBatchJo
 		results = append(results, result)
 	}
 
+	if err := scanner.Err(); err != nil {
+		return nil, &ResponseDecodeError{
+			Name:      "compliance batch job download",
+			Err:       err,
+			RateLimit: rl,
+		}
+	}
+
 	return &ComplianceBatchJobDownloadResponse{
 		Results:   results,
 		RateLimit: rl,
 	}, nil
-
 }
 
 func batchResultsSeparator(data []byte, atEOF bool) (int, []byte, error) {
 	if atEOF && len(data) == 0 {
 		return 0, nil, nil
 	}
-	if idx := bytes.Index(data, []byte("\r\n")); idx != -1 {
-		return idx + len("\r\n"), data[0:idx], nil
+	if idx := bytes.IndexByte(data, '\n'); idx != -1 {
+		return idx + 1, bytes.TrimSuffix(data[0:idx], []byte("\r")), nil
 	}
 	if atEOF {
 		return len(data), data, nil
@@ -146,6 +146,56 @@ func TestComplianceBatchJobObj_Download(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "newline separated users",
+			fields: fields{
+				DownloadURL: "https://wwww.test.com/download",
+				client: mockHTTPClient(func(req *http.Request) *http.Response {
+					results := `{"id":"2244994945","action":"delete","created_at":"2013-12-14T04:35:55.000Z","redacted_at":"2021-07-29T17:02:47.000Z","reason":"deactivated"}`
+					results += "\n"
+					results += `{"id":"6253282","action":"scrub_geo","created_at":"2007-05-23T06:01:13.000Z","redacted_at":"2021-07-29T17:02:47.000Z","reason":"scrub_geo"}`
+					results += "\n"
+
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(results)),
+					}
+				}),
+			},
+			want: &ComplianceBatchJobDownloadResponse{
+				Results: []*ComplianceBatchJobResult{
+					{
+						ID:         "2244994945",
+						Action:     ComplianceBatchJobActionDelete,
+						CreatedAt:  "2013-12-14T04:35:55.000Z",
+						RedactedAt: "2021-07-29T17:02:47.000Z",
+						Reason:     ComplianceBatchJobReasonDeactivated,
+					},
+					{
+						ID:         "6253282",
+						Action:     ComplianceBatchJobActionScrubGeo,
+						CreatedAt:  "2007-05-23T06:01:13.000Z",
+						RedactedAt: "2021-07-29T17:02:47.000Z",
+						Reason:     ComplianceBatchJobReasonScrubGeo,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "bad result",
+			fields: fields{
+				DownloadURL: "https://wwww.test.com/download",
+				client: mockHTTPClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"id":"2244994945",`)),
+					}
+				}),
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -164,3 +214,21 @@ func TestComplianceBatchJobObj_Download(t *testing.T) {
 		})
 	}
 }
+
+func TestComplianceBatchJobDownloadResponse_ResultsByReason(t *testing.T) {
+	resp := &ComplianceBatchJobDownloadResponse{
+		Results: []*ComplianceBatchJobResult{
+			{ID: "1", Action: ComplianceBatchJobActionDelete, Reason: ComplianceBatchJobReasonDeleted},
+			{ID: "2", Action: ComplianceBatchJobActionDelete, Reason: ComplianceBatchJobReasonProtected},
+			{ID: "3", Action: ComplianceBatchJobActionScrubGeo, Reason: ComplianceBatchJobReasonScrubGeo},
+			{ID: "4", Action: ComplianceBatchJobActionDelete, Reason: ComplianceBatchJobReasonDeleted},
+		},
+	}
+	byReason := resp.ResultsByReason()
+	if len(byReason[ComplianceBatchJobReasonDeleted]) != 2 || len(byReason[ComplianceBatchJobReasonProtected]) != 1 {
+		t.Errorf("ComplianceBatchJobDownloadResponse.ResultsByReason() = %v", byReason)
+	}
+	if got := resp.DeletedIDs(); !reflect.DeepEqual(got, []string{"1", "2", "4"}) {
+		t.Errorf("ComplianceBatchJobDownloadResponse.DeletedIDs() = %v", got)
+	}
+}
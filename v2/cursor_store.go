@@ -0,0 +1,128 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Cursor is the checkpoint of a paginated job.  NextToken is the next page of the current crawl and is empty when the
+// crawl is complete.  NewestID is the newest tweet of the crawl and SinceID is the since id the crawl was started with,
+// so a completed crawl can be continued with only the newer tweets.
+type Cursor struct {
+	NextToken string `json:"next_token,omitempty"`
+	NewestID  string `json:"newest_id,omitempty"`
+	SinceID   string `json:"since_id,omitempty"`
+}
+
+// CursorStore will load and save the cursor of each named job, so pagination can survive process restarts.  Load
+// returns nil if the job has no cursor.
+type CursorStore interface {
+	Load(ctx context.Context, job string) (*Cursor, error)
+	Save(ctx context.Context, job string, cursor Cursor) error
+}
+
+// MemoryCursorStore keeps the cursors in memory
+type MemoryCursorStore struct {
+	mutex   sync.Mutex
+	cursors map[string]Cursor
+}
+
+// Load returns the job's cursor
+func (m *MemoryCursorStore) Load(_ context.Context, job string) (*Cursor, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	cursor, has := m.cursors[job]
+	if !has {
+		return nil, nil
+	}
+	return &cursor, nil
+}
+
+// Save will save the job's cursor
+func (m *MemoryCursorStore) Save(_ context.Context, job string, cursor Cursor) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.cursors == nil {
+		m.cursors = map[string]Cursor{}
+	}
+	m.cursors[job] = cursor
+	return nil
+}
+
+// FileCursorStore keeps the cursors of all jobs in a JSON file.  The file is replaced on each save so that a crash
+// does not leave a partial file.
+type FileCursorStore struct {
+	Path  string
+	mutex sync.Mutex
+}
+
+func (f *FileCursorStore) read() (map[string]Cursor, error) {
+	cursors := map[string]Cursor{}
+	b, err := os.ReadFile(f.Path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return cursors, nil
+	case err != nil:
+		return nil, fmt.Errorf("cursor store read: %w", err)
+	}
+	if err := json.Unmarshal(b, &cursors); err != nil {
+		return nil, fmt.Errorf("cursor store decode %s: %w", f.Path, err)
+	}
+	return cursors, nil
+}
+
+// Load returns the job's cursor
+func (f *FileCursorStore) Load(_ context.Context, job string) (*Cursor, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	cursors, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	cursor, has := cursors[job]
+	if !has {
+		return nil, nil
+	}
+	return &cursor, nil
+}
+
+// Save will save the job's cursor
+func (f *FileCursorStore) Save(_ context.Context, job string, cursor Cursor) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	cursors, err := f.read()
+	if err != nil {
+		return err
+	}
+	cursors[job] = cursor
+	b, err := json.MarshalIndent(cursors, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cursor store encode: %w", err)
+	}
+	if err := replaceFile(f.Path, b); err != nil {
+		return fmt.Errorf("cursor store write: %w", err)
+	}
+	return nil
+}
+
+// replaceFile will write the contents to a temporary file and rename it to the path
+func replaceFile(path string, b []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
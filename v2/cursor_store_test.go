@@ -0,0 +1,28 @@
+package twitter
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFileCursorStore(t *testing.T) {
+	ctx := context.Background()
+	store := &FileCursorStore{Path: filepath.Join(t.TempDir(), "cursors.json")}
+	if cursor, err := store.Load(ctx, "golang"); err != nil || cursor != nil {
+		t.Fatalf("FileCursorStore.Load() = %v %v, want no cursor", cursor, err)
+	}
+	want := Cursor{NextToken: "p2", NewestID: "9"}
+	if err := store.Save(ctx, "golang", want); err != nil {
+		t.Fatalf("FileCursorStore.Save() error = %v", err)
+	}
+	if err := store.Save(ctx, "gopher", Cursor{NewestID: "1"}); err != nil {
+		t.Fatalf("FileCursorStore.Save() error = %v", err)
+	}
+
+	reopened := &FileCursorStore{Path: store.Path}
+	if cursor, err := reopened.Load(ctx, "golang"); err != nil || !reflect.DeepEqual(*cursor, want) {
+		t.Errorf("FileCursorStore.Load() = %v %v, want %v", cursor, err, want)
+	}
+}
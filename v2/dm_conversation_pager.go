@@ -0,0 +1,199 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+)
+
+// DMConversationPager will page through the history of a direct message conversation.  The events are newest first,
+// unless OldestFirst is set.  The pager is not safe for concurrent use.
+//
+//	pager := &twitter.DMConversationPager{Client: client, ConversationID: "1346889436626259968"}
+//	for pager.Next(ctx) {
+//		events := pager.Page().Data
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+//
+// With a cursor store, the cursor of the job is saved once the caller has finished a page, which is when the next page
+// is asked for or the page is committed with Commit.  A pager for the same job will resume the crawl at the first page
+// that was not finished or, if the crawl was completed, page only through the events newer than the crawl.
+//
+// With oldest first, the history is fetched before the first page is returned, so the pages and their events can be
+// returned in reverse.  The cursor is only saved once the last page is finished, an interrupted crawl will start again.
+//
+// With WaitRateLimit, a page that has no requests remaining will hold the next page until the rate limit resets.
+type DMConversationPager struct {
+	Client         *Client
+	ConversationID string
+	// Opts are the lookup options, the pagination token is set by the pager
+	Opts DMEventsLookupOpts
+	// OldestFirst will return the oldest events first
+	OldestFirst bool
+	// Job is the name of the cursor in the store
+	Job   string
+	Store CursorStore
+	// WaitRateLimit will wait for the rate limit reset before the next page
+	WaitRateLimit bool
+
+	started    bool
+	done       bool
+	cursor     Cursor
+	checkpoint cursorCheckpoint
+	page       *DMEventsLookupResponse
+	pages      []*DMEventsLookupResponse
+	rateLimit  *RateLimit
+	err        error
+}
+
+// Next will fetch the next page and returns false when there are no more pages or there is an error
+func (p *DMConversationPager) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+	if err := p.checkpoint.commit(ctx); err != nil {
+		p.err = err
+		return false
+	}
+	if p.done {
+		return false
+	}
+	if !p.started {
+		if err := p.start(ctx); err != nil {
+			p.err = err
+			return false
+		}
+		p.started = true
+	}
+	if p.OldestFirst {
+		return p.nextOldest(ctx)
+	}
+
+	page, err := p.fetch(ctx)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.page = page
+	p.checkpoint.hold(p.cursor)
+	return true
+}
+
+// Commit will save the cursor after the current page, for a caller that stops before the next page is asked for
+func (p *DMConversationPager) Commit(ctx context.Context) error {
+	return p.checkpoint.commit(ctx)
+}
+
+// Page is the current page
+func (p *DMConversationPager) Page() *DMEventsLookupResponse {
+	return p.page
+}
+
+// Items are the events of the current page
+func (p *DMConversationPager) Items() []*DMEventObj {
+	if p.page == nil {
+		return nil
+	}
+	return p.page.Data
+}
+
+// Err is the error that stopped the paging
+func (p *DMConversationPager) Err() error {
+	return p.err
+}
+
+// Cursor is the cursor after the current page
+func (p *DMConversationPager) Cursor() Cursor {
+	return p.cursor
+}
+
+func (p *DMConversationPager) nextOldest(ctx context.Context) bool {
+	if p.pages == nil {
+		pages := []*DMEventsLookupResponse{}
+		for !p.done {
+			page, err := p.fetch(ctx)
+			if err != nil {
+				p.err = err
+				return false
+			}
+			reversed := *page
+			reversed.Data = make([]*DMEventObj, 0, len(page.Data))
+			for i := len(page.Data) - 1; i >= 0; i-- {
+				reversed.Data = append(reversed.Data, page.Data[i])
+			}
+			pages = append([]*DMEventsLookupResponse{&reversed}, pages...)
+		}
+		p.pages = pages
+		p.done = false
+	}
+
+	p.page, p.pages = p.pages[0], p.pages[1:]
+	if len(p.pages) > 0 {
+		return true
+	}
+	p.done = true
+	p.checkpoint.hold(p.cursor)
+	return true
+}
+
+// fetch will look up the next page, the events at and after the since id are removed and end the crawl
+func (p *DMConversationPager) fetch(ctx context.Context) (*DMEventsLookupResponse, error) {
+	if p.WaitRateLimit {
+		if err := waitRateLimit(ctx, p.rateLimit); err != nil {
+			return nil, fmt.Errorf("dm conversation pager: %w", err)
+		}
+	}
+	opts := p.Opts
+	opts.PaginationToken = p.cursor.NextToken
+	page, err := p.Client.DMConversationEventsLookup(ctx, p.ConversationID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("dm conversation pager: %w", err)
+	}
+	p.rateLimit = page.RateLimit
+
+	since := false
+	if len(p.cursor.SinceID) > 0 {
+		for i, event := range page.Data {
+			if event != nil && event.ID == p.cursor.SinceID {
+				page.Data = page.Data[:i]
+				since = true
+				break
+			}
+		}
+	}
+	if len(p.cursor.NextToken) == 0 && len(page.Data) > 0 && page.Data[0] != nil {
+		p.cursor.NewestID = page.Data[0].ID
+	}
+	p.cursor.NextToken = ""
+	if page.Meta != nil && !since {
+		p.cursor.NextToken = page.Meta.NextToken
+	}
+	p.done = len(p.cursor.NextToken) == 0
+	return page, nil
+}
+
+func (p *DMConversationPager) start(ctx context.Context) error {
+	if p.Client == nil || len(p.ConversationID) == 0 {
+		return fmt.Errorf("dm conversation pager: a client and conversation id are required: %w", ErrParameter)
+	}
+	checkpoint, err := newCursorCheckpoint("dm conversation pager", p.Job, p.Store)
+	if err != nil {
+		return err
+	}
+	p.checkpoint = checkpoint
+	saved, err := p.checkpoint.load(ctx)
+	if err != nil {
+		return err
+	}
+	if saved != nil && len(saved.NextToken) > 0 && p.OldestFirst {
+		// the oldest first history can not resume part way, only after the last completed crawl
+		p.cursor = Cursor{
+			NewestID: saved.SinceID,
+			SinceID:  saved.SinceID,
+		}
+		return nil
+	}
+	p.cursor = resumeCursor(saved, Cursor{})
+	return nil
+}
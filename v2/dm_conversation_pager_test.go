@@ -0,0 +1,118 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func dmPagesClient(pages map[string]string) *Client {
+	return &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != dmConversationEventsEndpoint.urlID("", "1") {
+				log.Panicf("the path is not correct %s", req.URL.Path)
+			}
+			body, has := pages[req.URL.Query().Get("pagination_token")]
+			if !has {
+				log.Panicf("the page is not correct %s", req.URL.RawQuery)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+}
+
+func dmPage(ids []string, next string) string {
+	data := []string{}
+	for _, id := range ids {
+		data = append(data, fmt.Sprintf(`{"id":"%s","event_type":"MessageCreate","text":"message %s"}`, id, id))
+	}
+	meta := fmt.Sprintf(`"result_count":%d`, len(ids))
+	if len(next) > 0 {
+		meta += fmt.Sprintf(`,"next_token":"%s"`, next)
+	}
+	return fmt.Sprintf(`{"data":[%s],"meta":{%s}}`, strings.Join(data, ","), meta)
+}
+
+func dmPagerIDs(t *testing.T, pager *DMConversationPager, pages int) string {
+	got := []string{}
+	for i := 0; i < pages && pager.Next(context.Background()); i++ {
+		for _, event := range pager.Page().Data {
+			got = append(got, event.ID)
+		}
+	}
+	if err := pager.Err(); err != nil {
+		t.Fatalf("DMConversationPager.Next() error = %v", err)
+	}
+	return strings.Join(got, ",")
+}
+
+func TestDMConversationPager(t *testing.T) {
+	pages := map[string]string{
+		"":   dmPage([]string{"9", "8"}, "p2"),
+		"p2": dmPage([]string{"7", "6"}, "p3"),
+		"p3": dmPage([]string{"5"}, ""),
+	}
+	store := &MemoryCursorStore{}
+
+	pager := &DMConversationPager{Client: dmPagesClient(pages), ConversationID: "1", Job: "support", Store: store}
+	if got := dmPagerIDs(t, pager, 2); got != "9,8,7,6" {
+		t.Errorf("DMConversationPager first run = %v", got)
+	}
+
+	pager = &DMConversationPager{Client: dmPagesClient(pages), ConversationID: "1", Job: "support", Store: store}
+	if got := dmPagerIDs(t, pager, 10); got != "7,6,5" {
+		t.Errorf("DMConversationPager resumed run = %v, want the unfinished page 7,6,5", got)
+	}
+	if cursor := pager.Cursor(); cursor.NewestID != "9" || len(cursor.NextToken) > 0 {
+		t.Errorf("DMConversationPager cursor = %+v", cursor)
+	}
+
+	pager = &DMConversationPager{Client: dmPagesClient(pages), ConversationID: "1", Job: "committed", Store: store}
+	if got := dmPagerIDs(t, pager, 2); got != "9,8,7,6" {
+		t.Errorf("DMConversationPager first run = %v", got)
+	}
+	if err := pager.Commit(context.Background()); err != nil {
+		t.Fatalf("DMConversationPager.Commit() error = %v", err)
+	}
+	pager = &DMConversationPager{Client: dmPagesClient(pages), ConversationID: "1", Job: "committed", Store: store}
+	if got := dmPagerIDs(t, pager, 10); got != "5" {
+		t.Errorf("DMConversationPager committed run = %v, want 5", got)
+	}
+
+	pages[""] = dmPage([]string{"11", "10", "9", "8"}, "p2")
+	pager = &DMConversationPager{Client: dmPagesClient(pages), ConversationID: "1", Job: "support", Store: store}
+	if got := dmPagerIDs(t, pager, 10); got != "11,10" {
+		t.Errorf("DMConversationPager newer run = %v, want 11,10", got)
+	}
+}
+
+func TestDMConversationPager_OldestFirst(t *testing.T) {
+	pages := map[string]string{
+		"":   dmPage([]string{"9", "8"}, "p2"),
+		"p2": dmPage([]string{"7", "6"}, "p3"),
+		"p3": dmPage([]string{"5"}, ""),
+	}
+	store := &MemoryCursorStore{}
+
+	pager := &DMConversationPager{Client: dmPagesClient(pages), ConversationID: "1", OldestFirst: true, Job: "support", Store: store}
+	if got := dmPagerIDs(t, pager, 10); got != "5,6,7,8,9" {
+		t.Errorf("DMConversationPager oldest first = %v", got)
+	}
+
+	pages[""] = dmPage([]string{"12", "11"}, "n2")
+	pages["n2"] = dmPage([]string{"10", "9"}, "p2")
+	pager = &DMConversationPager{Client: dmPagesClient(pages), ConversationID: "1", OldestFirst: true, Job: "support", Store: store}
+	if got := dmPagerIDs(t, pager, 10); got != "10,11,12" {
+		t.Errorf("DMConversationPager oldest first newer run = %v, want 10,11,12", got)
+	}
+}
@@ -0,0 +1,122 @@
+package twitter
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// DMEventsLookupOpts are the options for the direct message events lookups
+type DMEventsLookupOpts struct {
+	EventTypes      []DMEventType
+	Expansions      []Expansion
+	DMEventFields   []DMEventField
+	MediaFields     []MediaField
+	TweetFields     []TweetField
+	UserFields      []UserField
+	MaxResults      int
+	PaginationToken string
+}
+
+func (d DMEventsLookupOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if len(d.EventTypes) > 0 {
+		q.Add("event_types", strings.Join(dmEventTypeStringArray(d.EventTypes), ","))
+	}
+	if len(d.Expansions) > 0 {
+		q.Add("expansions", strings.Join(expansionStringArray(d.Expansions), ","))
+	}
+	if len(d.DMEventFields) > 0 {
+		q.Add("dm_event.fields", strings.Join(dmEventFieldStringArray(d.DMEventFields), ","))
+	}
+	if len(d.MediaFields) > 0 {
+		q.Add("media.fields", strings.Join(mediaFieldStringArray(d.MediaFields), ","))
+	}
+	if len(d.TweetFields) > 0 {
+		q.Add("tweet.fields", strings.Join(tweetFieldStringArray(d.TweetFields), ","))
+	}
+	if len(d.UserFields) > 0 {
+		q.Add("user.fields", strings.Join(userFieldStringArray(d.UserFields), ","))
+	}
+	if d.MaxResults > 0 {
+		q.Add("max_results", strconv.Itoa(d.MaxResults))
+	}
+	if len(d.PaginationToken) > 0 {
+		q.Add("pagination_token", d.PaginationToken)
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// DMEventsLookupMeta is the direct message events lookup meta
+type DMEventsLookupMeta struct {
+	ResultCount   int    `json:"result_count"`
+	NextToken     string `json:"next_token"`
+	PreviousToken string `json:"previous_token"`
+}
+
+// DMEventsLookupResponse is the response from the direct message events lookups
+type DMEventsLookupResponse = Response[[]*DMEventObj, *DMEventsLookupMeta]
+
+// CreateDMMessage is the message to send.  Text is required unless there is an attachment.
+type CreateDMMessage struct {
+	Text        string               `json:"text,omitempty"`
+	Attachments []CreateDMAttachment `json:"attachments,omitempty"`
+}
+
+func (m CreateDMMessage) validate() error {
+	if len(m.Attachments) > dmMaxAttachments {
+		return fmt.Errorf("direct message attachments %d is greater than max %d %w", len(m.Attachments), dmMaxAttachments, ErrParameter)
+	}
+	for _, attachment := range m.Attachments {
+		if err := attachment.validate(); err != nil {
+			return err
+		}
+	}
+	if len(m.Text) == 0 && len(m.Attachments) == 0 {
+		return fmt.Errorf("direct message text is required if no attachments %w", ErrParameter)
+	}
+	return nil
+}
+
+// CreateDMAttachment is previously uploaded media to attach to the message.  If the media category used
+// for the upload is set, it is validated to be one that can be sent in a direct message.
+type CreateDMAttachment struct {
+	MediaID       string        `json:"media_id"`
+	MediaCategory MediaCategory `json:"-"`
+}
+
+func (a CreateDMAttachment) validate() error {
+	switch {
+	case len(a.MediaID) == 0:
+		return fmt.Errorf("direct message attachment media id is required %w", ErrParameter)
+	case len(a.MediaCategory) > 0 && !a.MediaCategory.DMEligible():
+		return fmt.Errorf("direct message attachment media category %s is not direct message eligible %w", a.MediaCategory, ErrParameter)
+	default:
+	}
+	return nil
+}
+
+// CreateDMConversationRequest is the group conversation to create along with the first message
+type CreateDMConversationRequest struct {
+	ParticipantIDs []string
+	Message        CreateDMMessage
+}
+
+func (r CreateDMConversationRequest) validate() error {
+	if len(r.ParticipantIDs) == 0 {
+		return fmt.Errorf("direct message conversation participant ids are required %w", ErrParameter)
+	}
+	return r.Message.validate()
+}
+
+// CreateDMEventData is the conversation and event created by sending a message
+type CreateDMEventData struct {
+	DMConversationID string `json:"dm_conversation_id"`
+	DMEventID        string `json:"dm_event_id"`
+}
+
+// CreateDMEventResponse is the response from sending a message or creating a conversation
+type CreateDMEventResponse = Response[*CreateDMEventData, NoMeta]
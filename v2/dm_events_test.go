@@ -0,0 +1,99 @@
+package twitter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCreateDMMessage_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		message CreateDMMessage
+		wantErr bool
+	}{
+		{
+			name: "text",
+			message: CreateDMMessage{
+				Text: "Hello",
+			},
+			wantErr: false,
+		},
+		{
+			name: "attachment only",
+			message: CreateDMMessage{
+				Attachments: []CreateDMAttachment{
+					{
+						MediaID:       "1455952740635586573",
+						MediaCategory: MediaCategoryDMImage,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "attachment without category",
+			message: CreateDMMessage{
+				Text: "screenshot",
+				Attachments: []CreateDMAttachment{
+					{
+						MediaID: "1455952740635586573",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "empty",
+			message: CreateDMMessage{},
+			wantErr: true,
+		},
+		{
+			name: "tweet media",
+			message: CreateDMMessage{
+				Attachments: []CreateDMAttachment{
+					{
+						MediaID:       "1455952740635586573",
+						MediaCategory: MediaCategoryTweetVideo,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no media id",
+			message: CreateDMMessage{
+				Attachments: []CreateDMAttachment{
+					{
+						MediaCategory: MediaCategoryDMGIF,
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "too many attachments",
+			message: CreateDMMessage{
+				Attachments: []CreateDMAttachment{
+					{
+						MediaID: "1455952740635586573",
+					},
+					{
+						MediaID: "1455952740635586574",
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.message.validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateDMMessage.validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrParameter) {
+				t.Errorf("CreateDMMessage.validate() error = %v, want a parameter error", err)
+			}
+		})
+	}
+}
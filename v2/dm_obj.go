@@ -0,0 +1,92 @@
+package twitter
+
+// DMEventField are the direct message event fields that can be requested
+type DMEventField string
+
+const (
+	// DMEventFieldID is the unique identifier of the event.
+	DMEventFieldID DMEventField = "id"
+	// DMEventFieldText is the actual UTF-8 text of the direct message.
+	DMEventFieldText DMEventField = "text"
+	// DMEventFieldEventType describes the type of event, MessageCreate, ParticipantsJoin or ParticipantsLeave.
+	DMEventFieldEventType DMEventField = "event_type"
+	// DMEventFieldCreatedAt is the creation time of the event.
+	DMEventFieldCreatedAt DMEventField = "created_at"
+	// DMEventFieldDMConversationID is the unique identifier of the conversation the event is part of.
+	DMEventFieldDMConversationID DMEventField = "dm_conversation_id"
+	// DMEventFieldSenderID is the unique identifier of the user who sent the message.
+	DMEventFieldSenderID DMEventField = "sender_id"
+	// DMEventFieldParticipantIDs are the unique identifiers of the users joining or leaving a group conversation.
+	DMEventFieldParticipantIDs DMEventField = "participant_ids"
+	// DMEventFieldReferencedTweets are the tweets referenced in the message.
+	DMEventFieldReferencedTweets DMEventField = "referenced_tweets"
+	// DMEventFieldAttachments are the media attached to the message.
+	DMEventFieldAttachments DMEventField = "attachments"
+)
+
+func dmEventFieldStringArray(arr []DMEventField) []string {
+	strs := make([]string, len(arr))
+	for i, field := range arr {
+		strs[i] = string(field)
+	}
+	return strs
+}
+
+// DMEventType is the kind of direct message event
+type DMEventType string
+
+const (
+	// DMEventTypeMessageCreate is a message that was sent
+	DMEventTypeMessageCreate DMEventType = "MessageCreate"
+	// DMEventTypeParticipantsJoin is when users join a group conversation
+	DMEventTypeParticipantsJoin DMEventType = "ParticipantsJoin"
+	// DMEventTypeParticipantsLeave is when users leave a group conversation
+	DMEventTypeParticipantsLeave DMEventType = "ParticipantsLeave"
+)
+
+func dmEventTypeStringArray(arr []DMEventType) []string {
+	strs := make([]string, len(arr))
+	for i, eventType := range arr {
+		strs[i] = string(eventType)
+	}
+	return strs
+}
+
+// DMEventObj is a direct message event
+type DMEventObj struct {
+	ID               string                  `json:"id"`
+	EventType        DMEventType             `json:"event_type"`
+	Text             string                  `json:"text,omitempty"`
+	SenderID         string                  `json:"sender_id,omitempty"`
+	ParticipantIDs   []string                `json:"participant_ids,omitempty"`
+	DMConversationID string                  `json:"dm_conversation_id,omitempty"`
+	CreatedAt        string                  `json:"created_at,omitempty"`
+	ReferencedTweets []*DMReferencedTweetObj `json:"referenced_tweets,omitempty"`
+	Attachments      *DMAttachmentsObj       `json:"attachments,omitempty"`
+}
+
+// IsMessage returns true if the event is a message
+func (d DMEventObj) IsMessage() bool {
+	return d.EventType == DMEventTypeMessageCreate
+}
+
+// IsParticipantsJoin returns true if the event is users joining a conversation
+func (d DMEventObj) IsParticipantsJoin() bool {
+	return d.EventType == DMEventTypeParticipantsJoin
+}
+
+// IsParticipantsLeave returns true if the event is users leaving a conversation
+func (d DMEventObj) IsParticipantsLeave() bool {
+	return d.EventType == DMEventTypeParticipantsLeave
+}
+
+// DMReferencedTweetObj is a tweet referenced in a direct message
+type DMReferencedTweetObj struct {
+	ID string `json:"id"`
+}
+
+// DMAttachmentsObj are the attachments of a direct message
+type DMAttachmentsObj struct {
+	MediaKeys []string `json:"media_keys,omitempty"`
+	CardIDs   []string `json:"card_ids,omitempty"`
+}
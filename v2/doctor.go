@@ -0,0 +1,255 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	doctorTweetID  = "20"
+	doctorUserName = "TwitterDev"
+	doctorQuery    = "from:TwitterDev"
+)
+
+// DoctorCapability is a capability that the doctor checks for
+type DoctorCapability string
+
+const (
+	// DoctorCapabilityUserContext is the credentials are for an user
+	DoctorCapabilityUserContext DoctorCapability = "user_context"
+	// DoctorCapabilityTweetLookup is looking up tweets
+	DoctorCapabilityTweetLookup DoctorCapability = "tweet_lookup"
+	// DoctorCapabilityUserLookup is looking up users
+	DoctorCapabilityUserLookup DoctorCapability = "user_lookup"
+	// DoctorCapabilityRecentSearch is the recent search
+	DoctorCapabilityRecentSearch DoctorCapability = "recent_search"
+	// DoctorCapabilityFilteredStream is the filtered stream rules
+	DoctorCapabilityFilteredStream DoctorCapability = "filtered_stream"
+	// DoctorCapabilityCompliance is the batch compliance jobs
+	DoctorCapabilityCompliance DoctorCapability = "compliance"
+	// DoctorCapabilityDirectMessages is reading direct messages
+	DoctorCapabilityDirectMessages DoctorCapability = "direct_messages"
+)
+
+// DoctorCheck is the result of a single doctor check.  If the check failed, the error and a hint are present.
+type DoctorCheck struct {
+	Capability DoctorCapability `json:"capability"`
+	Endpoint   string           `json:"endpoint"`
+	OK         bool             `json:"ok"`
+	StatusCode int              `json:"status_code,omitempty"`
+	RateLimit  *RateLimit       `json:"rate_limit,omitempty"`
+	Error      string           `json:"error,omitempty"`
+	Hint       string           `json:"hint,omitempty"`
+}
+
+// DoctorReport is the report from the doctor.  Problems are client misconfigurations found before any request is sent.
+type DoctorReport struct {
+	Problems []string       `json:"problems,omitempty"`
+	Checks   []*DoctorCheck `json:"checks"`
+}
+
+// Capabilities returns the capabilities the credentials have
+func (d *DoctorReport) Capabilities() []DoctorCapability {
+	capabilities := []DoctorCapability{}
+	for _, check := range d.Checks {
+		if check.OK {
+			capabilities = append(capabilities, check.Capability)
+		}
+	}
+	return capabilities
+}
+
+// Healthy is true when there are no problems and all of the checks passed
+func (d *DoctorReport) Healthy() bool {
+	if len(d.Problems) > 0 {
+		return false
+	}
+	for _, check := range d.Checks {
+		if !check.OK {
+			return false
+		}
+	}
+	return true
+}
+
+// Doctor will check the client configuration and then call a read only set of endpoints with the configured
+// credentials.  The report has which capabilities the credentials have, the current rate limits and hints
+// for any failures.  Nothing is created, changed or deleted.  An error is only returned if the client
+// can not send requests at all.
+func (c *Client) Doctor(ctx context.Context) (*DoctorReport, error) {
+	report := &DoctorReport{
+		Problems: c.doctorProblems(),
+		Checks:   []*DoctorCheck{},
+	}
+	if c.Client == nil || c.Authorizer == nil || len(c.Host) == 0 {
+		return report, fmt.Errorf("doctor: the client is not configured: %w", ErrParameter)
+	}
+
+	checks := []struct {
+		capability DoctorCapability
+		endpoint   endpoint
+		call       func() (*RateLimit, error)
+	}{
+		{
+			capability: DoctorCapabilityUserContext,
+			endpoint:   userAuthLookupEndpoint,
+			call: func() (*RateLimit, error) {
+				resp, err := c.AuthUserLookup(ctx, UserLookupOpts{})
+				if err != nil {
+					return nil, err
+				}
+				return resp.RateLimit, nil
+			},
+		},
+		{
+			capability: DoctorCapabilityTweetLookup,
+			endpoint:   tweetLookupEndpoint,
+			call: func() (*RateLimit, error) {
+				resp, err := c.TweetLookup(ctx, []string{doctorTweetID}, TweetLookupOpts{})
+				if err != nil {
+					return nil, err
+				}
+				return resp.RateLimit, nil
+			},
+		},
+		{
+			capability: DoctorCapabilityUserLookup,
+			endpoint:   userNameLookupEndpoint,
+			call: func() (*RateLimit, error) {
+				resp, err := c.UserNameLookup(ctx, []string{doctorUserName}, UserLookupOpts{})
+				if err != nil {
+					return nil, err
+				}
+				return resp.RateLimit, nil
+			},
+		},
+		{
+			capability: DoctorCapabilityRecentSearch,
+			endpoint:   tweetRecentSearchEndpoint,
+			call: func() (*RateLimit, error) {
+				resp, err := c.TweetRecentSearch(ctx, doctorQuery, TweetRecentSearchOpts{MaxResults: 10})
+				if err != nil {
+					return nil, err
+				}
+				return resp.RateLimit, nil
+			},
+		},
+		{
+			capability: DoctorCapabilityFilteredStream,
+			endpoint:   tweetSearchStreamRulesEndpoint,
+			call: func() (*RateLimit, error) {
+				resp, err := c.TweetSearchStreamRules(ctx, nil)
+				if err != nil {
+					return nil, err
+				}
+				return resp.RateLimit, nil
+			},
+		},
+		{
+			capability: DoctorCapabilityCompliance,
+			endpoint:   complianceJobsEndpoint,
+			call: func() (*RateLimit, error) {
+				resp, err := c.ComplianceBatchJobLookup(ctx, ComplianceBatchJobTypeTweets, ComplianceBatchJobLookupOpts{})
+				if err != nil {
+					return nil, err
+				}
+				return resp.RateLimit, nil
+			},
+		},
+		{
+			capability: DoctorCapabilityDirectMessages,
+			endpoint:   dmEventsEndpoint,
+			call: func() (*RateLimit, error) {
+				resp, err := c.DMEventsLookup(ctx, DMEventsLookupOpts{MaxResults: 1})
+				if err != nil {
+					return nil, err
+				}
+				return resp.RateLimit, nil
+			},
+		},
+	}
+
+	for _, check := range checks {
+		if err := ctx.Err(); err != nil {
+			return report, fmt.Errorf("doctor: %w", err)
+		}
+		result := &DoctorCheck{
+			Capability: check.capability,
+			Endpoint:   string(check.endpoint),
+		}
+		rl, err := check.call()
+		switch {
+		case err == nil:
+			result.OK = true
+			result.StatusCode = http.StatusOK
+			result.RateLimit = rl
+		default:
+			result.Error = err.Error()
+			result.StatusCode, result.Hint = doctorHint(check.capability, err)
+			result.RateLimit, _ = RateLimitFromError(err)
+		}
+		report.Checks = append(report.Checks, result)
+	}
+	return report, nil
+}
+
+func (c *Client) doctorProblems() []string {
+	problems := []string{}
+	if c.Authorizer == nil {
+		problems = append(problems, "the client authorizer is not set")
+	}
+	if c.Client == nil {
+		problems = append(problems, "the client http client is not set")
+	}
+	if len(c.Host) == 0 {
+		problems = append(problems, "the client host is not set, like https://api.twitter.com")
+		return problems
+	}
+	u, err := url.Parse(c.Host)
+	switch {
+	case err != nil:
+		problems = append(problems, fmt.Sprintf("the client host is not a valid url: %v", err))
+	case u.Scheme != "https":
+		problems = append(problems, "the client host should use https")
+	case strings.HasSuffix(c.Host, "/"):
+		problems = append(problems, "the client host should not end with a slash")
+	case strings.HasSuffix(strings.TrimSuffix(u.Path, "/"), "/2"):
+		problems = append(problems, "the client host should not include the api version, /2 is added to each endpoint")
+	}
+	return problems
+}
+
+func doctorHint(capability DoctorCapability, err error) (int, string) {
+	status := 0
+	var er *ErrorResponse
+	var hr *HTTPError
+	switch {
+	case errors.As(err, &er):
+		status = er.StatusCode
+	case errors.As(err, &hr):
+		status = hr.StatusCode
+	default:
+		return status, "the request could not be sent, check the host and network"
+	}
+	switch status {
+	case http.StatusUnauthorized:
+		return status, "the credentials were rejected, check that the token is valid and has not expired"
+	case http.StatusForbidden:
+		switch capability {
+		case DoctorCapabilityUserContext, DoctorCapabilityDirectMessages:
+			return status, "this endpoint needs user context credentials with the required scopes"
+		case DoctorCapabilityCompliance, DoctorCapabilityFilteredStream:
+			return status, "this endpoint needs app only credentials for a project with access"
+		default:
+			return status, "the credentials do not have access to this endpoint, check the project access level"
+		}
+	case http.StatusTooManyRequests:
+		return status, "the rate limit has been reached, wait for the reset"
+	default:
+		return status, "unexpected response from the endpoint"
+	}
+}
@@ -0,0 +1,130 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_Doctor(t *testing.T) {
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method != http.MethodGet {
+				t.Errorf("the doctor sent a %s request", req.Method)
+			}
+			header := http.Header{}
+			header.Add(rateLimit, "15")
+			header.Add(rateRemaining, "12")
+			header.Add(rateReset, "1644461060")
+
+			status := http.StatusOK
+			var body string
+			switch {
+			case strings.HasSuffix(req.URL.Path, "/2/users/me"):
+				body = `{"data":{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev"}}`
+			case strings.HasSuffix(req.URL.Path, "/2/users/by/username/TwitterDev"):
+				body = `{"data":{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev"}}`
+			case strings.HasSuffix(req.URL.Path, "/2/tweets/20"):
+				body = `{"data":{"id":"20","text":"just setting up my twttr"}}`
+			case strings.HasSuffix(req.URL.Path, "/2/tweets/search/recent"):
+				body = `{"data":[],"meta":{"result_count":0}}`
+			case strings.HasSuffix(req.URL.Path, "/2/dm_events"):
+				body = `{"data":[],"meta":{"result_count":0}}`
+			case strings.HasSuffix(req.URL.Path, "/2/tweets/search/stream/rules"),
+				strings.HasSuffix(req.URL.Path, "/2/compliance/jobs"):
+				status = http.StatusForbidden
+				body = `{"title":"Unsupported Authentication","detail":"Authenticating with OAuth 2.0 User Context is forbidden for this endpoint.","type":"https://api.twitter.com/2/problems/unsupported-authentication","status":403}`
+			default:
+				t.Errorf("the doctor called an unexpected url %s", req.URL.String())
+				status = http.StatusNotFound
+			}
+			return &http.Response{
+				StatusCode: status,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     header,
+			}
+		}),
+	}
+
+	report, err := client.Doctor(context.Background())
+	if err != nil {
+		t.Fatalf("Client.Doctor() error = %v", err)
+	}
+	if len(report.Problems) != 0 {
+		t.Errorf("Client.Doctor() problems = %v", report.Problems)
+	}
+	if report.Healthy() {
+		t.Errorf("Client.Doctor() should not be healthy")
+	}
+	want := []DoctorCapability{
+		DoctorCapabilityUserContext,
+		DoctorCapabilityTweetLookup,
+		DoctorCapabilityUserLookup,
+		DoctorCapabilityRecentSearch,
+		DoctorCapabilityDirectMessages,
+	}
+	if got := report.Capabilities(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Client.Doctor() capabilities = %v, want %v", got, want)
+	}
+	for _, check := range report.Checks {
+		if check.RateLimit == nil {
+			t.Errorf("Client.Doctor() check %s rate limit is missing", check.Capability)
+		}
+		if !check.OK && (check.StatusCode != http.StatusForbidden || len(check.Hint) == 0) {
+			t.Errorf("Client.Doctor() check %s = %+v", check.Capability, check)
+		}
+	}
+}
+
+func TestClient_doctorProblems(t *testing.T) {
+	tests := []struct {
+		name   string
+		client *Client
+		want   int
+	}{
+		{
+			name: "configured",
+			client: &Client{
+				Authorizer: &mockAuth{},
+				Client:     http.DefaultClient,
+				Host:       "https://api.twitter.com",
+			},
+			want: 0,
+		},
+		{
+			name:   "empty",
+			client: &Client{},
+			want:   3,
+		},
+		{
+			name: "version in host",
+			client: &Client{
+				Authorizer: &mockAuth{},
+				Client:     http.DefaultClient,
+				Host:       "https://api.twitter.com/2",
+			},
+			want: 1,
+		},
+		{
+			name: "trailing slash",
+			client: &Client{
+				Authorizer: &mockAuth{},
+				Client:     http.DefaultClient,
+				Host:       "https://api.twitter.com/",
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.client.doctorProblems(); len(got) != tt.want {
+				t.Errorf("Client.doctorProblems() = %v, want %d problems", got, tt.want)
+			}
+		})
+	}
+}
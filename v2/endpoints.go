@@ -53,8 +53,33 @@ const (
 	complianceJobsEndpoint                        endpoint = "2/compliance/jobs"
 	quoteTweetLookupEndpoint                      endpoint = "2/tweets/{id}/quote_tweets"
 	tweetBookmarksEndpoint                        endpoint = "2/users/{id}/bookmarks"
+	tweetBookmarkFoldersEndpoint                  endpoint = "2/users/{id}/bookmarks/folders"
+	dmEventsEndpoint                              endpoint = "2/dm_events"
+	dmConversationEventsEndpoint                  endpoint = "2/dm_conversations/{id}/dm_events"
+	dmParticipantEventsEndpoint                   endpoint = "2/dm_conversations/with/{id}/dm_events"
+	dmConversationMessagesEndpoint                endpoint = "2/dm_conversations/{id}/messages"
+	dmParticipantMessagesEndpoint                 endpoint = "2/dm_conversations/with/{id}/messages"
+	dmConversationsEndpoint                       endpoint = "2/dm_conversations"
+	trendsByWOEIDEndpoint                         endpoint = "2/trends/by/woeid/{id}"
+	personalizedTrendsEndpoint                    endpoint = "2/users/personalized_trends"
+	communityLookupEndpoint                       endpoint = "2/communities/{id}"
+	communitySearchEndpoint                       endpoint = "2/communities/search"
+	notesEligiblePostsEndpoint                    endpoint = "2/notes/search/posts_eligible_for_notes"
+	notesWrittenEndpoint                          endpoint = "2/notes/search/notes_written"
+	notesEndpoint                                 endpoint = "2/notes"
+	evaluateNoteEndpoint                          endpoint = "2/evaluate_note"
+	tweetUsageEndpoint                            endpoint = "2/usage/tweets"
+	accountActivityWebhooksEndpoint               endpoint = "1.1/account_activity/all/{id}/webhooks.json"
+	accountActivityWebhookEndpoint                endpoint = "1.1/account_activity/all/{id}/webhooks/{webhook_id}.json"
+	accountActivitySubscriptionsEndpoint          endpoint = "1.1/account_activity/all/{id}/subscriptions.json"
+	accountActivitySubscriptionEndpoint           endpoint = "1.1/account_activity/all/{id}/subscriptions/{user_id}.json"
+	accountActivitySubscriptionsListEndpoint      endpoint = "1.1/account_activity/all/{id}/subscriptions/list.json"
+	geoPlaceEndpoint                              endpoint = "1.1/geo/id/{id}.json"
+	geoSearchEndpoint                             endpoint = "1.1/geo/search.json"
 
-	idTag = "{id}"
+	idTag        = "{id}"
+	webhookIDTag = "{webhook_id}"
+	userIDTag    = "{user_id}"
 )
 
 func (e endpoint) url(host string) string {
@@ -0,0 +1,114 @@
+package twitter
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrEntityOffset is matched when an entity's offsets are not within the text
+var ErrEntityOffset = errors.New("twitter entity offsets are not within the text")
+
+// EntityOffsetError has the entity offsets that could not be converted and the text length in UTF-16 code units
+type EntityOffsetError struct {
+	Start  int
+	End    int
+	Length int
+}
+
+func (e *EntityOffsetError) Error() string {
+	return fmt.Sprintf("%s: start %d end %d length %d", ErrEntityOffset.Error(), e.Start, e.End, e.Length)
+}
+
+// Is will match ErrEntityOffset
+func (e *EntityOffsetError) Is(target error) bool {
+	return target == ErrEntityOffset
+}
+
+// utf16Offsets will return the byte offset of each UTF-16 code unit of the text.  The offset of the
+// second unit of a surrogate pair is -1, as it is not a boundary in the text.  The last offset is the text length.
+func utf16Offsets(text string) []int {
+	offsets := make([]int, 0, len(text)+1)
+	for i, r := range text {
+		offsets = append(offsets, i)
+		if r >= 0x10000 {
+			offsets = append(offsets, -1)
+		}
+	}
+	return append(offsets, len(text))
+}
+
+// ByteRange will convert the entity's UTF-16 code unit offsets into byte offsets of the text, so text[start:end]
+// is the entity.  Emoji and other characters outside of the basic plane are two code units but one rune.
+func (e EntityObj) ByteRange(text string) (int, int, error) {
+	offsets := utf16Offsets(text)
+	if e.Start < 0 || e.End < e.Start || e.End >= len(offsets) || offsets[e.Start] < 0 || offsets[e.End] < 0 {
+		return 0, 0, &EntityOffsetError{
+			Start:  e.Start,
+			End:    e.End,
+			Length: len(offsets) - 1,
+		}
+	}
+	return offsets[e.Start], offsets[e.End], nil
+}
+
+// RuneRange will convert the entity's UTF-16 code unit offsets into rune offsets of the text, so
+// []rune(text)[start:end] is the entity.
+func (e EntityObj) RuneRange(text string) (int, int, error) {
+	start, end, err := e.ByteRange(text)
+	if err != nil {
+		return 0, 0, err
+	}
+	return utf8.RuneCountInString(text[:start]), utf8.RuneCountInString(text[:end]), nil
+}
+
+// Text will return the entity's text
+func (e EntityObj) Text(text string) (string, error) {
+	start, end, err := e.ByteRange(text)
+	if err != nil {
+		return "", err
+	}
+	return text[start:end], nil
+}
+
+// EntityReplacement is the text that will replace an entity
+type EntityReplacement struct {
+	Entity EntityObj
+	Text   string
+}
+
+// SpliceEntities will replace each entity of the text, like wrapping hashtags and urls in links.  The replacements can
+// be in any order, but can not overlap.
+func SpliceEntities(text string, replacements []EntityReplacement) (string, error) {
+	type span struct {
+		start int
+		end   int
+		text  string
+	}
+	spans := make([]span, len(replacements))
+	for i, r := range replacements {
+		start, end, err := r.Entity.ByteRange(text)
+		if err != nil {
+			return "", err
+		}
+		spans[i] = span{start: start, end: end, text: r.Text}
+	}
+	sort.SliceStable(spans, func(i, j int) bool {
+		return spans[i].start < spans[j].start
+	})
+
+	sb := strings.Builder{}
+	last := 0
+	for _, s := range spans {
+		if s.start < last {
+			return "", fmt.Errorf("splice entities: entity at byte %d overlaps the previous entity", s.start)
+		}
+		sb.WriteString(text[last:s.start])
+		sb.WriteString(s.text)
+		last = s.end
+	}
+	sb.WriteString(text[last:])
+	return sb.String(), nil
+}
@@ -0,0 +1,43 @@
+package twitter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEntityObj_ByteRange(t *testing.T) {
+	text := "🎉 #golang is fun"
+	entity := EntityObj{Start: 3, End: 10}
+
+	got, err := entity.Text(text)
+	if err != nil || got != "#golang" {
+		t.Errorf("EntityObj.Text() = %q %v, want #golang", got, err)
+	}
+	start, end, err := entity.RuneRange(text)
+	if err != nil || start != 2 || end != 9 {
+		t.Errorf("EntityObj.RuneRange() = %d %d %v, want 2 9", start, end, err)
+	}
+	for _, bad := range []EntityObj{{Start: 1, End: 3}, {Start: 3, End: 30}, {Start: 5, End: 4}} {
+		if _, _, err := bad.ByteRange(text); !errors.Is(err, ErrEntityOffset) {
+			t.Errorf("EntityObj.ByteRange(%v) error = %v, want ErrEntityOffset", bad, err)
+		}
+	}
+}
+
+func TestSpliceEntities(t *testing.T) {
+	text := "😀 @gopher likes #golang"
+	got, err := SpliceEntities(text, []EntityReplacement{
+		{Entity: EntityObj{Start: 17, End: 24}, Text: "<a>#golang</a>"},
+		{Entity: EntityObj{Start: 3, End: 10}, Text: "<a>@gopher</a>"},
+	})
+	if want := "😀 <a>@gopher</a> likes <a>#golang</a>"; err != nil || got != want {
+		t.Errorf("SpliceEntities() = %q %v, want %q", got, err, want)
+	}
+	_, err = SpliceEntities(text, []EntityReplacement{
+		{Entity: EntityObj{Start: 3, End: 10}},
+		{Entity: EntityObj{Start: 5, End: 12}},
+	})
+	if err == nil {
+		t.Errorf("SpliceEntities() should not splice overlapping entities")
+	}
+}
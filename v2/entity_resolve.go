@@ -0,0 +1,314 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// EntityType is the type of object an input was resolved to
+type EntityType string
+
+const (
+	// EntityTypeTweet is an input resolved to a tweet
+	EntityTypeTweet EntityType = "tweet"
+	// EntityTypeUser is an input resolved to an user
+	EntityTypeUser EntityType = "user"
+	// EntityTypeUnknown is an input that could not be resolved
+	EntityTypeUnknown EntityType = "unknown"
+)
+
+type entityInputKind int
+
+const (
+	entityInputUnknown entityInputKind = iota
+	entityInputID
+	entityInputTweetID
+	entityInputUserID
+	entityInputUserName
+)
+
+var (
+	entityIDRegex       = regexp.MustCompile(`^[0-9]{1,19}$`)
+	entityUserNameRegex = regexp.MustCompile(`^[A-Za-z0-9_]{1,15}$`)
+	entityHosts         = map[string]bool{
+		"twitter.com":        true,
+		"www.twitter.com":    true,
+		"mobile.twitter.com": true,
+		"x.com":              true,
+		"www.x.com":          true,
+		"mobile.x.com":       true,
+	}
+	entityReservedPaths = map[string]bool{
+		"home":          true,
+		"explore":       true,
+		"search":        true,
+		"hashtag":       true,
+		"notifications": true,
+		"messages":      true,
+		"settings":      true,
+		"i":             true,
+		"intent":        true,
+		"share":         true,
+	}
+)
+
+// ResolveEntitiesOpts are the options for resolving entities
+type ResolveEntitiesOpts struct {
+	TweetFields []TweetField
+	UserFields  []UserField
+}
+
+// ResolvedEntity is the result of resolving a single input.  Only one of tweet or user will be present
+// depending on the type.  If the input could not be resolved, the type will be unknown and the error
+// may contain the partial error from the lookup.
+type ResolvedEntity struct {
+	Input string
+	Type  EntityType
+	Tweet *TweetObj
+	User  *UserObj
+	Error *ErrorObj
+}
+
+// ResolveEntitiesResponse is the response from resolving entities.  The entities are in the same order as the inputs.
+type ResolveEntitiesResponse struct {
+	Entities  []*ResolvedEntity
+	RateLimit *RateLimit
+}
+
+type entityInput struct {
+	kind  entityInputKind
+	value string
+}
+
+func parseEntityInput(input string) entityInput {
+	in := strings.TrimSpace(input)
+	switch {
+	case len(in) == 0:
+		return entityInput{kind: entityInputUnknown}
+	case strings.HasPrefix(in, "@"):
+		name := strings.TrimPrefix(in, "@")
+		if entityUserNameRegex.MatchString(name) {
+			return entityInput{kind: entityInputUserName, value: name}
+		}
+		return entityInput{kind: entityInputUnknown}
+	case entityIDRegex.MatchString(in):
+		return entityInput{kind: entityInputID, value: in}
+	case strings.Contains(in, "/"):
+		return parseEntityURL(in)
+	case entityUserNameRegex.MatchString(in):
+		return entityInput{kind: entityInputUserName, value: in}
+	default:
+		return entityInput{kind: entityInputUnknown}
+	}
+}
+
+func parseEntityURL(in string) entityInput {
+	if !strings.Contains(in, "://") {
+		in = "https://" + in
+	}
+	u, err := url.Parse(in)
+	if err != nil || !entityHosts[strings.ToLower(u.Hostname())] {
+		return entityInput{kind: entityInputUnknown}
+	}
+	segments := pathSegments(u.Path)
+	switch {
+	case len(segments) >= 4 && segments[0] == "i" && segments[1] == "web" && segments[2] == "status" && entityIDRegex.MatchString(segments[3]):
+		return entityInput{kind: entityInputTweetID, value: segments[3]}
+	case len(segments) >= 3 && segments[0] == "i" && segments[1] == "user" && entityIDRegex.MatchString(segments[2]):
+		return entityInput{kind: entityInputUserID, value: segments[2]}
+	case len(segments) >= 3 && (segments[1] == "status" || segments[1] == "statuses") && entityIDRegex.MatchString(segments[2]):
+		return entityInput{kind: entityInputTweetID, value: segments[2]}
+	case len(segments) >= 1 && !entityReservedPaths[strings.ToLower(segments[0])] && entityUserNameRegex.MatchString(segments[0]):
+		return entityInput{kind: entityInputUserName, value: segments[0]}
+	default:
+		return entityInput{kind: entityInputUnknown}
+	}
+}
+
+// ResolveEntities will take mixed inputs, tweet ids, user ids, handles and tweet or profile URLs, and resolve each one
+// to the tweet or user it references.  The lookups are batched by type.  A bare numeric id is first looked up as a tweet
+// and then, if not found, as an user.
+func (c *Client) ResolveEntities(ctx context.Context, inputs []string, opts ResolveEntitiesOpts) (*ResolveEntitiesResponse, error) {
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("resolve entities: an input is required: %w", ErrParameter)
+	}
+
+	parsed := make([]entityInput, len(inputs))
+	tweetIDs := []string{}
+	userIDs := []string{}
+	userNames := []string{}
+	for i, input := range inputs {
+		parsed[i] = parseEntityInput(input)
+		switch parsed[i].kind {
+		case entityInputID, entityInputTweetID:
+			tweetIDs = append(tweetIDs, parsed[i].value)
+		case entityInputUserID:
+			userIDs = append(userIDs, parsed[i].value)
+		case entityInputUserName:
+			userNames = append(userNames, strings.ToLower(parsed[i].value))
+		default:
+		}
+	}
+
+	resolver := &entityResolver{
+		client:    c,
+		tweets:    map[string]*TweetObj{},
+		users:     map[string]*UserObj{},
+		userNames: map[string]*UserObj{},
+		errs:      map[string]*ErrorObj{},
+	}
+
+	if err := resolver.lookupTweets(ctx, unique(tweetIDs), opts); err != nil {
+		return nil, err
+	}
+	for _, p := range parsed {
+		if _, has := resolver.tweets[p.value]; p.kind == entityInputID && !has {
+			userIDs = append(userIDs, p.value)
+		}
+	}
+	if err := resolver.lookupUsers(ctx, unique(userIDs), opts); err != nil {
+		return nil, err
+	}
+	if err := resolver.lookupUserNames(ctx, unique(userNames), opts); err != nil {
+		return nil, err
+	}
+
+	entities := make([]*ResolvedEntity, len(inputs))
+	for i, p := range parsed {
+		entity := &ResolvedEntity{
+			Input: inputs[i],
+			Type:  EntityTypeUnknown,
+		}
+		switch p.kind {
+		case entityInputID:
+			if tweet, has := resolver.tweets[p.value]; has {
+				entity.Type = EntityTypeTweet
+				entity.Tweet = tweet
+			} else if user, has := resolver.users[p.value]; has {
+				entity.Type = EntityTypeUser
+				entity.User = user
+			}
+		case entityInputTweetID:
+			if tweet, has := resolver.tweets[p.value]; has {
+				entity.Type = EntityTypeTweet
+				entity.Tweet = tweet
+			}
+		case entityInputUserID:
+			if user, has := resolver.users[p.value]; has {
+				entity.Type = EntityTypeUser
+				entity.User = user
+			}
+		case entityInputUserName:
+			if user, has := resolver.userNames[strings.ToLower(p.value)]; has {
+				entity.Type = EntityTypeUser
+				entity.User = user
+			}
+		default:
+		}
+		if entity.Type == EntityTypeUnknown {
+			entity.Error = resolver.errs[strings.ToLower(p.value)]
+		}
+		entities[i] = entity
+	}
+
+	return &ResolveEntitiesResponse{
+		Entities:  entities,
+		RateLimit: resolver.rateLimit,
+	}, nil
+}
+
+type entityResolver struct {
+	client    *Client
+	tweets    map[string]*TweetObj
+	users     map[string]*UserObj
+	userNames map[string]*UserObj
+	errs      map[string]*ErrorObj
+	rateLimit *RateLimit
+}
+
+func (r *entityResolver) addErrors(errs []*ErrorObj) {
+	for _, e := range errs {
+		if value, ok := e.Value.(string); ok {
+			r.errs[strings.ToLower(value)] = e
+		}
+	}
+}
+
+func (r *entityResolver) lookupTweets(ctx context.Context, ids []string, opts ResolveEntitiesOpts) error {
+	for _, batch := range chunk(ids, tweetMaxIDs) {
+		resp, err := r.client.TweetLookup(ctx, batch, TweetLookupOpts{TweetFields: opts.TweetFields})
+		if err != nil {
+			return fmt.Errorf("resolve entities tweet lookup: %w", err)
+		}
+		r.rateLimit = resp.RateLimit
+		for _, tweet := range resp.Raw.Tweets {
+			if tweet != nil {
+				r.tweets[tweet.ID] = tweet
+			}
+		}
+		r.addErrors(resp.Raw.Errors)
+	}
+	return nil
+}
+
+func (r *entityResolver) lookupUsers(ctx context.Context, ids []string, opts ResolveEntitiesOpts) error {
+	for _, batch := range chunk(ids, userMaxIDs) {
+		resp, err := r.client.UserLookup(ctx, batch, UserLookupOpts{UserFields: opts.UserFields})
+		if err != nil {
+			return fmt.Errorf("resolve entities user lookup: %w", err)
+		}
+		r.rateLimit = resp.RateLimit
+		for _, user := range resp.Raw.Users {
+			if user != nil {
+				r.users[user.ID] = user
+			}
+		}
+		r.addErrors(resp.Raw.Errors)
+	}
+	return nil
+}
+
+func (r *entityResolver) lookupUserNames(ctx context.Context, names []string, opts ResolveEntitiesOpts) error {
+	for _, batch := range chunk(names, userMaxNames) {
+		resp, err := r.client.UserNameLookup(ctx, batch, UserLookupOpts{UserFields: opts.UserFields})
+		if err != nil {
+			return fmt.Errorf("resolve entities username lookup: %w", err)
+		}
+		r.rateLimit = resp.RateLimit
+		for _, user := range resp.Raw.Users {
+			if user != nil {
+				r.userNames[strings.ToLower(user.UserName)] = user
+			}
+		}
+		r.addErrors(resp.Raw.Errors)
+	}
+	return nil
+}
+
+func unique(values []string) []string {
+	seen := map[string]bool{}
+	u := []string{}
+	for _, v := range values {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		u = append(u, v)
+	}
+	return u
+}
+
+func chunk(values []string, size int) [][]string {
+	chunks := [][]string{}
+	for len(values) > size {
+		chunks = append(chunks, values[:size])
+		values = values[size:]
+	}
+	if len(values) > 0 {
+		chunks = append(chunks, values)
+	}
+	return chunks
+}
@@ -0,0 +1,151 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_parseEntityInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  entityInput
+	}{
+		{
+			name:  "bare id",
+			input: "1460323737035677698",
+			want:  entityInput{kind: entityInputID, value: "1460323737035677698"},
+		},
+		{
+			name:  "handle",
+			input: "@TwitterDev",
+			want:  entityInput{kind: entityInputUserName, value: "TwitterDev"},
+		},
+		{
+			name:  "username",
+			input: " TwitterDev ",
+			want:  entityInput{kind: entityInputUserName, value: "TwitterDev"},
+		},
+		{
+			name:  "tweet url",
+			input: "https://twitter.com/TwitterDev/status/1460323737035677698?s=20",
+			want:  entityInput{kind: entityInputTweetID, value: "1460323737035677698"},
+		},
+		{
+			name:  "x tweet url without scheme",
+			input: "x.com/TwitterDev/status/1460323737035677698",
+			want:  entityInput{kind: entityInputTweetID, value: "1460323737035677698"},
+		},
+		{
+			name:  "web status url",
+			input: "https://twitter.com/i/web/status/1460323737035677698",
+			want:  entityInput{kind: entityInputTweetID, value: "1460323737035677698"},
+		},
+		{
+			name:  "user id url",
+			input: "https://twitter.com/i/user/2244994945",
+			want:  entityInput{kind: entityInputUserID, value: "2244994945"},
+		},
+		{
+			name:  "profile url",
+			input: "https://x.com/TwitterDev",
+			want:  entityInput{kind: entityInputUserName, value: "TwitterDev"},
+		},
+		{
+			name:  "reserved path",
+			input: "https://twitter.com/search?q=golang",
+			want:  entityInput{kind: entityInputUnknown},
+		},
+		{
+			name:  "other host",
+			input: "https://example.com/TwitterDev",
+			want:  entityInput{kind: entityInputUnknown},
+		},
+		{
+			name:  "bad handle",
+			input: "@not a handle",
+			want:  entityInput{kind: entityInputUnknown},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseEntityInput(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseEntityInput() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_ResolveEntities(t *testing.T) {
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			var body string
+			switch {
+			case strings.HasSuffix(req.URL.Path, userNameLookupEndpoint.url("")+"/username/twitterdev"):
+				body = `{"data":{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev"}}`
+			case strings.HasSuffix(req.URL.Path, tweetLookupEndpoint.url("")):
+				if req.URL.Query().Get("ids") != "1460323737035677698,783214" {
+					log.Panicf("the tweet ids are not correct %s", req.URL.Query().Get("ids"))
+				}
+				body = `{
+					"data":[{"id":"1460323737035677698","text":"Introducing a new era"}],
+					"errors":[{"value":"783214","detail":"Could not find tweet with ids: [783214].","title":"Not Found Error","resource_type":"tweet","parameter":"ids","type":"https://api.twitter.com/2/problems/resource-not-found"}]
+				}`
+			case strings.HasSuffix(req.URL.Path, userLookupEndpoint.url("")+"/783214"):
+				body = `{"data":{"id":"783214","name":"Twitter","username":"Twitter"}}`
+			default:
+				log.Panicf("the url is not correct %s", req.URL.String())
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header: func() http.Header {
+					h := http.Header{}
+					h.Add(rateLimit, "15")
+					h.Add(rateRemaining, "12")
+					h.Add(rateReset, "1644461060")
+					return h
+				}(),
+			}
+		}),
+	}
+
+	got, err := client.ResolveEntities(context.Background(), []string{
+		"https://twitter.com/TwitterDev/status/1460323737035677698",
+		"@TwitterDev",
+		"783214",
+		"https://twitter.com/home",
+	}, ResolveEntitiesOpts{})
+	if err != nil {
+		t.Fatalf("Client.ResolveEntities() error = %v", err)
+	}
+	wantTypes := []EntityType{EntityTypeTweet, EntityTypeUser, EntityTypeUser, EntityTypeUnknown}
+	if len(got.Entities) != len(wantTypes) {
+		t.Fatalf("Client.ResolveEntities() entities = %d, want %d", len(got.Entities), len(wantTypes))
+	}
+	for i, want := range wantTypes {
+		if got.Entities[i].Type != want {
+			t.Errorf("Client.ResolveEntities() entity %d type = %v, want %v", i, got.Entities[i].Type, want)
+		}
+	}
+	if got.Entities[2].User.UserName != "Twitter" {
+		t.Errorf("Client.ResolveEntities() numeric id did not fall back to user lookup %v", got.Entities[2].User)
+	}
+	if got.RateLimit == nil {
+		t.Errorf("Client.ResolveEntities() rate limit is missing")
+	}
+}
+
+func TestClient_ResolveEntities_Parameter(t *testing.T) {
+	client := &Client{}
+	if _, err := client.ResolveEntities(context.Background(), nil, ResolveEntitiesOpts{}); err == nil {
+		t.Errorf("Client.ResolveEntities() expected a parameter error")
+	}
+}
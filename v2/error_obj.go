@@ -1,6 +1,9 @@
 package twitter
 
-import "fmt"
+import (
+	"fmt"
+	"net/http"
+)
 
 // ResponseDecodeError is an error when a response has a decoding error, JSON.
 type ResponseDecodeError struct {
@@ -18,16 +21,36 @@ func (r *ResponseDecodeError) Unwrap() error {
 	return r.Err
 }
 
-// HTTPError is a response error where the body is not JSON, but XML.  This commonly seen in 404 errors.
+// HTTPError is a response error where the body is not JSON, but XML.  This commonly seen in 404 errors.  The
+// TransactionID is twitter's id of the request, which can be given to twitter support.
 type HTTPError struct {
-	Status     string
-	StatusCode int
-	URL        string
-	RateLimit  *RateLimit
+	Status        string
+	StatusCode    int
+	URL           string
+	RateLimit     *RateLimit
+	TransactionID string
 }
 
 func (h HTTPError) Error() string {
-	return fmt.Sprintf("twitter [%s] status: %s code: %d", h.URL, h.Status, h.StatusCode)
+	msg := fmt.Sprintf("twitter [%s] status: %s code: %d", h.URL, h.Status, h.StatusCode)
+	if len(h.TransactionID) > 0 {
+		msg += " transaction id: " + h.TransactionID
+	}
+	return msg
+}
+
+// Is will match the sentinel error of the status code, like ErrNotFound
+func (h HTTPError) Is(target error) bool {
+	err := statusError(h.StatusCode)
+	return err != nil && err == target
+}
+
+// transactionID returns twitter's id of the request from the response headers
+func transactionID(header http.Header) string {
+	if id := header.Get("x-transaction-id"); len(id) > 0 {
+		return id
+	}
+	return header.Get("x-request-id")
 }
 
 // ErrorObj is part of the partial errors in the response
@@ -36,26 +59,50 @@ type ErrorObj struct {
 	Detail       string      `json:"detail"`
 	Type         string      `json:"type"`
 	ResourceType string      `json:"resource_type"`
+	ResourceID   string      `json:"resource_id,omitempty"`
 	Parameter    string      `json:"parameter"`
 	Value        interface{} `json:"value"`
 }
 
-// Error is part of the HTTP response error
+// Error is part of the HTTP response error.  Invalid requests have the parameters and message, the other problems
+// have the details of the resource, like the id of the resource that was not found.
 type Error struct {
-	Parameters interface{} `json:"parameters"`
-	Message    string      `json:"message"`
+	Parameters   interface{} `json:"parameters"`
+	Message      string      `json:"message"`
+	Title        string      `json:"title,omitempty"`
+	Detail       string      `json:"detail,omitempty"`
+	Type         string      `json:"type,omitempty"`
+	ResourceType string      `json:"resource_type,omitempty"`
+	ResourceID   string      `json:"resource_id,omitempty"`
+	Parameter    string      `json:"parameter,omitempty"`
+	Value        interface{} `json:"value,omitempty"`
 }
 
-// ErrorResponse is returned by a non-success callout
+// ErrorResponse is returned by a non-success callout.  It has the problem details of the response, the type is a URI
+// of the problem, like https://api.twitter.com/2/problems/invalid-request.  The TransactionID is twitter's id of the
+// request, which can be given to twitter support.
 type ErrorResponse struct {
-	StatusCode int
-	Errors     []Error    `json:"errors"`
-	Title      string     `json:"title"`
-	Detail     string     `json:"detail"`
-	Type       string     `json:"type"`
-	RateLimit  *RateLimit `json:"-"`
+	StatusCode    int
+	Errors        []Error    `json:"errors"`
+	Title         string     `json:"title"`
+	Detail        string     `json:"detail"`
+	Type          string     `json:"type"`
+	Status        int        `json:"status,omitempty"`
+	Reason        string     `json:"reason,omitempty"`
+	RateLimit     *RateLimit `json:"-"`
+	TransactionID string     `json:"-"`
 }
 
 func (e ErrorResponse) Error() string {
-	return fmt.Sprintf("twitter callout status %d %s:%s", e.StatusCode, e.Title, e.Detail)
+	msg := fmt.Sprintf("twitter callout status %d %s:%s", e.StatusCode, e.Title, e.Detail)
+	if len(e.TransactionID) > 0 {
+		msg += " transaction id: " + e.TransactionID
+	}
+	return msg
+}
+
+// Is will match the sentinel error of the status code, like ErrRateLimited
+func (e ErrorResponse) Is(target error) bool {
+	err := statusError(e.StatusCode)
+	return err != nil && err == target
 }
@@ -0,0 +1,119 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestErrorResponse_Is(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		want       error
+	}{
+		{
+			name:       "unauthorized",
+			statusCode: http.StatusUnauthorized,
+			body:       `{"title":"Unauthorized","type":"about:blank","status":401,"detail":"Unauthorized"}`,
+			want:       ErrUnauthorized,
+		},
+		{
+			name:       "forbidden",
+			statusCode: http.StatusForbidden,
+			body:       `{"title":"Forbidden","type":"about:blank","status":403,"detail":"Forbidden"}`,
+			want:       ErrForbidden,
+		},
+		{
+			name:       "not found html",
+			statusCode: http.StatusNotFound,
+			body:       `<html>not found</html>`,
+			want:       ErrNotFound,
+		},
+		{
+			name:       "rate limited",
+			statusCode: http.StatusTooManyRequests,
+			body:       `{"title":"Too Many Requests","type":"about:blank","status":429,"detail":"Too Many Requests"}`,
+			want:       ErrRateLimited,
+		},
+		{
+			name:       "server error",
+			statusCode: http.StatusInternalServerError,
+			body:       `{"title":"Internal Error","type":"about:blank","status":500,"detail":"Internal Error"}`,
+		},
+	}
+	sentinels := []error{ErrUnauthorized, ErrForbidden, ErrNotFound, ErrRateLimited}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Client: mockHTTPClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: tt.statusCode,
+						Body:       io.NopCloser(strings.NewReader(tt.body)),
+						Request:    req,
+					}
+				}),
+			}
+			_, err := client.AuthUserLookup(context.Background(), UserLookupOpts{})
+			err = fmt.Errorf("wrapped: %w", err)
+			for _, sentinel := range sentinels {
+				if got := errors.Is(err, sentinel); got != (sentinel == tt.want) {
+					t.Errorf("errors.Is(%v, %v) = %v", err, sentinel, got)
+				}
+			}
+		})
+	}
+}
+
+func TestErrorResponse_problemDetails(t *testing.T) {
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			header := http.Header{}
+			header.Add("x-transaction-id", "1a2b3c4d5e6f")
+			return &http.Response{
+				StatusCode: http.StatusForbidden,
+				Header:     header,
+				Body: io.NopCloser(strings.NewReader(`{
+					"title": "Unsupported Authentication",
+					"detail": "Authenticating with OAuth 2.0 Application-Only is forbidden for this endpoint.",
+					"type": "https://api.twitter.com/2/problems/unsupported-authentication",
+					"status": 403,
+					"errors": [
+						{
+							"parameters": {},
+							"message": "Unsupported Authentication",
+							"resource_type": "user",
+							"resource_id": "2244994945",
+							"parameter": "id",
+							"value": "2244994945"
+						}
+					]
+				}`)),
+				Request: req,
+			}
+		}),
+	}
+	_, err := client.AuthUserLookup(context.Background(), UserLookupOpts{})
+	er := &ErrorResponse{}
+	if !errors.As(err, &er) {
+		t.Fatalf("Client.AuthUserLookup() error = %v, want an error response", err)
+	}
+	if er.TransactionID != "1a2b3c4d5e6f" || er.Status != http.StatusForbidden || er.Type != "https://api.twitter.com/2/problems/unsupported-authentication" {
+		t.Errorf("ErrorResponse = %+v", er)
+	}
+	if len(er.Errors) != 1 || er.Errors[0].ResourceID != "2244994945" || er.Errors[0].ResourceType != "user" || er.Errors[0].Parameter != "id" {
+		t.Errorf("ErrorResponse errors = %+v", er.Errors)
+	}
+	if !strings.Contains(err.Error(), "transaction id: 1a2b3c4d5e6f") {
+		t.Errorf("ErrorResponse.Error() = %s, want the transaction id", err.Error())
+	}
+}
@@ -1,6 +1,43 @@
 package twitter
 
-import "errors"
+import (
+	"errors"
+	"net/http"
+)
 
 // ErrParameter will indicate that the error is from an invalid input parameter
 var ErrParameter = errors.New("twitter input parameter error")
+
+// ErrUnauthorized will indicate that twitter responded the request is not authorized
+var ErrUnauthorized = errors.New("twitter request is unauthorized")
+
+// ErrForbidden will indicate that twitter responded the request is forbidden, like an endpoint not in the app's access level
+var ErrForbidden = errors.New("twitter request is forbidden")
+
+// ErrNotFound will indicate that twitter responded the resource or endpoint is not found
+var ErrNotFound = errors.New("twitter resource not found")
+
+// ErrStreamStalled will indicate that a stream received neither data nor a keep alive within the stall timeout
+var ErrStreamStalled = errors.New("twitter stream stalled")
+
+// ErrStreamOverflow will indicate that a stream message channel was full with the error overflow policy
+var ErrStreamOverflow = errors.New("twitter stream consumer is behind")
+
+// ErrResponseTooLarge will indicate that a response body was larger than the client's max response size
+var ErrResponseTooLarge = errors.New("twitter response is too large")
+
+// statusError returns the sentinel error of the response status code, or nil if there is not one
+func statusError(statusCode int) error {
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	default:
+		return nil
+	}
+}
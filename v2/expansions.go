@@ -32,6 +32,10 @@ const (
 	ExpansionInvitedUserIDs Expansion = "invited_user_ids"
 	// ExpansionHostIDs returns the host ids
 	ExpansionHostIDs Expansion = "host_ids"
+	// ExpansionSenderID returns the user object of the direct message sender
+	ExpansionSenderID Expansion = "sender_id"
+	// ExpansionParticipantIDs returns the user objects of the direct message participants
+	ExpansionParticipantIDs Expansion = "participant_ids"
 )
 
 func expansionStringArray(arr []Expansion) []string {
@@ -0,0 +1,368 @@
+// Package export streams tweets from the twitter client to one or more sinks.
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+const (
+	defaultBufferSize  = 100
+	defaultMaxAttempts = 3
+	defaultBackoff     = time.Second
+)
+
+var (
+	// ErrPipelineClosed is returned when exporting to a pipeline that has been closed
+	ErrPipelineClosed = errors.New("export pipeline is closed")
+	// ErrSinkFull is returned when a sink's buffer is full and the overflow policy is to error
+	ErrSinkFull = errors.New("export sink buffer is full")
+)
+
+// Sink is a destination for exported tweets, like a NDJSON file, a message bus or a database.  A sink is
+// only called from one go routine.
+type Sink interface {
+	Write(ctx context.Context, tweets []*twitter.TweetDictionary) error
+	Close() error
+}
+
+// SinkFunc is an adapter to allow a function to be used as a sink
+type SinkFunc func(ctx context.Context, tweets []*twitter.TweetDictionary) error
+
+// Write will call the function
+func (f SinkFunc) Write(ctx context.Context, tweets []*twitter.TweetDictionary) error {
+	return f(ctx, tweets)
+}
+
+// Close does nothing
+func (f SinkFunc) Close() error {
+	return nil
+}
+
+// OverflowPolicy is what happens when a sink's buffer is full
+type OverflowPolicy int
+
+const (
+	// OverflowBlock will block the export until the sink has room or the context is done
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDrop will drop the batch for the sink and count it
+	OverflowDrop
+	// OverflowError will return ErrSinkFull from the export
+	OverflowError
+)
+
+// SinkConfig is the configuration of a sink in a pipeline.  BufferSize is the number of batches that can be
+// queued for the sink and defaults to 100.  MaxAttempts is the number of times a batch write is attempted and
+// defaults to 3.  Backoff is the wait between attempts, it is doubled after each failure and defaults to one second.
+type SinkConfig struct {
+	Name        string
+	Sink        Sink
+	BufferSize  int
+	MaxAttempts int
+	Backoff     time.Duration
+	Overflow    OverflowPolicy
+}
+
+// SinkHealth is the health of a single sink.  A sink is healthy if its last write succeeded.
+type SinkHealth struct {
+	Name          string
+	Healthy       bool
+	Pending       int
+	Written       int64
+	Failed        int64
+	Dropped       int64
+	Retries       int64
+	LastWrite     time.Time
+	LastError     error
+	LastErrorTime time.Time
+}
+
+// Health is the combined health of the pipeline.  The pipeline is healthy only if all of the sinks are healthy.
+type Health struct {
+	Healthy bool
+	Sinks   []SinkHealth
+}
+
+// Pipeline will fan out exported tweets to multiple sinks.  Each sink has its own buffer, retry and overflow
+// policy so that a slow or failing sink does not hold up the others.
+type Pipeline struct {
+	sinks   []*sinkWorker
+	mutex   sync.RWMutex
+	closed  bool
+	closing chan struct{}
+	exports sync.WaitGroup
+	wg      sync.WaitGroup
+	cancel  context.CancelFunc
+}
+
+// NewPipeline will create and start a pipeline to the sinks
+func NewPipeline(configs ...SinkConfig) (*Pipeline, error) {
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("export pipeline: a sink is required: %w", twitter.ErrParameter)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &Pipeline{
+		sinks:   make([]*sinkWorker, len(configs)),
+		closing: make(chan struct{}),
+		cancel:  cancel,
+	}
+	for i, config := range configs {
+		if config.Sink == nil {
+			cancel()
+			return nil, fmt.Errorf("export pipeline: sink %d is required: %w", i, twitter.ErrParameter)
+		}
+		if len(config.Name) == 0 {
+			config.Name = fmt.Sprintf("sink-%d", i)
+		}
+		if config.BufferSize <= 0 {
+			config.BufferSize = defaultBufferSize
+		}
+		if config.MaxAttempts <= 0 {
+			config.MaxAttempts = defaultMaxAttempts
+		}
+		if config.Backoff <= 0 {
+			config.Backoff = defaultBackoff
+		}
+		p.sinks[i] = &sinkWorker{
+			config:  config,
+			batches: make(chan []*twitter.TweetDictionary, config.BufferSize),
+			healthy: true,
+		}
+	}
+	for _, s := range p.sinks {
+		p.wg.Add(1)
+		go func(s *sinkWorker) {
+			defer p.wg.Done()
+			s.run(ctx)
+		}(s)
+	}
+	return p, nil
+}
+
+// Export will send the tweets to all of the sinks.  The sinks with room are sent the tweets first, so a full sink
+// that blocks does not hold up the others.  An error is returned if a sink is full and its policy is to error, or if
+// the context is done or the pipeline is closed while blocked on a sink.
+func (p *Pipeline) Export(ctx context.Context, tweets []*twitter.TweetDictionary) error {
+	if len(tweets) == 0 {
+		return nil
+	}
+	p.mutex.RLock()
+	if p.closed {
+		p.mutex.RUnlock()
+		return ErrPipelineClosed
+	}
+	p.exports.Add(1)
+	p.mutex.RUnlock()
+	defer p.exports.Done()
+
+	var err error
+	blocked := []*sinkWorker{}
+	for _, s := range p.sinks {
+		queued, serr := s.offer(tweets)
+		switch {
+		case serr != nil:
+			if err == nil {
+				err = fmt.Errorf("export sink %s: %w", s.config.Name, serr)
+			}
+		case !queued:
+			blocked = append(blocked, s)
+		default:
+		}
+	}
+	for _, s := range blocked {
+		if serr := s.enqueue(ctx, p.closing, tweets); serr != nil && err == nil {
+			err = fmt.Errorf("export sink %s: %w", s.config.Name, serr)
+		}
+	}
+	return err
+}
+
+// ExportRaw will create the tweet dictionaries, in response order, and export them
+func (p *Pipeline) ExportRaw(ctx context.Context, raw *twitter.TweetRaw) error {
+	return p.Export(ctx, dictionaries(raw))
+}
+
+// dictionaries will create the tweet dictionaries of the raw response in response order
+func dictionaries(raw *twitter.TweetRaw) []*twitter.TweetDictionary {
+	if raw == nil {
+		return nil
+	}
+	tweets := make([]*twitter.TweetDictionary, 0, len(raw.Tweets))
+	for _, tweet := range raw.Tweets {
+		if tweet != nil {
+			tweets = append(tweets, twitter.CreateTweetDictionary(*tweet, raw.Includes))
+		}
+	}
+	return tweets
+}
+
+// Health returns the combined health of the sinks
+func (p *Pipeline) Health() Health {
+	health := Health{
+		Healthy: true,
+		Sinks:   make([]SinkHealth, len(p.sinks)),
+	}
+	for i, s := range p.sinks {
+		health.Sinks[i] = s.health()
+		if !health.Sinks[i].Healthy {
+			health.Healthy = false
+		}
+	}
+	return health
+}
+
+// Close will stop accepting exports, drain the buffered batches to the sinks and close them.  The exports blocked on
+// a full sink return ErrPipelineClosed.  If the context is done before the sinks are drained, the remaining batches
+// are abandoned.
+func (p *Pipeline) Close(ctx context.Context) error {
+	p.mutex.Lock()
+	if p.closed {
+		p.mutex.Unlock()
+		return nil
+	}
+	p.closed = true
+	close(p.closing)
+	p.mutex.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		// the blocked exports return once the pipeline is closing, then the queues do not have any senders
+		p.exports.Wait()
+		for _, s := range p.sinks {
+			close(s.batches)
+		}
+		p.wg.Wait()
+		close(done)
+	}()
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		p.cancel()
+		<-done
+		err = ctx.Err()
+	}
+	p.cancel()
+
+	for _, s := range p.sinks {
+		if cerr := s.config.Sink.Close(); cerr != nil && err == nil {
+			err = fmt.Errorf("export sink %s close: %w", s.config.Name, cerr)
+		}
+	}
+	return err
+}
+
+type sinkWorker struct {
+	config  SinkConfig
+	batches chan []*twitter.TweetDictionary
+
+	written int64
+	failed  int64
+	dropped int64
+	retries int64
+
+	mutex         sync.Mutex
+	healthy       bool
+	lastWrite     time.Time
+	lastError     error
+	lastErrorTime time.Time
+}
+
+// offer will queue the tweets if the sink has room, otherwise the overflow policy is applied.  False is returned when
+// the sink is full and the export has to block.
+func (s *sinkWorker) offer(tweets []*twitter.TweetDictionary) (bool, error) {
+	select {
+	case s.batches <- tweets:
+		return true, nil
+	default:
+	}
+	switch s.config.Overflow {
+	case OverflowDrop:
+		atomic.AddInt64(&s.dropped, int64(len(tweets)))
+		return true, nil
+	case OverflowError:
+		return false, ErrSinkFull
+	default:
+		return false, nil
+	}
+}
+
+// enqueue will block until the sink has room, the context is done or the pipeline is closing
+func (s *sinkWorker) enqueue(ctx context.Context, closing <-chan struct{}, tweets []*twitter.TweetDictionary) error {
+	select {
+	case s.batches <- tweets:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-closing:
+		return ErrPipelineClosed
+	}
+}
+
+func (s *sinkWorker) run(ctx context.Context) {
+	for batch := range s.batches {
+		if ctx.Err() != nil {
+			atomic.AddInt64(&s.dropped, int64(len(batch)))
+			continue
+		}
+		s.write(ctx, batch)
+	}
+}
+
+func (s *sinkWorker) write(ctx context.Context, batch []*twitter.TweetDictionary) {
+	backoff := s.config.Backoff
+	for attempt := 1; ; attempt++ {
+		err := s.config.Sink.Write(ctx, batch)
+		if err == nil {
+			atomic.AddInt64(&s.written, int64(len(batch)))
+			s.mutex.Lock()
+			s.healthy = true
+			s.lastWrite = time.Now()
+			s.mutex.Unlock()
+			return
+		}
+		s.mutex.Lock()
+		s.lastError = err
+		s.lastErrorTime = time.Now()
+		s.mutex.Unlock()
+
+		if attempt >= s.config.MaxAttempts {
+			atomic.AddInt64(&s.failed, int64(len(batch)))
+			s.mutex.Lock()
+			s.healthy = false
+			s.mutex.Unlock()
+			return
+		}
+		atomic.AddInt64(&s.retries, 1)
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			atomic.AddInt64(&s.failed, int64(len(batch)))
+			return
+		}
+	}
+}
+
+func (s *sinkWorker) health() SinkHealth {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return SinkHealth{
+		Name:          s.config.Name,
+		Healthy:       s.healthy,
+		Pending:       len(s.batches),
+		Written:       atomic.LoadInt64(&s.written),
+		Failed:        atomic.LoadInt64(&s.failed),
+		Dropped:       atomic.LoadInt64(&s.dropped),
+		Retries:       atomic.LoadInt64(&s.retries),
+		LastWrite:     s.lastWrite,
+		LastError:     s.lastError,
+		LastErrorTime: s.lastErrorTime,
+	}
+}
@@ -0,0 +1,209 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+type recordSink struct {
+	mutex  sync.Mutex
+	fails  int
+	tweets []string
+	closed bool
+}
+
+func (r *recordSink) Write(ctx context.Context, tweets []*twitter.TweetDictionary) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.fails > 0 {
+		r.fails--
+		return errors.New("sink unavailable")
+	}
+	for _, t := range tweets {
+		r.tweets = append(r.tweets, t.Tweet.ID)
+	}
+	return nil
+}
+
+func (r *recordSink) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.closed = true
+	return nil
+}
+
+func testRaw() *twitter.TweetRaw {
+	return &twitter.TweetRaw{
+		Tweets: []*twitter.TweetObj{
+			{
+				ID:       "1",
+				Text:     "first",
+				AuthorID: "10",
+			},
+			{
+				ID:       "2",
+				Text:     "second",
+				AuthorID: "10",
+			},
+		},
+		Includes: &twitter.TweetRawIncludes{
+			Users: []*twitter.UserObj{
+				{
+					ID:       "10",
+					UserName: "TwitterDev",
+				},
+			},
+		},
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	file := &recordSink{}
+	bus := &recordSink{fails: 1}
+	db := &recordSink{fails: 10}
+
+	p, err := NewPipeline(
+		SinkConfig{Name: "file", Sink: file},
+		SinkConfig{Name: "bus", Sink: bus, Backoff: time.Millisecond},
+		SinkConfig{Name: "db", Sink: db, Backoff: time.Millisecond, MaxAttempts: 2},
+	)
+	if err != nil {
+		t.Fatalf("NewPipeline() error = %v", err)
+	}
+	if err := p.ExportRaw(context.Background(), testRaw()); err != nil {
+		t.Fatalf("Pipeline.ExportRaw() error = %v", err)
+	}
+	if err := p.Close(context.Background()); err != nil {
+		t.Fatalf("Pipeline.Close() error = %v", err)
+	}
+	if err := p.ExportRaw(context.Background(), testRaw()); !errors.Is(err, ErrPipelineClosed) {
+		t.Errorf("Pipeline.ExportRaw() after close error = %v", err)
+	}
+
+	for _, s := range []*recordSink{file, bus} {
+		if len(s.tweets) != 2 || s.tweets[0] != "1" || s.tweets[1] != "2" {
+			t.Errorf("Pipeline sink tweets = %v", s.tweets)
+		}
+		if !s.closed {
+			t.Errorf("Pipeline sink was not closed")
+		}
+	}
+
+	health := p.Health()
+	if health.Healthy {
+		t.Errorf("Pipeline.Health() should not be healthy")
+	}
+	want := map[string]SinkHealth{
+		"file": {Healthy: true, Written: 2},
+		"bus":  {Healthy: true, Written: 2, Retries: 1},
+		"db":   {Healthy: false, Failed: 2, Retries: 1},
+	}
+	for _, got := range health.Sinks {
+		w := want[got.Name]
+		if got.Healthy != w.Healthy || got.Written != w.Written || got.Failed != w.Failed || got.Retries != w.Retries {
+			t.Errorf("Pipeline.Health() sink %s = %+v, want %+v", got.Name, got, w)
+		}
+	}
+}
+
+func TestPipeline_Overflow(t *testing.T) {
+	release := make(chan struct{})
+	slow := SinkFunc(func(ctx context.Context, tweets []*twitter.TweetDictionary) error {
+		<-release
+		return nil
+	})
+	fast := &recordSink{}
+
+	p, err := NewPipeline(
+		SinkConfig{Name: "slow", Sink: slow, BufferSize: 1, Overflow: OverflowDrop},
+		SinkConfig{Name: "fast", Sink: fast, BufferSize: 10},
+	)
+	if err != nil {
+		t.Fatalf("NewPipeline() error = %v", err)
+	}
+	for i := 0; i < 5; i++ {
+		if err := p.ExportRaw(context.Background(), testRaw()); err != nil {
+			t.Fatalf("Pipeline.ExportRaw() error = %v", err)
+		}
+	}
+	close(release)
+	if err := p.Close(context.Background()); err != nil {
+		t.Fatalf("Pipeline.Close() error = %v", err)
+	}
+	if len(fast.tweets) != 10 {
+		t.Errorf("Pipeline fast sink tweets = %d, want 10", len(fast.tweets))
+	}
+	health := p.Health()
+	if health.Sinks[0].Dropped == 0 {
+		t.Errorf("Pipeline slow sink should have dropped tweets")
+	}
+	if health.Sinks[0].Dropped+health.Sinks[0].Written != 10 {
+		t.Errorf("Pipeline slow sink dropped %d and wrote %d", health.Sinks[0].Dropped, health.Sinks[0].Written)
+	}
+}
+
+func TestPipeline_Blocked(t *testing.T) {
+	release := make(chan struct{})
+	slow := SinkFunc(func(ctx context.Context, tweets []*twitter.TweetDictionary) error {
+		select {
+		case <-release:
+		case <-ctx.Done():
+		}
+		return nil
+	})
+	fast := &recordSink{}
+
+	p, err := NewPipeline(
+		SinkConfig{Name: "slow", Sink: slow, BufferSize: 1},
+		SinkConfig{Name: "fast", Sink: fast, BufferSize: 10},
+	)
+	if err != nil {
+		t.Fatalf("NewPipeline() error = %v", err)
+	}
+	// the first batch is written by the slow sink and the second fills its buffer
+	for i := 0; i < 2; i++ {
+		if err := p.ExportRaw(context.Background(), testRaw()); err != nil {
+			t.Fatalf("Pipeline.ExportRaw() error = %v", err)
+		}
+	}
+	blocked := make(chan error, 1)
+	go func() {
+		blocked <- p.ExportRaw(context.Background(), testRaw())
+	}()
+	time.Sleep(20 * time.Millisecond)
+	fast.mutex.Lock()
+	written := len(fast.tweets)
+	fast.mutex.Unlock()
+	if written != 6 {
+		t.Errorf("Pipeline fast sink tweets = %d, want 6 while the slow sink blocks", written)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := p.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Pipeline.Close() error = %v, want the context's error", err)
+	}
+	select {
+	case err := <-blocked:
+		if !errors.Is(err, ErrPipelineClosed) {
+			t.Errorf("Pipeline.ExportRaw() blocked error = %v, want %v", err, ErrPipelineClosed)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Pipeline.ExportRaw() should return once the pipeline is closed")
+	}
+	close(release)
+}
+
+func TestNewPipeline_Parameter(t *testing.T) {
+	if _, err := NewPipeline(); !errors.Is(err, twitter.ErrParameter) {
+		t.Errorf("NewPipeline() error = %v", err)
+	}
+	if _, err := NewPipeline(SinkConfig{Name: "nil"}); !errors.Is(err, twitter.ErrParameter) {
+		t.Errorf("NewPipeline() error = %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+// NDJSONWriter will write each tweet dictionary as a line of JSON, which can be loaded by tools like BigQuery.  It
+// can be used directly with the search pages or as a pipeline sink.
+type NDJSONWriter struct {
+	mutex   sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewNDJSONWriter will create a NDJSON writer.  The writer is not closed by the NDJSON writer.
+func NewNDJSONWriter(w io.Writer) *NDJSONWriter {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	return &NDJSONWriter{
+		encoder: encoder,
+	}
+}
+
+// Write will write a line for each tweet
+func (n *NDJSONWriter) Write(ctx context.Context, tweets []*twitter.TweetDictionary) error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	for _, tweet := range tweets {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := n.encoder.Encode(tweet); err != nil {
+			return fmt.Errorf("ndjson write tweet %s: %w", tweet.Tweet.ID, err)
+		}
+	}
+	return nil
+}
+
+// WriteRaw will write the tweets of a response page, like TweetRecentSearchResponse.Raw
+func (n *NDJSONWriter) WriteRaw(ctx context.Context, raw *twitter.TweetRaw) error {
+	return n.Write(ctx, dictionaries(raw))
+}
+
+// Close does nothing, the writer is owned by the caller
+func (n *NDJSONWriter) Close() error {
+	return nil
+}
+
+// Column is a CSV column with its header name and the value of a tweet
+type Column struct {
+	Name  string
+	Value func(tweet *twitter.TweetDictionary) string
+}
+
+// DefaultColumns are the tweet id, creation time, author, language, text and public metrics
+var DefaultColumns = []Column{
+	{Name: "id", Value: func(t *twitter.TweetDictionary) string { return t.Tweet.ID }},
+	{Name: "created_at", Value: func(t *twitter.TweetDictionary) string { return t.Tweet.CreatedAt }},
+	{Name: "author_id", Value: func(t *twitter.TweetDictionary) string { return t.Tweet.AuthorID }},
+	{Name: "author_username", Value: func(t *twitter.TweetDictionary) string {
+		if t.Author == nil {
+			return ""
+		}
+		return t.Author.UserName
+	}},
+	{Name: "lang", Value: func(t *twitter.TweetDictionary) string { return t.Tweet.Language }},
+	{Name: "text", Value: func(t *twitter.TweetDictionary) string { return t.Tweet.FullText() }},
+	{Name: "retweet_count", Value: publicMetric(func(m *twitter.TweetMetricsObj) int { return m.Retweets })},
+	{Name: "reply_count", Value: publicMetric(func(m *twitter.TweetMetricsObj) int { return m.Replies })},
+	{Name: "like_count", Value: publicMetric(func(m *twitter.TweetMetricsObj) int { return m.Likes })},
+	{Name: "quote_count", Value: publicMetric(func(m *twitter.TweetMetricsObj) int { return m.Quotes })},
+}
+
+func publicMetric(metric func(m *twitter.TweetMetricsObj) int) func(t *twitter.TweetDictionary) string {
+	return func(t *twitter.TweetDictionary) string {
+		if t.Tweet.PublicMetrics == nil {
+			return ""
+		}
+		return strconv.Itoa(metric(t.Tweet.PublicMetrics))
+	}
+}
+
+// CSVWriter will write a row for each tweet dictionary with the configured columns.  The header row is written before
+// the first tweet.  It can be used directly with the search pages or as a pipeline sink.
+type CSVWriter struct {
+	mutex   sync.Mutex
+	writer  *csv.Writer
+	columns []Column
+	header  bool
+}
+
+// NewCSVWriter will create a CSV writer with the columns, or the default columns if there are none.  The writer is not
+// closed by the CSV writer.
+func NewCSVWriter(w io.Writer, columns ...Column) (*CSVWriter, error) {
+	if len(columns) == 0 {
+		columns = DefaultColumns
+	}
+	for i, column := range columns {
+		if len(column.Name) == 0 || column.Value == nil {
+			return nil, fmt.Errorf("csv writer: column %d requires a name and value: %w", i, twitter.ErrParameter)
+		}
+	}
+	return &CSVWriter{
+		writer:  csv.NewWriter(w),
+		columns: columns,
+	}, nil
+}
+
+// Write will write a row for each tweet and flush the rows
+func (c *CSVWriter) Write(ctx context.Context, tweets []*twitter.TweetDictionary) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !c.header {
+		header := make([]string, len(c.columns))
+		for i, column := range c.columns {
+			header[i] = column.Name
+		}
+		if err := c.writer.Write(header); err != nil {
+			return fmt.Errorf("csv write header: %w", err)
+		}
+		c.header = true
+	}
+	for _, tweet := range tweets {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		row := make([]string, len(c.columns))
+		for i, column := range c.columns {
+			row[i] = column.Value(tweet)
+		}
+		if err := c.writer.Write(row); err != nil {
+			return fmt.Errorf("csv write tweet %s: %w", tweet.Tweet.ID, err)
+		}
+	}
+	c.writer.Flush()
+	if err := c.writer.Error(); err != nil {
+		return fmt.Errorf("csv write flush: %w", err)
+	}
+	return nil
+}
+
+// WriteRaw will write the tweets of a response page, like TweetRecentSearchResponse.Raw
+func (c *CSVWriter) WriteRaw(ctx context.Context, raw *twitter.TweetRaw) error {
+	return c.Write(ctx, dictionaries(raw))
+}
+
+// Close will flush the rows, the writer is owned by the caller
+func (c *CSVWriter) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.writer.Flush()
+	return c.writer.Error()
+}
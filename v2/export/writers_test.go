@@ -0,0 +1,56 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+func TestNDJSONWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	writer := NewNDJSONWriter(buf)
+	if err := writer.WriteRaw(context.Background(), testRaw()); err != nil {
+		t.Fatalf("NDJSONWriter.WriteRaw() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("NDJSONWriter.WriteRaw() lines = %d, want 2", len(lines))
+	}
+	tweet := &twitter.TweetDictionary{}
+	if err := json.Unmarshal([]byte(lines[1]), tweet); err != nil || tweet.Tweet.ID != "2" || tweet.Author.UserName != "TwitterDev" {
+		t.Errorf("NDJSONWriter.WriteRaw() line = %s %v", lines[1], err)
+	}
+}
+
+func TestCSVWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	writer, err := NewCSVWriter(buf,
+		Column{Name: "id", Value: func(t *twitter.TweetDictionary) string { return t.Tweet.ID }},
+		DefaultColumns[3],
+		Column{Name: "text", Value: func(t *twitter.TweetDictionary) string { return t.Tweet.Text + ", quoted" }},
+	)
+	if err != nil {
+		t.Fatalf("NewCSVWriter() error = %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := writer.WriteRaw(context.Background(), testRaw()); err != nil {
+			t.Fatalf("CSVWriter.WriteRaw() error = %v", err)
+		}
+	}
+	want := "id,author_username,text\n" +
+		"1,TwitterDev,\"first, quoted\"\n" +
+		"2,TwitterDev,\"second, quoted\"\n" +
+		"1,TwitterDev,\"first, quoted\"\n" +
+		"2,TwitterDev,\"second, quoted\"\n"
+	if buf.String() != want {
+		t.Errorf("CSVWriter.WriteRaw() = %q, want %q", buf.String(), want)
+	}
+
+	if _, err := NewCSVWriter(buf, Column{Name: "id"}); err == nil {
+		t.Errorf("NewCSVWriter() should require the column value")
+	}
+}
@@ -0,0 +1,105 @@
+package twitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	tweetObjFields = extraFields(reflect.TypeOf(TweetObj{}))
+	userObjFields  = extraFields(reflect.TypeOf(UserObj{}))
+)
+
+// UnmarshalJSON will decode the tweet and keep the fields that are not in the struct in Extra
+func (t *TweetObj) UnmarshalJSON(data []byte) error {
+	extra, err := unmarshalExtra(data, reflect.ValueOf(t).Elem(), tweetObjFields)
+	if err != nil {
+		return err
+	}
+	t.Extra = extra
+	return nil
+}
+
+// MarshalJSON will encode the tweet with the fields in Extra
+func (t TweetObj) MarshalJSON() ([]byte, error) {
+	type tweet TweetObj
+	return marshalExtra((*tweet)(&t), t.Extra)
+}
+
+// UnmarshalJSON will decode the user and keep the fields that are not in the struct in Extra
+func (u *UserObj) UnmarshalJSON(data []byte) error {
+	extra, err := unmarshalExtra(data, reflect.ValueOf(u).Elem(), userObjFields)
+	if err != nil {
+		return err
+	}
+	u.Extra = extra
+	return nil
+}
+
+// MarshalJSON will encode the user with the fields in Extra
+func (u UserObj) MarshalJSON() ([]byte, error) {
+	type user UserObj
+	return marshalExtra((*user)(&u), u.Extra)
+}
+
+// unmarshalExtra will decode the object's keys into the struct's known fields and return the keys that are not known
+// fields, or nil if there are none.  The object is only parsed once, each known key is decoded from its raw value.
+func unmarshalExtra(data []byte, v reflect.Value, known map[string]int) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for k, raw := range fields {
+		i, has := known[strings.ToLower(k)]
+		if !has {
+			continue
+		}
+		if err := json.Unmarshal(raw, v.Field(i).Addr().Interface()); err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		delete(fields, k)
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// extraFields returns the indexes of the struct's fields by their lower case JSON names.  The structs with an Extra
+// map do not embed other structs, so only the struct's own fields are known.
+func extraFields(t reflect.Type) map[string]int {
+	fields := map[string]int{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" || !f.IsExported() {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if len(name) == 0 {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = i
+	}
+	return fields
+}
+
+// marshalExtra will encode the value and add the extra fields that are not already encoded
+func marshalExtra(v interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	enc, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return enc, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(enc, &fields); err != nil {
+		return nil, err
+	}
+	for k, v := range extra {
+		if _, has := fields[k]; !has {
+			fields[k] = v
+		}
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,63 @@
+package twitter
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestTweetObj_Extra(t *testing.T) {
+	body := `{"id":"1","text":"hello","community_id":"5","display_text_range":[0,5]}`
+	tweet := &TweetObj{}
+	if err := json.Unmarshal([]byte(body), tweet); err != nil {
+		t.Fatalf("TweetObj.UnmarshalJSON() error = %v", err)
+	}
+	if tweet.ID != "1" || tweet.Text != "hello" {
+		t.Errorf("TweetObj.UnmarshalJSON() = %+v", tweet)
+	}
+	want := map[string]json.RawMessage{
+		"community_id":       json.RawMessage(`"5"`),
+		"display_text_range": json.RawMessage(`[0,5]`),
+	}
+	if !reflect.DeepEqual(tweet.Extra, want) {
+		t.Errorf("TweetObj.UnmarshalJSON() extra = %v, want %v", tweet.Extra, want)
+	}
+
+	enc, err := json.Marshal(tweet)
+	if err != nil {
+		t.Fatalf("TweetObj.MarshalJSON() error = %v", err)
+	}
+	decoded := &TweetObj{}
+	if err := json.Unmarshal(enc, decoded); err != nil || !reflect.DeepEqual(decoded, tweet) {
+		t.Errorf("TweetObj.MarshalJSON() = %s, the extra fields should be kept", string(enc))
+	}
+}
+
+func TestUserObj_Extra(t *testing.T) {
+	users := []*UserObj{}
+	if err := json.Unmarshal([]byte(`[{"id":"1","name":"Twitter Dev","UserName":"TwitterDev"},{"id":"2","name":"Go","username":"golang","verified_type":"none"}]`), &users); err != nil {
+		t.Fatalf("UserObj.UnmarshalJSON() error = %v", err)
+	}
+	if users[0].Extra != nil || users[0].UserName != "TwitterDev" {
+		t.Errorf("UserObj.UnmarshalJSON() = %+v, the keys match the fields without case", users[0])
+	}
+	if string(users[1].Extra["verified_type"]) != `"none"` {
+		t.Errorf("UserObj.UnmarshalJSON() extra = %v", users[1].Extra)
+	}
+	if enc, _ := json.Marshal(users[0]); string(enc) != `{"id":"1","name":"Twitter Dev","username":"TwitterDev"}` {
+		t.Errorf("UserObj.MarshalJSON() = %s", string(enc))
+	}
+}
+
+func TestTweetObj_ExtraError(t *testing.T) {
+	tweet := &TweetObj{}
+	err := json.Unmarshal([]byte(`{"id":"1","text":5}`), tweet)
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Errorf("TweetObj.UnmarshalJSON() error = %v, want the known field's type error", err)
+	}
+	if err := json.Unmarshal([]byte(`null`), tweet); err != nil || tweet.Extra != nil {
+		t.Errorf("TweetObj.UnmarshalJSON() null error = %v extra = %v", err, tweet.Extra)
+	}
+}
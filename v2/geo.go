@@ -0,0 +1,158 @@
+package twitter
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+)
+
+// GeoGranularity is the minimal type of place returned by a geo search
+type GeoGranularity string
+
+const (
+	// GeoGranularityPOI is a point of interest
+	GeoGranularityPOI GeoGranularity = "poi"
+	// GeoGranularityNeighborhood is a neighborhood
+	GeoGranularityNeighborhood GeoGranularity = "neighborhood"
+	// GeoGranularityCity is a city, the default
+	GeoGranularityCity GeoGranularity = "city"
+	// GeoGranularityAdmin is an administrative area, like a state
+	GeoGranularityAdmin GeoGranularity = "admin"
+	// GeoGranularityCountry is a country
+	GeoGranularityCountry GeoGranularity = "country"
+)
+
+// GeoPlace is a place of the v1.1 geo endpoints.  The place id is the same as the tweet's geo place id.
+type GeoPlace struct {
+	ID              string                 `json:"id"`
+	URL             string                 `json:"url"`
+	PlaceType       string                 `json:"place_type"`
+	Name            string                 `json:"name"`
+	FullName        string                 `json:"full_name"`
+	CountryCode     string                 `json:"country_code"`
+	Country         string                 `json:"country"`
+	ContainedWithin []*GeoPlace            `json:"contained_within,omitempty"`
+	BoundingBox     *GeoBoundingBox        `json:"bounding_box,omitempty"`
+	Centroid        []float64              `json:"centroid,omitempty"`
+	Attributes      map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// GeoBoundingBox is the polygon of a place, the coordinates are longitude and latitude pairs
+type GeoBoundingBox struct {
+	Type        string        `json:"type"`
+	Coordinates [][][]float64 `json:"coordinates"`
+}
+
+// BBox returns the west longitude, south latitude, east longitude and north latitude of the bounding box, the same as
+// the bbox of a tweet's expanded place.  Nil is returned if there are no coordinates.
+func (b *GeoBoundingBox) BBox() []float64 {
+	if b == nil {
+		return nil
+	}
+	var bbox []float64
+	for _, ring := range b.Coordinates {
+		for _, point := range ring {
+			if len(point) < 2 {
+				continue
+			}
+			if bbox == nil {
+				bbox = []float64{point[0], point[1], point[0], point[1]}
+				continue
+			}
+			bbox[0] = math.Min(bbox[0], point[0])
+			bbox[1] = math.Min(bbox[1], point[1])
+			bbox[2] = math.Max(bbox[2], point[0])
+			bbox[3] = math.Max(bbox[3], point[1])
+		}
+	}
+	return bbox
+}
+
+// PlaceObj will convert the place to the place object of the v2 tweet expansions
+func (p *GeoPlace) PlaceObj() *PlaceObj {
+	place := &PlaceObj{
+		ID:          p.ID,
+		FullName:    p.FullName,
+		Country:     p.Country,
+		CountryCode: p.CountryCode,
+		Name:        p.Name,
+		PlaceType:   p.PlaceType,
+	}
+	for _, within := range p.ContainedWithin {
+		if within != nil {
+			place.ContainedWithin = append(place.ContainedWithin, within.ID)
+		}
+	}
+	if bbox := p.BoundingBox.BBox(); bbox != nil {
+		place.Geo = &PlaceGeoObj{
+			Type:       "Feature",
+			BBox:       bbox,
+			Properties: map[string]interface{}{},
+		}
+	}
+	return place
+}
+
+// GeoPlaceResponse is the response of the geo place lookup
+type GeoPlaceResponse struct {
+	Place     *GeoPlace
+	RateLimit *RateLimit
+}
+
+// GeoSearchOpts are the geo search options, one of the query, the latitude and longitude or the IP address is
+// required.  The latitude and longitude are sent when either is not zero.
+type GeoSearchOpts struct {
+	Query           string
+	Latitude        float64
+	Longitude       float64
+	IP              string
+	Granularity     GeoGranularity
+	Accuracy        string
+	MaxResults      int
+	ContainedWithin string
+}
+
+func (g GeoSearchOpts) coordinates() bool {
+	return g.Latitude != 0 || g.Longitude != 0
+}
+
+func (g GeoSearchOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if len(g.Query) > 0 {
+		q.Add("query", g.Query)
+	}
+	if g.coordinates() {
+		q.Add("lat", strconv.FormatFloat(g.Latitude, 'f', -1, 64))
+		q.Add("long", strconv.FormatFloat(g.Longitude, 'f', -1, 64))
+	}
+	if len(g.IP) > 0 {
+		q.Add("ip", g.IP)
+	}
+	if len(g.Granularity) > 0 {
+		q.Add("granularity", string(g.Granularity))
+	}
+	if len(g.Accuracy) > 0 {
+		q.Add("accuracy", g.Accuracy)
+	}
+	if g.MaxResults > 0 {
+		q.Add("max_results", strconv.Itoa(g.MaxResults))
+	}
+	if len(g.ContainedWithin) > 0 {
+		q.Add("contained_within", g.ContainedWithin)
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// GeoSearchResponse is the response of the geo search
+type GeoSearchResponse struct {
+	Places    []*GeoPlace
+	RateLimit *RateLimit
+}
+
+type geoSearchResult struct {
+	Result struct {
+		Places []*GeoPlace `json:"places"`
+	} `json:"result"`
+}
@@ -0,0 +1,70 @@
+package twitter
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// EndpointFamily is a group of endpoints that can be sent to a different host
+type EndpointFamily string
+
+const (
+	// EndpointFamilySearch are the search and counts endpoints
+	EndpointFamilySearch EndpointFamily = "search"
+	// EndpointFamilyStreams are the stream endpoints and the stream rules
+	EndpointFamilyStreams EndpointFamily = "streams"
+	// EndpointFamilyUploads are the media upload endpoints
+	EndpointFamilyUploads EndpointFamily = "uploads"
+)
+
+// endpointFamily returns the family of the path, streams are checked first since the filtered stream is under search
+func endpointFamily(segments []string) (EndpointFamily, bool) {
+	has := func(s string) bool {
+		for _, seg := range segments {
+			if seg == s {
+				return true
+			}
+		}
+		return false
+	}
+	switch {
+	case has("stream"):
+		return EndpointFamilyStreams, true
+	case has("search"), has("counts"):
+		return EndpointFamilySearch, true
+	case has("media") && has("upload"):
+		return EndpointFamilyUploads, true
+	default:
+		return "", false
+	}
+}
+
+// applyHosts will send the request to the host of its endpoint family.  The host can have a base path, like
+// https://gateway.example.com/twitter, which replaces the base path of the client's host.
+func (c *Client) applyHosts(req *http.Request) {
+	if len(c.Hosts) == 0 || req.URL == nil {
+		return
+	}
+	family, ok := endpointFamily(pathSegments(req.URL.Path))
+	if !ok {
+		return
+	}
+	host, has := c.Hosts[family]
+	if !has || len(host) == 0 {
+		return
+	}
+	override, err := url.Parse(host)
+	if err != nil {
+		return
+	}
+	path := req.URL.Path
+	if base, err := url.Parse(c.Host); err == nil {
+		path = strings.TrimPrefix(path, strings.TrimSuffix(base.Path, "/"))
+	}
+	req.URL.Scheme = override.Scheme
+	req.URL.Host = override.Host
+	req.URL.Path = strings.TrimSuffix(override.Path, "/") + "/" + strings.TrimPrefix(path, "/")
+	req.URL.RawPath = ""
+	req.Host = override.Host
+}
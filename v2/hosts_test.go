@@ -0,0 +1,75 @@
+package twitter
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestClient_applyHosts(t *testing.T) {
+	hosts := map[EndpointFamily]string{
+		EndpointFamilySearch:  "https://search.example.com",
+		EndpointFamilyStreams: "http://localhost:8080/mock/",
+	}
+	tests := []struct {
+		name  string
+		host  string
+		hosts map[EndpointFamily]string
+		url   string
+		want  string
+	}{
+		{
+			name: "no hosts",
+			host: "https://api.twitter.com",
+			url:  "https://api.twitter.com/2/tweets/search/recent?query=golang",
+			want: "https://api.twitter.com/2/tweets/search/recent?query=golang",
+		},
+		{
+			name:  "search",
+			host:  "https://api.twitter.com",
+			hosts: hosts,
+			url:   "https://api.twitter.com/2/tweets/search/recent?query=golang",
+			want:  "https://search.example.com/2/tweets/search/recent?query=golang",
+		},
+		{
+			name:  "counts",
+			host:  "https://api.twitter.com",
+			hosts: hosts,
+			url:   "https://api.twitter.com/2/tweets/counts/all?query=golang",
+			want:  "https://search.example.com/2/tweets/counts/all?query=golang",
+		},
+		{
+			name:  "stream rules with base paths",
+			host:  "https://gateway.example.com/twitter/",
+			hosts: hosts,
+			url:   "https://gateway.example.com/twitter/2/tweets/search/stream/rules",
+			want:  "http://localhost:8080/mock/2/tweets/search/stream/rules",
+		},
+		{
+			name:  "other endpoint",
+			host:  "https://api.twitter.com",
+			hosts: hosts,
+			url:   "https://api.twitter.com/2/users/2244994945/tweets",
+			want:  "https://api.twitter.com/2/users/2244994945/tweets",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Host:  tt.host,
+				Hosts: tt.hosts,
+			}
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.applyHosts(req)
+			if got := req.URL.String(); got != tt.want {
+				t.Errorf("Client.applyHosts() = %v, want %v", got, tt.want)
+			}
+			if u, _ := url.Parse(tt.want); req.Host != u.Host {
+				t.Errorf("Client.applyHosts() host = %v, want %v", req.Host, u.Host)
+			}
+		})
+	}
+}
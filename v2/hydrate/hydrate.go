@@ -0,0 +1,353 @@
+// Package hydrate looks up the tweets of a stream of tweet ids, like the ids of a compliance file or an old archive.  The
+// ids are batched into tweet lookups that are sent by a pool of workers, and the ids that could not be hydrated are
+// reported with the reason.
+package hydrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+const (
+	defaultWorkers       = 4
+	defaultBatchSize     = 100
+	defaultFlushInterval = time.Second
+	defaultMaxAttempts   = 3
+	defaultBackoff       = time.Second
+)
+
+// Reason is why an id was not hydrated
+type Reason string
+
+const (
+	// ReasonNotFound is a tweet that does not exist, like a deleted tweet
+	ReasonNotFound Reason = "not_found"
+	// ReasonSuspended is a tweet of a suspended user
+	ReasonSuspended Reason = "suspended"
+	// ReasonNotAuthorized is a tweet that can not be seen, like the tweet of a protected user
+	ReasonNotAuthorized Reason = "not_authorized"
+	// ReasonOther is a tweet with any other partial error
+	ReasonOther Reason = "other"
+)
+
+// Missing is an id that was looked up but was not returned.  Error is the partial error of the id, if there was one.
+type Missing struct {
+	ID     string
+	Reason Reason
+	Error  *twitter.ErrorObj
+}
+
+// Failure is a batch of ids whose lookup failed after all of the attempts
+type Failure struct {
+	IDs []string
+	Err error
+}
+
+// Report is the outcome of a run.  IDs is the number of unique ids that were read.
+type Report struct {
+	IDs      int
+	Hydrated int
+	Lookups  int
+	Missing  []Missing
+	Failures []Failure
+}
+
+// Hydrator will hydrate tweet ids.  Opts are the options of each lookup.  Workers is the number of lookups sent at
+// once, it defaults to four.  BatchSize is the number of ids in a lookup, it defaults to and can not be more than 100.  A
+// partial batch is looked up after FlushInterval without a new id, which defaults to one second.  MaxAttempts is the
+// number of times a lookup is tried before its ids fail, it defaults to three, with Backoff between the attempts, which
+// is doubled after each failure and defaults to one second.  A rate limited lookup will wait until the rate limit resets,
+// as will the other workers, and is not counted as an attempt.
+type Hydrator struct {
+	Client        *twitter.Client
+	Opts          twitter.TweetLookupOpts
+	Workers       int
+	BatchSize     int
+	FlushInterval time.Duration
+	MaxAttempts   int
+	Backoff       time.Duration
+
+	mutex  sync.Mutex
+	report *Report
+	hold   time.Time
+}
+
+// Run will hydrate the ids until the ids channel is closed, sending the tweets to the tweets channel, which is closed
+// when Run returns.  The report is returned when all of the ids are done, or with the context's error if it is done
+// first.
+func (h *Hydrator) Run(ctx context.Context, ids <-chan string, tweets chan<- *twitter.TweetDictionary) (*Report, error) {
+	defer close(tweets)
+	if h.Client == nil {
+		return nil, fmt.Errorf("hydrate: a client is required: %w", twitter.ErrParameter)
+	}
+	h.mutex.Lock()
+	h.report = &Report{}
+	h.hold = time.Time{}
+	h.mutex.Unlock()
+
+	workers := h.Workers
+	if workers <= 0 {
+		workers = defaultWorkers
+	}
+	batches := make(chan []string, workers)
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				h.hydrate(ctx, batch, tweets)
+			}
+		}()
+	}
+	h.batch(ctx, ids, batches)
+	close(batches)
+	wg.Wait()
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	report := h.report
+	sort.Slice(report.Missing, func(i, j int) bool {
+		return report.Missing[i].ID < report.Missing[j].ID
+	})
+	return report, ctx.Err()
+}
+
+// batch will read the unique ids into batches
+func (h *Hydrator) batch(ctx context.Context, ids <-chan string, batches chan<- []string) {
+	size := h.BatchSize
+	if size <= 0 || size > defaultBatchSize {
+		size = defaultBatchSize
+	}
+	interval := h.FlushInterval
+	if interval <= 0 {
+		interval = defaultFlushInterval
+	}
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	seen := map[string]bool{}
+	batch := []string{}
+	flush := func() bool {
+		if len(batch) == 0 {
+			return true
+		}
+		select {
+		case batches <- batch:
+			batch = []string{}
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	for {
+		select {
+		case id, ok := <-ids:
+			if !ok {
+				flush()
+				return
+			}
+			id = strings.TrimSpace(id)
+			if len(id) == 0 || seen[id] {
+				continue
+			}
+			seen[id] = true
+			h.mutex.Lock()
+			h.report.IDs++
+			h.mutex.Unlock()
+			batch = append(batch, id)
+			if len(batch) == size && !flush() {
+				return
+			}
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(interval)
+		case <-timer.C:
+			if !flush() {
+				return
+			}
+			timer.Reset(interval)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// hydrate will look up the batch and send its tweets
+func (h *Hydrator) hydrate(ctx context.Context, batch []string, tweets chan<- *twitter.TweetDictionary) {
+	resp, err := h.lookup(ctx, batch)
+	if err != nil {
+		h.mutex.Lock()
+		h.report.Failures = append(h.report.Failures, Failure{IDs: batch, Err: err})
+		h.mutex.Unlock()
+		return
+	}
+
+	found := map[string]bool{}
+	for _, tweet := range resp.Raw.Tweets {
+		if tweet == nil {
+			continue
+		}
+		found[tweet.ID] = true
+		select {
+		case tweets <- twitter.CreateTweetDictionary(*tweet, resp.Raw.Includes):
+		case <-ctx.Done():
+			return
+		}
+	}
+	missing := missingIDs(batch, found, resp.Raw.Errors)
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.report.Hydrated += len(found)
+	h.report.Missing = append(h.report.Missing, missing...)
+}
+
+// lookup will look up the batch, waiting for the rate limit and retrying the failures
+func (h *Hydrator) lookup(ctx context.Context, batch []string) (*twitter.TweetLookupResponse, error) {
+	maxAttempts := h.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	backoff := h.Backoff
+	if backoff <= 0 {
+		backoff = defaultBackoff
+	}
+	for attempt := 1; ; {
+		if err := h.wait(ctx); err != nil {
+			return nil, err
+		}
+		h.mutex.Lock()
+		h.report.Lookups++
+		h.mutex.Unlock()
+
+		resp, err := h.Client.TweetLookup(ctx, batch, h.Opts)
+		if err == nil {
+			h.limit(resp.RateLimit)
+			return resp, nil
+		}
+		switch {
+		case ctx.Err() != nil:
+			return nil, err
+		case errors.Is(err, twitter.ErrRateLimited) && h.limit(rateLimit(err)):
+			// a rate limited lookup without a reset is retried as a failure
+			continue
+		case errors.Is(err, twitter.ErrParameter), errors.Is(err, twitter.ErrUnauthorized), errors.Is(err, twitter.ErrForbidden):
+			return nil, err
+		}
+		if attempt >= maxAttempts {
+			return nil, err
+		}
+		attempt++
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// limit will hold the lookups until the reset if the rate limit has no remaining requests
+func (h *Hydrator) limit(rl *twitter.RateLimit) bool {
+	if rl == nil || rl.Remaining > 0 || !rl.Reset.Time().After(time.Now()) {
+		return false
+	}
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	if reset := rl.Reset.Time(); reset.After(h.hold) {
+		h.hold = reset
+	}
+	return true
+}
+
+// wait will wait until the rate limit hold has passed
+func (h *Hydrator) wait(ctx context.Context) error {
+	h.mutex.Lock()
+	hold := time.Until(h.hold)
+	h.mutex.Unlock()
+	if hold <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(hold)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// rateLimit returns the rate limit of a rate limited error
+func rateLimit(err error) *twitter.RateLimit {
+	var (
+		resp    *twitter.ErrorResponse
+		httpErr *twitter.HTTPError
+		limited *twitter.RateLimitedError
+	)
+	switch {
+	case errors.As(err, &resp):
+		return resp.RateLimit
+	case errors.As(err, &httpErr):
+		return httpErr.RateLimit
+	case errors.As(err, &limited):
+		return &limited.RateLimit
+	default:
+		return nil
+	}
+}
+
+// missingIDs returns the ids of the batch that were not found, with the reason of their partial error
+func missingIDs(batch []string, found map[string]bool, errs []*twitter.ErrorObj) []Missing {
+	partial := map[string]*twitter.ErrorObj{}
+	for _, e := range errs {
+		if e == nil || (len(e.ResourceType) > 0 && e.ResourceType != "tweet") {
+			continue
+		}
+		id := e.ResourceID
+		if value, ok := e.Value.(string); ok && len(id) == 0 {
+			id = value
+		}
+		partial[id] = e
+	}
+	missing := []Missing{}
+	for _, id := range batch {
+		if found[id] {
+			continue
+		}
+		e := partial[id]
+		missing = append(missing, Missing{
+			ID:     id,
+			Reason: reason(e),
+			Error:  e,
+		})
+	}
+	return missing
+}
+
+func reason(e *twitter.ErrorObj) Reason {
+	switch {
+	case e == nil:
+		return ReasonNotFound
+	case strings.Contains(strings.ToLower(e.Detail), "suspended"):
+		return ReasonSuspended
+	case strings.HasSuffix(e.Type, "/not-authorized-for-resource"):
+		return ReasonNotAuthorized
+	case strings.HasSuffix(e.Type, "/resource-not-found"):
+		return ReasonNotFound
+	default:
+		return ReasonOther
+	}
+}
@@ -0,0 +1,166 @@
+package hydrate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+type noAuth struct{}
+
+func (noAuth) Add(*http.Request) {}
+
+type lookupServer struct {
+	mutex   sync.Mutex
+	fails   int
+	limited int
+}
+
+func (l *lookupServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.limited > 0 {
+		l.limited--
+		w.Header().Set("x-rate-limit-limit", "300")
+		w.Header().Set("x-rate-limit-remaining", "0")
+		w.Header().Set("x-rate-limit-reset", strconv.FormatInt(time.Now().Add(time.Second).Unix(), 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"title":"Too Many Requests","type":"about:blank","status":429,"detail":"Too Many Requests"}`)
+		return
+	}
+	if l.fails > 0 {
+		l.fails--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"title":"Service Unavailable","type":"about:blank","status":503,"detail":"Service Unavailable"}`)
+		return
+	}
+	ids := strings.Split(r.URL.Query().Get("ids"), ",")
+	single := strings.TrimPrefix(r.URL.Path, "/2/tweets/")
+	if single != r.URL.Path {
+		ids = []string{single}
+	}
+	tweets := []string{}
+	errs := []string{}
+	for _, id := range ids {
+		switch {
+		case strings.HasPrefix(id, "4"):
+			errs = append(errs, fmt.Sprintf(`{"value":"%s","detail":"Could not find tweet with ids: [%s].","title":"Not Found Error","resource_type":"tweet","parameter":"ids","resource_id":"%s","type":"https://api.twitter.com/2/problems/resource-not-found"}`, id, id, id))
+		case strings.HasPrefix(id, "5"):
+			errs = append(errs, fmt.Sprintf(`{"value":"%s","detail":"User has been suspended: [%s].","title":"Forbidden","resource_type":"tweet","parameter":"ids","resource_id":"%s","type":"https://api.twitter.com/2/problems/resource-not-found"}`, id, id, id))
+		case strings.HasPrefix(id, "6"):
+		default:
+			tweets = append(tweets, fmt.Sprintf(`{"id":"%s","text":"tweet %s"}`, id, id))
+		}
+	}
+	if single != r.URL.Path {
+		data := "null"
+		if len(tweets) > 0 {
+			data = tweets[0]
+		}
+		fmt.Fprintf(w, `{"data":%s,"errors":[%s]}`, data, strings.Join(errs, ","))
+		return
+	}
+	fmt.Fprintf(w, `{"data":[%s],"errors":[%s]}`, strings.Join(tweets, ","), strings.Join(errs, ","))
+}
+
+func runHydrator(t *testing.T, h *Hydrator, values []string) ([]string, *Report) {
+	ids := make(chan string)
+	go func() {
+		defer close(ids)
+		for _, id := range values {
+			ids <- id
+		}
+	}()
+	tweets := make(chan *twitter.TweetDictionary)
+	got := []string{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for tweet := range tweets {
+			got = append(got, tweet.Tweet.ID)
+		}
+	}()
+	report, err := h.Run(context.Background(), ids, tweets)
+	if err != nil {
+		t.Fatalf("Hydrator.Run() error = %v", err)
+	}
+	<-done
+	sort.Strings(got)
+	return got, report
+}
+
+func TestHydrator_Run(t *testing.T) {
+	server := httptest.NewServer(&lookupServer{fails: 1})
+	defer server.Close()
+	h := &Hydrator{
+		Client: &twitter.Client{
+			Authorizer: noAuth{},
+			Client:     http.DefaultClient,
+			Host:       server.URL,
+		},
+		Workers:   2,
+		BatchSize: 2,
+		Backoff:   time.Millisecond,
+	}
+
+	got, report := runHydrator(t, h, []string{"10", "41", "11", "10", "51", "61", "12", "13"})
+	if strings.Join(got, ",") != "10,11,12,13" {
+		t.Errorf("Hydrator.Run() tweets = %v", got)
+	}
+	if report.IDs != 7 || report.Hydrated != 4 || report.Lookups != 5 || len(report.Failures) != 0 {
+		t.Errorf("Hydrator.Run() report = %+v", report)
+	}
+	missing := []string{}
+	for _, m := range report.Missing {
+		missing = append(missing, m.ID+"="+string(m.Reason))
+	}
+	if want := "41=not_found,51=suspended,61=not_found"; strings.Join(missing, ",") != want {
+		t.Errorf("Hydrator.Run() missing = %v, want %s", missing, want)
+	}
+}
+
+func TestHydrator_RunRateLimited(t *testing.T) {
+	server := httptest.NewServer(&lookupServer{limited: 1})
+	defer server.Close()
+	h := &Hydrator{
+		Client: &twitter.Client{
+			Authorizer: noAuth{},
+			Client:     http.DefaultClient,
+			Host:       server.URL,
+		},
+		MaxAttempts: 1,
+	}
+
+	got, report := runHydrator(t, h, []string{"10", "11"})
+	if strings.Join(got, ",") != "10,11" || report.Lookups != 2 || len(report.Failures) != 0 {
+		t.Errorf("Hydrator.Run() = %v report %+v", got, report)
+	}
+}
+
+func TestHydrator_RunFailures(t *testing.T) {
+	server := httptest.NewServer(&lookupServer{fails: 2})
+	defer server.Close()
+	h := &Hydrator{
+		Client: &twitter.Client{
+			Authorizer: noAuth{},
+			Client:     http.DefaultClient,
+			Host:       server.URL,
+		},
+		MaxAttempts: 2,
+		Backoff:     time.Millisecond,
+	}
+
+	got, report := runHydrator(t, h, []string{"10", "11"})
+	if len(got) != 0 || len(report.Failures) != 1 || len(report.Failures[0].IDs) != 2 {
+		t.Errorf("Hydrator.Run() = %v report %+v", got, report)
+	}
+}
@@ -0,0 +1,61 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+// ComplianceJob is a batch compliance job of the ids.  Submit creates the job and uploads the ids, and the result is
+// the downloaded compliance results.
+type ComplianceJob struct {
+	Client *twitter.Client
+	Type   twitter.ComplianceBatchJobType
+	Opts   twitter.CreateComplianceBatchJobOpts
+	IDs    []string
+}
+
+// Submit will create the compliance job and upload the ids
+func (c *ComplianceJob) Submit(ctx context.Context) (string, error) {
+	if len(c.IDs) == 0 {
+		return "", fmt.Errorf("compliance job: ids are required: %w", twitter.ErrParameter)
+	}
+	resp, err := c.Client.CreateComplianceBatchJob(ctx, c.Type, c.Opts)
+	if err != nil {
+		return "", err
+	}
+	if err := resp.Raw.Job.Upload(ctx, strings.NewReader(strings.Join(c.IDs, "\n"))); err != nil {
+		return "", err
+	}
+	return resp.Raw.Job.ID, nil
+}
+
+// Status returns the state of the compliance job, an expired job has failed
+func (c *ComplianceJob) Status(ctx context.Context, id string) (State, error) {
+	resp, err := c.Client.ComplianceBatchJob(ctx, id)
+	if err != nil {
+		return State{}, err
+	}
+	job := resp.Raw.Job
+	switch job.Status {
+	case twitter.ComplianceBatchJobStatusComplete:
+		return State{Status: StatusSucceeded}, nil
+	case twitter.ComplianceBatchJobStatusFailed, twitter.ComplianceBatchJobStatusExpired:
+		return State{Status: StatusFailed, Error: fmt.Sprintf("%s %s", job.Status, job.Error)}, nil
+	case twitter.ComplianceBatchJobStatusInProgress:
+		return State{Status: StatusRunning}, nil
+	default:
+		return State{Status: StatusPending}, nil
+	}
+}
+
+// Result will download the results of the compliance job
+func (c *ComplianceJob) Result(ctx context.Context, id string) (*twitter.ComplianceBatchJobDownloadResponse, error) {
+	resp, err := c.Client.ComplianceBatchJob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Raw.Job.Download(ctx)
+}
@@ -0,0 +1,74 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+type noAuth struct{}
+
+func (noAuth) Add(*http.Request) {}
+
+func TestComplianceJob(t *testing.T) {
+	mutex := sync.Mutex{}
+	uploaded := ""
+	polls := 0
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	job := func(status string) string {
+		return fmt.Sprintf(`{"data":{"id":"1","type":"tweets","status":"%s","upload_url":"%s/upload","download_url":"%s/download"}}`, status, server.URL, server.URL)
+	}
+	mux.HandleFunc("/2/compliance/jobs", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, job("created"))
+	})
+	mux.HandleFunc("/2/compliance/jobs/1", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		polls++
+		if polls == 1 {
+			fmt.Fprint(w, job("in_progress"))
+			return
+		}
+		fmt.Fprint(w, job("complete"))
+	})
+	mux.HandleFunc("/upload", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		mutex.Lock()
+		defer mutex.Unlock()
+		uploaded = string(b)
+	})
+	mux.HandleFunc("/download", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"10","action":"delete","reason":"deleted"}`+"\n")
+	})
+
+	m := &Manager[*twitter.ComplianceBatchJobDownloadResponse]{
+		Interval: time.Millisecond,
+	}
+	resp, err := m.Run(context.Background(), "tweets", &ComplianceJob{
+		Client: &twitter.Client{
+			Authorizer: noAuth{},
+			Client:     http.DefaultClient,
+			Host:       server.URL,
+		},
+		Type: twitter.ComplianceBatchJobTypeTweets,
+		IDs:  []string{"10", "11"},
+	})
+	if err != nil {
+		t.Fatalf("ComplianceJob error = %v", err)
+	}
+	if uploaded != "10\n11" {
+		t.Errorf("ComplianceJob uploaded = %q", uploaded)
+	}
+	if ids := resp.DeletedIDs(); len(ids) != 1 || ids[0] != "10" {
+		t.Errorf("ComplianceJob deleted ids = %v", ids)
+	}
+}
@@ -0,0 +1,215 @@
+// Package jobs runs the long running twitter jobs, like the batch compliance jobs, through their lifecycle.  A job is
+// submitted once, polled until it is done and its result is fetched, with the job's record saved to a store so a
+// restarted process continues the job instead of submitting it again.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	defaultInterval    = 30 * time.Second
+	defaultMaxAttempts = 3
+	defaultBackoff     = time.Second
+)
+
+// ErrJobFailed is returned when the job finished without a result
+var ErrJobFailed = errors.New("job failed")
+
+// Status is the lifecycle status of a job
+type Status string
+
+const (
+	// StatusPending is a job that is submitted but has not started
+	StatusPending Status = "pending"
+	// StatusRunning is a job that has started
+	StatusRunning Status = "running"
+	// StatusSucceeded is a job that has a result
+	StatusSucceeded Status = "succeeded"
+	// StatusFailed is a job that finished without a result
+	StatusFailed Status = "failed"
+)
+
+func (s Status) done() bool {
+	return s == StatusSucceeded || s == StatusFailed
+}
+
+// State is the status of a job and the reason a job failed
+type State struct {
+	Status Status
+	Error  string
+}
+
+// Job is a long running job.  Submit starts the job and returns its id, Status returns the job's state and Result
+// returns the result of a succeeded job.
+type Job[T any] interface {
+	Submit(ctx context.Context) (string, error)
+	Status(ctx context.Context, id string) (State, error)
+	Result(ctx context.Context, id string) (T, error)
+}
+
+// Manager will run the jobs through their lifecycle.  Store keeps the job records, it defaults to memory.  Interval is
+// the time between the status polls, it defaults to 30 seconds.  MaxAttempts is the number of times a status or result
+// call is tried before the job fails, it defaults to three, with Backoff between the attempts, which defaults to one
+// second.  Submit is only called once, a submit that created the job before it failed would create another job if it
+// was retried.  OnComplete is optionally called with the result, or the error, of each job.
+type Manager[T any] struct {
+	Store       Store
+	Interval    time.Duration
+	MaxAttempts int
+	Backoff     time.Duration
+	OnComplete  func(ctx context.Context, name string, result T, err error)
+
+	storeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// Start will run the named job in its own go routine, the result is sent to OnComplete.  Wait will wait for the
+// started jobs.
+func (m *Manager[T]) Start(ctx context.Context, name string, job Job[T]) {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		m.Run(ctx, name, job)
+	}()
+}
+
+// Wait will wait for the started jobs to complete
+func (m *Manager[T]) Wait() {
+	m.wg.Wait()
+}
+
+// Run will run the named job until it is done and return its result.  A job with a record in the store is continued:
+// a submitted job is polled and a succeeded job has its result fetched, only a job without a record, or one that
+// failed, is submitted.
+func (m *Manager[T]) Run(ctx context.Context, name string, job Job[T]) (T, error) {
+	result, err := m.run(ctx, name, job)
+	if m.OnComplete != nil {
+		m.OnComplete(ctx, name, result, err)
+	}
+	return result, err
+}
+
+func (m *Manager[T]) run(ctx context.Context, name string, job Job[T]) (T, error) {
+	var empty T
+	store := m.store()
+
+	record, err := store.Load(ctx, name)
+	if err != nil {
+		return empty, fmt.Errorf("job %s load: %w", name, err)
+	}
+	if record == nil || record.Status == StatusFailed {
+		record = &Record{
+			Name: name,
+		}
+		id, err := job.Submit(ctx)
+		if err != nil {
+			return empty, fmt.Errorf("job %s submit: %w", name, err)
+		}
+		record.ID = id
+		record.SubmittedAt = time.Now()
+		if err := m.save(ctx, store, record, State{Status: StatusPending}); err != nil {
+			return empty, err
+		}
+	}
+
+	for !record.Status.done() {
+		state, err := attempt(ctx, m, func() (State, error) {
+			return job.Status(ctx, record.ID)
+		})
+		if err != nil {
+			return empty, fmt.Errorf("job %s status: %w", name, err)
+		}
+		if state.Status != record.Status {
+			if err := m.save(ctx, store, record, state); err != nil {
+				return empty, err
+			}
+		}
+		if record.Status.done() {
+			break
+		}
+		if err := sleep(ctx, m.interval()); err != nil {
+			return empty, err
+		}
+	}
+
+	if record.Status == StatusFailed {
+		return empty, fmt.Errorf("job %s [%s] %s: %w", name, record.ID, record.Error, ErrJobFailed)
+	}
+	result, err := attempt(ctx, m, func() (T, error) {
+		return job.Result(ctx, record.ID)
+	})
+	if err != nil {
+		return empty, fmt.Errorf("job %s result: %w", name, err)
+	}
+	return result, nil
+}
+
+func (m *Manager[T]) save(ctx context.Context, store Store, record *Record, state State) error {
+	record.Status = state.Status
+	record.Error = state.Error
+	record.UpdatedAt = time.Now()
+	if err := store.Save(ctx, *record); err != nil {
+		return fmt.Errorf("job %s save: %w", record.Name, err)
+	}
+	return nil
+}
+
+func (m *Manager[T]) store() Store {
+	m.storeOnce.Do(func() {
+		if m.Store == nil {
+			m.Store = &MemoryStore{}
+		}
+	})
+	return m.Store
+}
+
+func (m *Manager[T]) interval() time.Duration {
+	if m.Interval > 0 {
+		return m.Interval
+	}
+	return defaultInterval
+}
+
+// attempt will call the function until it succeeds or the manager's attempts are used
+func attempt[T any, R any](ctx context.Context, m *Manager[T], fn func() (R, error)) (R, error) {
+	maxAttempts := m.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	backoff := m.Backoff
+	if backoff <= 0 {
+		backoff = defaultBackoff
+	}
+	var (
+		result R
+		err    error
+	)
+	for i := 0; i < maxAttempts; i++ {
+		if i > 0 {
+			if err := sleep(ctx, backoff); err != nil {
+				return result, err
+			}
+		}
+		result, err = fn()
+		if err == nil {
+			return result, nil
+		}
+	}
+	return result, err
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
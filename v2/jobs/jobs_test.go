@@ -0,0 +1,167 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+type scriptedJob struct {
+	mutex      sync.Mutex
+	submits    int
+	submitErrs int
+	states     []State
+	statusErrs int
+	result     string
+}
+
+func (s *scriptedJob) Submit(ctx context.Context) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.submitErrs > 0 {
+		s.submitErrs--
+		return "", errors.New("submit unavailable")
+	}
+	s.submits++
+	return "job-1", nil
+}
+
+func (s *scriptedJob) Status(ctx context.Context, id string) (State, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.statusErrs > 0 {
+		s.statusErrs--
+		return State{}, errors.New("status unavailable")
+	}
+	state := s.states[0]
+	if len(s.states) > 1 {
+		s.states = s.states[1:]
+	}
+	return state, nil
+}
+
+func (s *scriptedJob) Result(ctx context.Context, id string) (string, error) {
+	return s.result + ":" + id, nil
+}
+
+func testManager() *Manager[string] {
+	return &Manager[string]{
+		Interval: time.Millisecond,
+		Backoff:  time.Millisecond,
+	}
+}
+
+func TestManager_Run(t *testing.T) {
+	job := &scriptedJob{
+		statusErrs: 2,
+		states: []State{
+			{Status: StatusPending},
+			{Status: StatusRunning},
+			{Status: StatusSucceeded},
+		},
+		result: "done",
+	}
+	m := testManager()
+	completed := ""
+	m.OnComplete = func(ctx context.Context, name string, result string, err error) {
+		completed = name + "=" + result
+	}
+
+	got, err := m.Run(context.Background(), "compliance", job)
+	if err != nil {
+		t.Fatalf("Manager.Run() error = %v", err)
+	}
+	if got != "done:job-1" || completed != "compliance=done:job-1" || job.submits != 1 {
+		t.Errorf("Manager.Run() = %s completed %s submits %d", got, completed, job.submits)
+	}
+	record, _ := m.Store.Load(context.Background(), "compliance")
+	if record == nil || record.ID != "job-1" || record.Status != StatusSucceeded {
+		t.Errorf("Manager.Run() record = %+v", record)
+	}
+}
+
+func TestManager_RunResume(t *testing.T) {
+	store := &FileStore{Path: filepath.Join(t.TempDir(), "jobs.json")}
+	if err := store.Save(context.Background(), Record{Name: "compliance", ID: "job-1", Status: StatusRunning}); err != nil {
+		t.Fatalf("FileStore.Save() error = %v", err)
+	}
+	job := &scriptedJob{
+		states: []State{{Status: StatusSucceeded}},
+		result: "done",
+	}
+	m := testManager()
+	m.Store = store
+
+	got, err := m.Run(context.Background(), "compliance", job)
+	if err != nil || got != "done:job-1" || job.submits != 0 {
+		t.Errorf("Manager.Run() = %s, %v submits %d, want resumed", got, err, job.submits)
+	}
+	record, err := store.Load(context.Background(), "compliance")
+	if err != nil || record.Status != StatusSucceeded {
+		t.Errorf("FileStore.Load() = %+v, %v", record, err)
+	}
+}
+
+func TestManager_RunFailed(t *testing.T) {
+	job := &scriptedJob{
+		states: []State{{Status: StatusFailed, Error: "expired"}},
+	}
+	m := testManager()
+	if _, err := m.Run(context.Background(), "compliance", job); !errors.Is(err, ErrJobFailed) {
+		t.Errorf("Manager.Run() error = %v, want %v", err, ErrJobFailed)
+	}
+
+	// the failed job is submitted again
+	job.states = []State{{Status: StatusSucceeded}}
+	if _, err := m.Run(context.Background(), "compliance", job); err != nil || job.submits != 2 {
+		t.Errorf("Manager.Run() error = %v submits %d, want resubmitted", err, job.submits)
+	}
+}
+
+func TestManager_RunAttempts(t *testing.T) {
+	job := &scriptedJob{
+		statusErrs: 3,
+		states:     []State{{Status: StatusSucceeded}},
+	}
+	m := testManager()
+	if _, err := m.Run(context.Background(), "compliance", job); err == nil {
+		t.Errorf("Manager.Run() should fail after the attempts")
+	}
+}
+
+func TestManager_RunSubmitOnce(t *testing.T) {
+	job := &scriptedJob{
+		submitErrs: 2,
+	}
+	m := testManager()
+	if _, err := m.Run(context.Background(), "compliance", job); err == nil {
+		t.Errorf("Manager.Run() should fail with the submit error")
+	}
+	if job.submitErrs != 1 {
+		t.Errorf("Manager.Run() submit calls = %d, want the submit to not be retried", 2-job.submitErrs)
+	}
+}
+
+func TestManager_Start(t *testing.T) {
+	m := testManager()
+	mutex := sync.Mutex{}
+	completed := map[string]string{}
+	m.OnComplete = func(ctx context.Context, name string, result string, err error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		completed[name] = result
+	}
+	for _, name := range []string{"tweets", "users"} {
+		m.Start(context.Background(), name, &scriptedJob{
+			states: []State{{Status: StatusRunning}, {Status: StatusSucceeded}},
+			result: name,
+		})
+	}
+	m.Wait()
+	if completed["tweets"] != "tweets:job-1" || completed["users"] != "users:job-1" {
+		t.Errorf("Manager.Start() completed = %v", completed)
+	}
+}
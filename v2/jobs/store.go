@@ -0,0 +1,125 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Record is the saved lifecycle of a named job
+type Record struct {
+	Name        string    `json:"name"`
+	ID          string    `json:"id"`
+	Status      Status    `json:"status"`
+	Error       string    `json:"error,omitempty"`
+	SubmittedAt time.Time `json:"submitted_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Store will load and save the record of each named job, so a job can survive process restarts.  Load returns nil if
+// the job has no record.
+type Store interface {
+	Load(ctx context.Context, name string) (*Record, error)
+	Save(ctx context.Context, record Record) error
+}
+
+// MemoryStore keeps the records in memory
+type MemoryStore struct {
+	mutex   sync.Mutex
+	records map[string]Record
+}
+
+// Load returns the job's record
+func (m *MemoryStore) Load(_ context.Context, name string) (*Record, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	record, has := m.records[name]
+	if !has {
+		return nil, nil
+	}
+	return &record, nil
+}
+
+// Save will save the job's record
+func (m *MemoryStore) Save(_ context.Context, record Record) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.records == nil {
+		m.records = map[string]Record{}
+	}
+	m.records[record.Name] = record
+	return nil
+}
+
+// FileStore keeps the records of all jobs in a JSON file.  The file is replaced on each save so that a crash does not
+// leave a partial file.
+type FileStore struct {
+	Path  string
+	mutex sync.Mutex
+}
+
+func (f *FileStore) read() (map[string]Record, error) {
+	records := map[string]Record{}
+	b, err := os.ReadFile(f.Path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return records, nil
+	case err != nil:
+		return nil, fmt.Errorf("job store read: %w", err)
+	}
+	if err := json.Unmarshal(b, &records); err != nil {
+		return nil, fmt.Errorf("job store decode %s: %w", f.Path, err)
+	}
+	return records, nil
+}
+
+// Load returns the job's record
+func (f *FileStore) Load(_ context.Context, name string) (*Record, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	records, err := f.read()
+	if err != nil {
+		return nil, err
+	}
+	record, has := records[name]
+	if !has {
+		return nil, nil
+	}
+	return &record, nil
+}
+
+// Save will save the job's record
+func (f *FileStore) Save(_ context.Context, record Record) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	records, err := f.read()
+	if err != nil {
+		return err
+	}
+	records[record.Name] = record
+	b, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("job store encode: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), filepath.Base(f.Path)+".*")
+	if err != nil {
+		return fmt.Errorf("job store write: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return fmt.Errorf("job store write: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("job store write: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), f.Path); err != nil {
+		return fmt.Errorf("job store write: %w", err)
+	}
+	return nil
+}
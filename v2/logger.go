@@ -0,0 +1,153 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const debugBodyLimit = 64 * 1024
+
+// debugRedacted are the request and response headers that are not logged in debug mode
+var debugRedacted = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// RequestLog is the information of a request sent by the client.  In debug mode, Curl is a curl command that will send
+// the same request, and Status and Header are the response's status and headers.  The authorization and cookies are
+// redacted.
+type RequestLog struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Duration   time.Duration
+	RateLimit  *RateLimit
+	Err        error
+	Curl       string
+	Status     string
+	Header     http.Header
+}
+
+// Logger will receive the information of every request sent by the client
+type Logger interface {
+	LogRequest(ctx context.Context, log *RequestLog)
+}
+
+// LoggerFunc is a function that can be used as a logger
+type LoggerFunc func(ctx context.Context, log *RequestLog)
+
+// LogRequest will call the function
+func (f LoggerFunc) LogRequest(ctx context.Context, log *RequestLog) {
+	f(ctx, log)
+}
+
+// NopLogger is a logger that does nothing, a client without a logger behaves the same
+type NopLogger struct{}
+
+// LogRequest does nothing
+func (NopLogger) LogRequest(context.Context, *RequestLog) {}
+
+func (c *Client) logRequest(req *http.Request, resp *http.Response, d time.Duration, err error) {
+	if c.Logger == nil {
+		return
+	}
+	log := &RequestLog{
+		Method:   req.Method,
+		URL:      req.URL.String(),
+		Duration: d,
+		Err:      err,
+	}
+	if resp != nil {
+		log.StatusCode = resp.StatusCode
+		log.RateLimit = rateFromHeader(resp.Header)
+	}
+	if c.debug(req.Context()) {
+		log.Curl = curlCommand(req)
+		if resp != nil {
+			log.Status = resp.Status
+			log.Header = redactHeader(resp.Header)
+		}
+	}
+	c.Logger.LogRequest(req.Context(), log)
+}
+
+type debugKey struct{}
+
+// WithDebug returns a context that will log the requests sent with it in debug mode, see the client's Debug
+func WithDebug(ctx context.Context) context.Context {
+	return context.WithValue(ctx, debugKey{}, true)
+}
+
+func (c *Client) debug(ctx context.Context) bool {
+	if c.Debug {
+		return true
+	}
+	debug, _ := ctx.Value(debugKey{}).(bool)
+	return debug
+}
+
+// curlCommand returns a curl command that will send the request, the redacted headers are replaced and a body that
+// can not be read again, or is too large, is left out
+func curlCommand(req *http.Request) string {
+	var b strings.Builder
+	b.WriteString("curl")
+	if req.Method != http.MethodGet {
+		b.WriteString(" -X " + req.Method)
+	}
+	b.WriteString(" " + shellQuote(req.URL.String()))
+
+	keys := make([]string, 0, len(req.Header))
+	for key := range req.Header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range req.Header[key] {
+			if debugRedacted[http.CanonicalHeaderKey(key)] {
+				value = "REDACTED"
+			}
+			b.WriteString(" -H " + shellQuote(key+": "+value))
+		}
+	}
+
+	switch {
+	case req.Body == nil || req.Body == http.NoBody:
+	case req.GetBody == nil || req.ContentLength > debugBodyLimit:
+		b.WriteString(" --data-binary @body")
+	default:
+		body, err := req.GetBody()
+		if err != nil {
+			b.WriteString(" --data-binary @body")
+			break
+		}
+		defer body.Close()
+		data, err := io.ReadAll(io.LimitReader(body, debugBodyLimit+1))
+		if err != nil || len(data) > debugBodyLimit {
+			b.WriteString(" --data-binary @body")
+			break
+		}
+		b.WriteString(" --data-raw " + shellQuote(string(data)))
+	}
+	return b.String()
+}
+
+// shellQuote will single quote the value for a POSIX shell
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+func redactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+	for key := range redacted {
+		if debugRedacted[http.CanonicalHeaderKey(key)] {
+			redacted[key] = []string{"REDACTED"}
+		}
+	}
+	return redacted
+}
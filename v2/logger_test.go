@@ -0,0 +1,92 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_Logger(t *testing.T) {
+	logs := []*RequestLog{}
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Logger: LoggerFunc(func(ctx context.Context, log *RequestLog) {
+			logs = append(logs, log)
+		}),
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			header := http.Header{}
+			header.Add(rateLimit, "15")
+			header.Add(rateRemaining, "0")
+			header.Add(rateReset, "1644461060")
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"title":"Too Many Requests","detail":"Too Many Requests","type":"about:blank","status":429}`)),
+			}
+		}),
+	}
+	if _, err := client.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{}); err == nil {
+		t.Fatalf("Client.TweetRecentSearch() should be rate limited")
+	}
+	if len(logs) != 1 {
+		t.Fatalf("Client.Logger got %d logs", len(logs))
+	}
+	log := logs[0]
+	if log.Method != http.MethodGet || log.URL != "https://www.test.com/2/tweets/search/recent?query=golang" ||
+		log.StatusCode != http.StatusTooManyRequests || log.RateLimit == nil || log.RateLimit.Remaining != 0 {
+		t.Errorf("Client.Logger got %+v", log)
+	}
+}
+
+type debugAuth struct{}
+
+func (debugAuth) Add(req *http.Request) {
+	req.Header.Add("Authorization", "Bearer secret")
+}
+
+func TestClient_LoggerDebug(t *testing.T) {
+	logs := []*RequestLog{}
+	client := &Client{
+		Authorizer: debugAuth{},
+		Host:       "https://www.test.com",
+		Logger: LoggerFunc(func(ctx context.Context, log *RequestLog) {
+			logs = append(logs, log)
+		}),
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			header := http.Header{}
+			header.Add("Set-Cookie", "guest_id=secret")
+			header.Add("X-Transaction-Id", "abc")
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Status:     "201 Created",
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"1","text":"it's go time"}}`)),
+			}
+		}),
+	}
+
+	if _, err := client.CreateTweet(context.Background(), CreateTweetRequest{Text: "it's go time"}); err != nil {
+		t.Fatalf("Client.CreateTweet() error = %v", err)
+	}
+	if _, err := client.CreateTweet(WithDebug(context.Background()), CreateTweetRequest{Text: "it's go time"}); err != nil {
+		t.Fatalf("Client.CreateTweet() error = %v", err)
+	}
+	if len(logs) != 2 {
+		t.Fatalf("Client.Logger got %d logs", len(logs))
+	}
+	if len(logs[0].Curl) > 0 || logs[0].Header != nil {
+		t.Errorf("Client.Logger without debug got %+v", logs[0])
+	}
+
+	log := logs[1]
+	want := `curl -X POST 'https://www.test.com/2/tweets' -H 'Accept: application/json' -H 'Authorization: REDACTED' -H 'Content-Type: application/json' --data-raw '{"text":"it'\''s go time"}'`
+	if log.Curl != want {
+		t.Errorf("Client.Logger curl = %s, want %s", log.Curl, want)
+	}
+	if log.Status != "201 Created" || log.Header.Get("Set-Cookie") != "REDACTED" || log.Header.Get("X-Transaction-Id") != "abc" {
+		t.Errorf("Client.Logger response = %s %v", log.Status, log.Header)
+	}
+}
@@ -40,6 +40,36 @@ func mediaFieldStringArray(arr []MediaField) []string {
 	return strs
 }
 
+// MediaCategory is the category media was uploaded with
+type MediaCategory string
+
+const (
+	// MediaCategoryTweetImage is an image to be attached to a tweet
+	MediaCategoryTweetImage MediaCategory = "tweet_image"
+	// MediaCategoryTweetGIF is an animated GIF to be attached to a tweet
+	MediaCategoryTweetGIF MediaCategory = "tweet_gif"
+	// MediaCategoryTweetVideo is a video to be attached to a tweet
+	MediaCategoryTweetVideo MediaCategory = "tweet_video"
+	// MediaCategoryDMImage is an image to be attached to a direct message
+	MediaCategoryDMImage MediaCategory = "dm_image"
+	// MediaCategoryDMGIF is an animated GIF to be attached to a direct message
+	MediaCategoryDMGIF MediaCategory = "dm_gif"
+	// MediaCategoryDMVideo is a video to be attached to a direct message
+	MediaCategoryDMVideo MediaCategory = "dm_video"
+	// MediaCategorySubtitles are subtitles for a video
+	MediaCategorySubtitles MediaCategory = "subtitles"
+)
+
+// DMEligible returns true if media uploaded with the category can be attached to a direct message
+func (m MediaCategory) DMEligible() bool {
+	switch m {
+	case MediaCategoryDMImage, MediaCategoryDMGIF, MediaCategoryDMVideo:
+		return true
+	default:
+		return false
+	}
+}
+
 // MediaObj refers to any image, GIF, or video attached to a Tweet
 type MediaObj struct {
 	Key              string             `json:"media_key"`
@@ -0,0 +1,145 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const mentionsPollerInterval = time.Minute
+
+// MentionsPoller will poll the user mention timeline for new mentions.  The since id is advanced as mentions are
+// delivered, so a poller can be restarted without delivering the same mentions again.  The poller is not safe for
+// concurrent use.
+type MentionsPoller struct {
+	Client *Client
+	UserID string
+	// Interval is the time between polls, defaults to one minute
+	Interval time.Duration
+	// Opts are the options of the timeline request, the since id and pagination token are set by the poller
+	Opts UserMentionTimelineOpts
+	// SinceID is the newest mention that has been delivered
+	SinceID string
+	// Backfill will deliver the existing mentions when there is no since id, otherwise the first poll only records
+	// the newest mention
+	Backfill bool
+	// Dedupe will suppress the mentions that have already been delivered
+	Dedupe *TweetDedupe
+}
+
+// Poll will return the mentions newer than the since id, oldest first, and advance the since id
+func (p *MentionsPoller) Poll(ctx context.Context) ([]*TweetDictionary, error) {
+	opts := p.Opts
+	opts.SinceID = p.SinceID
+	opts.PaginationToken = ""
+
+	pages := [][]*TweetDictionary{}
+	newestID := ""
+	for {
+		timeline, err := p.Client.UserMentionTimeline(ctx, p.UserID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("mentions poller: %w", err)
+		}
+		page := []*TweetDictionary{}
+		p.Dedupe.filter(timeline.Raw)
+		if timeline.Raw != nil {
+			dictionaries := timeline.Raw.TweetDictionaries()
+			for _, tweet := range timeline.Raw.Tweets {
+				if tweet != nil {
+					page = append(page, dictionaries[tweet.ID])
+				}
+			}
+		}
+		pages = append(pages, page)
+		if len(newestID) == 0 && timeline.Meta != nil {
+			newestID = timeline.Meta.NewestID
+		}
+		if timeline.Meta == nil || len(timeline.Meta.NextToken) == 0 {
+			break
+		}
+		opts.PaginationToken = timeline.Meta.NextToken
+	}
+
+	mentions := []*TweetDictionary{}
+	for i := len(pages) - 1; i >= 0; i-- {
+		for j := len(pages[i]) - 1; j >= 0; j-- {
+			mentions = append(mentions, pages[i][j])
+		}
+	}
+	if len(newestID) > 0 {
+		p.SinceID = newestID
+	}
+	return mentions, nil
+}
+
+// Run will poll for mentions until the context is done, calling the handler with each new mention oldest first.
+// If the handler returns an error, the since id is left at the last delivered mention and the error is returned.
+func (p *MentionsPoller) Run(ctx context.Context, handler func(ctx context.Context, mention *TweetDictionary) error) error {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = mentionsPollerInterval
+	}
+
+	if len(p.SinceID) == 0 && !p.Backfill {
+		if err := p.prime(ctx); err != nil {
+			return err
+		}
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		sinceID := p.SinceID
+		mentions, err := p.Poll(ctx)
+		if err != nil {
+			return err
+		}
+		for _, mention := range mentions {
+			if err := handler(ctx, mention); err != nil {
+				p.SinceID = sinceID
+				return fmt.Errorf("mentions poller handler: %w", err)
+			}
+			sinceID = mention.Tweet.ID
+		}
+		timer.Reset(interval)
+	}
+}
+
+// prime will record the newest mention without delivering it
+func (p *MentionsPoller) prime(ctx context.Context) error {
+	opts := p.Opts
+	opts.MaxResults = userMentionTimelineMinResults
+	opts.PaginationToken = ""
+	timeline, err := p.Client.UserMentionTimeline(ctx, p.UserID, opts)
+	if err != nil {
+		return fmt.Errorf("mentions poller: %w", err)
+	}
+	if timeline.Meta != nil {
+		p.SinceID = timeline.Meta.NewestID
+	}
+	return nil
+}
+
+// MentionsWebhookSimulator will deliver the polled mentions to a webhook dispatcher as tweet create events, so
+// application code written against webhooks can run without Account Activity access.
+type MentionsWebhookSimulator struct {
+	Poller     *MentionsPoller
+	Dispatcher WebhookDispatcher
+}
+
+// Run will poll and dispatch the mentions until the context is done or the dispatcher returns an error
+func (s *MentionsWebhookSimulator) Run(ctx context.Context) error {
+	return s.Poller.Run(ctx, func(ctx context.Context, mention *TweetDictionary) error {
+		return s.Dispatcher.Dispatch(ctx, &WebhookEvent{
+			Type:      WebhookEventTweetCreate,
+			ForUserID: s.Poller.UserID,
+			Tweet:     mention,
+		})
+	})
+}
@@ -0,0 +1,123 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func mentionsPollerTestClient() *Client {
+	return &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if !strings.HasSuffix(req.URL.Path, userMentionTimelineEndpoint.urlID("", "2244994945")) {
+				log.Panicf("the path is not correct %s", req.URL.Path)
+			}
+			var body string
+			switch q := req.URL.Query(); {
+			case len(q.Get("since_id")) == 0:
+				body = `{"data":[{"id":"3","text":"three"}],"meta":{"result_count":1,"newest_id":"3","oldest_id":"3"}}`
+			case q.Get("since_id") == "3" && len(q.Get("pagination_token")) == 0:
+				body = `{"data":[{"id":"6","text":"six","author_id":"1"},{"id":"5","text":"five","author_id":"1"}],"includes":{"users":[{"id":"1","name":"one","username":"one"}]},"meta":{"result_count":2,"newest_id":"6","oldest_id":"5","next_token":"next"}}`
+			case q.Get("since_id") == "3" && q.Get("pagination_token") == "next":
+				body = `{"data":[{"id":"4","text":"four"}],"meta":{"result_count":1,"newest_id":"4","oldest_id":"4"}}`
+			default:
+				body = `{"meta":{"result_count":0}}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+}
+
+func TestMentionsPoller_Poll(t *testing.T) {
+	poller := &MentionsPoller{
+		Client:  mentionsPollerTestClient(),
+		UserID:  "2244994945",
+		SinceID: "3",
+	}
+	mentions, err := poller.Poll(context.Background())
+	if err != nil {
+		t.Fatalf("MentionsPoller.Poll() error = %v", err)
+	}
+	ids := []string{}
+	for _, mention := range mentions {
+		ids = append(ids, mention.Tweet.ID)
+	}
+	if !reflect.DeepEqual(ids, []string{"4", "5", "6"}) {
+		t.Errorf("MentionsPoller.Poll() = %v", ids)
+	}
+	if mentions[2].Author == nil || mentions[2].Author.UserName != "one" {
+		t.Errorf("MentionsPoller.Poll() author = %v", mentions[2].Author)
+	}
+	if poller.SinceID != "6" {
+		t.Errorf("MentionsPoller.Poll() since id = %s", poller.SinceID)
+	}
+
+	mentions, err = poller.Poll(context.Background())
+	if err != nil || len(mentions) != 0 || poller.SinceID != "6" {
+		t.Errorf("MentionsPoller.Poll() = %v, %v since id %s", mentions, err, poller.SinceID)
+	}
+}
+
+func TestMentionsWebhookSimulator_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := []*WebhookEvent{}
+	simulator := &MentionsWebhookSimulator{
+		Poller: &MentionsPoller{
+			Client:   mentionsPollerTestClient(),
+			UserID:   "2244994945",
+			Interval: time.Millisecond,
+		},
+		Dispatcher: WebhookDispatcherFunc(func(ctx context.Context, event *WebhookEvent) error {
+			events = append(events, event)
+			if len(events) == 3 {
+				cancel()
+			}
+			return nil
+		}),
+	}
+	if err := simulator.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("MentionsWebhookSimulator.Run() error = %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("MentionsWebhookSimulator.Run() events = %d", len(events))
+	}
+	for i, id := range []string{"4", "5", "6"} {
+		if events[i].Type != WebhookEventTweetCreate || events[i].ForUserID != "2244994945" || events[i].Tweet.Tweet.ID != id {
+			t.Errorf("MentionsWebhookSimulator.Run() event %d = %+v", i, events[i])
+		}
+	}
+}
+
+func TestMentionsPoller_Run_handlerError(t *testing.T) {
+	poller := &MentionsPoller{
+		Client:   mentionsPollerTestClient(),
+		UserID:   "2244994945",
+		SinceID:  "3",
+		Interval: time.Millisecond,
+	}
+	err := poller.Run(context.Background(), func(ctx context.Context, mention *TweetDictionary) error {
+		if mention.Tweet.ID == "5" {
+			return errors.New("delivery failed")
+		}
+		return nil
+	})
+	if err == nil {
+		t.Fatalf("MentionsPoller.Run() error is nil")
+	}
+	if poller.SinceID != "4" {
+		t.Errorf("MentionsPoller.Run() since id = %s, want the last delivered mention", poller.SinceID)
+	}
+}
@@ -0,0 +1,63 @@
+package twitter
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// TimeLayoutClassic is the layout of the created at timestamps of the classic, v1.1, objects
+	TimeLayoutClassic = "Mon Jan 02 15:04:05 -0700 2006"
+
+	permalinkHost = "https://twitter.com"
+)
+
+// ErrNoCreatedAt is returned when the created at field was not requested
+var ErrNoCreatedAt = errors.New("twitter object does not have a created at time")
+
+// ParseTime will parse a twitter timestamp, either RFC3339 from the v2 objects or the classic layout
+func ParseTime(value string) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, ErrNoCreatedAt
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, nil
+	}
+	if classic, classicErr := time.Parse(TimeLayoutClassic, value); classicErr == nil {
+		return classic, nil
+	}
+	return time.Time{}, fmt.Errorf("twitter time %s: %w", value, err)
+}
+
+// CreatedAtTime will parse the tweet's created at field
+func (t *TweetObj) CreatedAtTime() (time.Time, error) {
+	return ParseTime(t.CreatedAt)
+}
+
+// TweetURL is the permalink of the tweet.  The author's user name is not needed.
+func (t *TweetObj) TweetURL() string {
+	return fmt.Sprintf("%s/i/web/status/%s", permalinkHost, t.ID)
+}
+
+// CreatedAtTime will parse the user's created at field
+func (u *UserObj) CreatedAtTime() (time.Time, error) {
+	return ParseTime(u.CreatedAt)
+}
+
+// ProfileURL is the permalink of the user's profile
+func (u *UserObj) ProfileURL() string {
+	if len(u.UserName) == 0 {
+		return fmt.Sprintf("%s/i/user/%s", permalinkHost, u.ID)
+	}
+	return fmt.Sprintf("%s/%s", permalinkHost, u.UserName)
+}
+
+// TweetURL is the permalink of the tweet with the author's user name, if the author was expanded
+func (t *TweetDictionary) TweetURL() string {
+	if t.Author == nil || len(t.Author.UserName) == 0 {
+		return t.Tweet.TweetURL()
+	}
+	return fmt.Sprintf("%s/%s/status/%s", permalinkHost, t.Author.UserName, t.Tweet.ID)
+}
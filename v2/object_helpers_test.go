@@ -0,0 +1,40 @@
+package twitter
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	want := time.Date(2021, time.November, 15, 19, 8, 5, 0, time.UTC)
+	for _, value := range []string{"2021-11-15T19:08:05.000Z", "Mon Nov 15 19:08:05 +0000 2021"} {
+		got, err := ParseTime(value)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("ParseTime(%s) = %v %v, want %v", value, got, err, want)
+		}
+	}
+	if _, err := ParseTime("yesterday"); err == nil {
+		t.Errorf("ParseTime() should fail an unknown layout")
+	}
+	if _, err := (&UserObj{}).CreatedAtTime(); !errors.Is(err, ErrNoCreatedAt) {
+		t.Errorf("UserObj.CreatedAtTime() error = %v, want ErrNoCreatedAt", err)
+	}
+}
+
+func TestPermalinks(t *testing.T) {
+	tweet := &TweetObj{ID: "1460323737035677698"}
+	if got := tweet.TweetURL(); got != "https://twitter.com/i/web/status/1460323737035677698" {
+		t.Errorf("TweetObj.TweetURL() = %s", got)
+	}
+	dictionary := &TweetDictionary{Tweet: *tweet, Author: &UserObj{ID: "2244994945", UserName: "TwitterDev"}}
+	if got := dictionary.TweetURL(); got != "https://twitter.com/TwitterDev/status/1460323737035677698" {
+		t.Errorf("TweetDictionary.TweetURL() = %s", got)
+	}
+	if got := dictionary.Author.ProfileURL(); got != "https://twitter.com/TwitterDev" {
+		t.Errorf("UserObj.ProfileURL() = %s", got)
+	}
+	if got := (&UserObj{ID: "2244994945"}).ProfileURL(); got != "https://twitter.com/i/user/2244994945" {
+		t.Errorf("UserObj.ProfileURL() = %s", got)
+	}
+}
@@ -0,0 +1,19 @@
+module github.com/g8rswimmer/go-twitter/v2/otel
+
+go 1.18
+
+require (
+	github.com/g8rswimmer/go-twitter/v2 v2.2.0
+	go.opentelemetry.io/otel v1.14.0
+	go.opentelemetry.io/otel/sdk v1.14.0
+	go.opentelemetry.io/otel/trace v1.14.0
+)
+
+require (
+	github.com/go-logr/logr v1.2.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	golang.org/x/sys v0.5.0 // indirect
+)
+
+// The client is developed in the same repository, the replace is ignored by the modules that require the otel module
+replace github.com/g8rswimmer/go-twitter/v2 => ../
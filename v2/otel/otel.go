@@ -0,0 +1,94 @@
+// Package twitterotel instruments the twitter client with OpenTelemetry tracing.  It is a separate module so the client
+// does not depend on OpenTelemetry.
+package twitterotel
+
+import (
+	"context"
+	"net/http"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/g8rswimmer/go-twitter/v2/otel"
+
+// Tracer will start an OpenTelemetry span for each API call of the client
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// Option is an option of the tracer
+type Option func(*config)
+
+type config struct {
+	provider trace.TracerProvider
+}
+
+// WithTracerProvider will use the tracer provider instead of the global provider
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.provider = provider
+	}
+}
+
+// NewTracer returns a tracer that can be used as the client's tracer
+func NewTracer(opts ...Option) *Tracer {
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.provider == nil {
+		c.provider = otel.GetTracerProvider()
+	}
+	return &Tracer{
+		tracer: c.provider.Tracer(instrumentationName),
+	}
+}
+
+// StartSpan will start a client span for the API call
+func (t *Tracer) StartSpan(ctx context.Context, endpoint string, req *http.Request) (context.Context, twitter.Span) {
+	ctx, span := t.tracer.Start(ctx, "twitter "+endpoint,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("twitter.endpoint", endpoint),
+			attribute.String("http.method", req.Method),
+			attribute.String("http.url", req.URL.String()),
+		),
+	)
+	return ctx, &apiSpan{
+		span: span,
+	}
+}
+
+type apiSpan struct {
+	span trace.Span
+}
+
+// End will add the result attributes and end the span
+func (s *apiSpan) End(result *twitter.SpanResult) {
+	defer s.span.End()
+	if result.StatusCode > 0 {
+		s.span.SetAttributes(attribute.Int("http.status_code", result.StatusCode))
+	}
+	if result.HasResultCount {
+		s.span.SetAttributes(attribute.Int("twitter.result_count", result.ResultCount))
+	}
+	if result.RateLimit != nil {
+		s.span.SetAttributes(
+			attribute.Int("twitter.rate_limit.limit", result.RateLimit.Limit),
+			attribute.Int("twitter.rate_limit.remaining", result.RateLimit.Remaining),
+			attribute.Int64("twitter.rate_limit.reset", int64(result.RateLimit.Reset)),
+		)
+	}
+	switch {
+	case result.Err != nil:
+		s.span.RecordError(result.Err)
+		s.span.SetStatus(codes.Error, result.Err.Error())
+	case result.StatusCode >= http.StatusBadRequest:
+		s.span.SetStatus(codes.Error, http.StatusText(result.StatusCode))
+	default:
+	}
+}
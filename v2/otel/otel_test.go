@@ -0,0 +1,71 @@
+package twitterotel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type authorizer struct{}
+
+func (a authorizer) Add(req *http.Request) {}
+
+func TestTracer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("x-rate-limit-limit", "450")
+		w.Header().Add("x-rate-limit-remaining", "449")
+		w.Header().Add("x-rate-limit-reset", "1644461060")
+		fmt.Fprint(w, `{"data":[{"id":"1","text":"golang"}],"meta":{"result_count":1,"newest_id":"1","oldest_id":"1"}}`)
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	client := &twitter.Client{
+		Authorizer: authorizer{},
+		Client:     http.DefaultClient,
+		Host:       server.URL,
+		Tracer:     NewTracer(WithTracerProvider(provider)),
+	}
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	if _, err := client.TweetRecentSearch(ctx, "golang", twitter.TweetRecentSearchOpts{}); err != nil {
+		t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+	}
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Tracer recorded %d spans", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "twitter GET 2/tweets/search/recent" || span.SpanKind() != trace.SpanKindClient {
+		t.Errorf("Tracer span = %s %s", span.Name(), span.SpanKind())
+	}
+	if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("Tracer span is not a child of the incoming context")
+	}
+	want := map[attribute.Key]attribute.Value{
+		"twitter.endpoint":             attribute.StringValue("GET 2/tweets/search/recent"),
+		"http.status_code":             attribute.IntValue(http.StatusOK),
+		"twitter.result_count":         attribute.IntValue(1),
+		"twitter.rate_limit.remaining": attribute.IntValue(449),
+	}
+	got := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		got[kv.Key] = kv.Value
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("Tracer span attribute %s = %v, want %v", k, got[k].Emit(), v.Emit())
+		}
+	}
+}
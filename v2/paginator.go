@@ -0,0 +1,476 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Paginator is the iteration of a paginated endpoint.  Next fetches the next page and returns false when there are no
+// more pages or there is an error, Items are the items of the current page and Err is the error that stopped the
+// paging.
+//
+//	for pager.Next(ctx) {
+//		for _, item := range pager.Items() {
+//			...
+//		}
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+//
+// Pager implements it for the timelines, follows, likes, list members, bookmarks and direct message events, and the
+// TweetSearchPager and DMConversationPager implement it for the search and the conversation history.
+type Paginator[T any] interface {
+	Next(ctx context.Context) bool
+	Items() []T
+	Err() error
+}
+
+var (
+	_ Paginator[*TweetDictionary] = (*TweetSearchPager)(nil)
+	_ Paginator[*DMEventObj]      = (*DMConversationPager)(nil)
+	_ Paginator[*UserDictionary]  = (*Pager[*UserDictionary])(nil)
+)
+
+// Page is a page of a paginated endpoint.  NewestID is the newest id of the first page, for the endpoints that can
+// continue a completed crawl with a since id.
+type Page[T any] struct {
+	Items     []T
+	NextToken string
+	NewestID  string
+	RateLimit *RateLimit
+}
+
+// PageFetcher returns the page of the cursor's next token.  With a cursor since id, only the items newer than it
+// should be fetched.
+type PageFetcher[T any] func(ctx context.Context, cursor Cursor) (*Page[T], error)
+
+// Pager will page through a paginated endpoint with a page fetcher, see NewPager for the endpoints of the client.  The
+// pager is not safe for concurrent use.
+//
+// With a cursor store, the cursor of the job is saved once the caller has finished a page, which is when the next
+// page is asked for or the page is committed with Commit.  A pager for the same job will resume the crawl at the
+// first page that was not finished or, if the crawl was completed, fetch only the items newer than the crawl when
+// the endpoint has a since id.
+//
+// With WaitRateLimit, a page that has no requests remaining will hold the next page until the rate limit resets,
+// instead of the request being rate limited.
+type Pager[T any] struct {
+	Fetch PageFetcher[T]
+	// Name is used in the errors
+	Name string
+	// Job is the name of the cursor in the store
+	Job   string
+	Store CursorStore
+	// WaitRateLimit will wait for the rate limit reset before the next page
+	WaitRateLimit bool
+
+	started    bool
+	done       bool
+	cursor     Cursor
+	checkpoint cursorCheckpoint
+	page       *Page[T]
+	err        error
+}
+
+// Next will fetch the next page and returns false when there are no more pages or there is an error
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+	if err := p.checkpoint.commit(ctx); err != nil {
+		p.err = err
+		return false
+	}
+	if p.done {
+		return false
+	}
+	if !p.started {
+		if err := p.start(ctx); err != nil {
+			p.err = err
+			return false
+		}
+		p.started = true
+	}
+	if p.WaitRateLimit && p.page != nil {
+		if err := waitRateLimit(ctx, p.page.RateLimit); err != nil {
+			p.err = fmt.Errorf("%s: %w", p.name(), err)
+			return false
+		}
+	}
+
+	page, err := p.Fetch(ctx, p.cursor)
+	if err != nil {
+		p.err = fmt.Errorf("%s: %w", p.name(), err)
+		return false
+	}
+	p.page = page
+	if len(p.cursor.NextToken) == 0 && len(page.NewestID) > 0 {
+		p.cursor.NewestID = page.NewestID
+	}
+	p.cursor.NextToken = page.NextToken
+	p.done = len(p.cursor.NextToken) == 0
+	p.checkpoint.hold(p.cursor)
+	return true
+}
+
+// Commit will save the cursor after the current page, for a caller that stops before the next page is asked for
+func (p *Pager[T]) Commit(ctx context.Context) error {
+	return p.checkpoint.commit(ctx)
+}
+
+// Page is the current page
+func (p *Pager[T]) Page() *Page[T] {
+	return p.page
+}
+
+// Items are the items of the current page
+func (p *Pager[T]) Items() []T {
+	if p.page == nil {
+		return nil
+	}
+	return p.page.Items
+}
+
+// Err is the error that stopped the paging
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// Cursor is the cursor after the current page
+func (p *Pager[T]) Cursor() Cursor {
+	return p.cursor
+}
+
+func (p *Pager[T]) name() string {
+	if len(p.Name) > 0 {
+		return p.Name
+	}
+	return "pager"
+}
+
+func (p *Pager[T]) start(ctx context.Context) error {
+	if p.Fetch == nil {
+		return fmt.Errorf("%s: a page fetcher is required: %w", p.name(), ErrParameter)
+	}
+	checkpoint, err := newCursorCheckpoint(p.name(), p.Job, p.Store)
+	if err != nil {
+		return err
+	}
+	p.checkpoint = checkpoint
+	saved, err := p.checkpoint.load(ctx)
+	if err != nil {
+		return err
+	}
+	p.cursor = resumeCursor(saved, Cursor{})
+	return nil
+}
+
+// cursorCheckpoint will save the cursor of a pager's job.  The cursor after a page is held until the caller has
+// finished the page, so a crash while a page is processed resumes at that page instead of after it.
+type cursorCheckpoint struct {
+	name    string
+	job     string
+	store   CursorStore
+	pending *Cursor
+}
+
+func newCursorCheckpoint(name, job string, store CursorStore) (cursorCheckpoint, error) {
+	if store != nil && len(job) == 0 {
+		return cursorCheckpoint{}, fmt.Errorf("%s: a job is required with a cursor store: %w", name, ErrParameter)
+	}
+	return cursorCheckpoint{
+		name:  name,
+		job:   job,
+		store: store,
+	}, nil
+}
+
+// load returns the saved cursor of the job, nil if there is not one
+func (c *cursorCheckpoint) load(ctx context.Context) (*Cursor, error) {
+	if c.store == nil {
+		return nil, nil
+	}
+	saved, err := c.store.Load(ctx, c.job)
+	if err != nil {
+		return nil, fmt.Errorf("%s load %s: %w", c.name, c.job, err)
+	}
+	return saved, nil
+}
+
+// hold will keep the cursor after a page until the page is finished
+func (c *cursorCheckpoint) hold(cursor Cursor) {
+	if c.store == nil {
+		return
+	}
+	c.pending = &cursor
+}
+
+// commit will save the held cursor
+func (c *cursorCheckpoint) commit(ctx context.Context) error {
+	if c.store == nil || c.pending == nil {
+		return nil
+	}
+	if err := c.store.Save(ctx, c.job, *c.pending); err != nil {
+		return fmt.Errorf("%s save %s: %w", c.name, c.job, err)
+	}
+	c.pending = nil
+	return nil
+}
+
+// resumeCursor returns the cursor to start a job with.  A saved crawl is resumed at its next token, a completed crawl
+// continues with the items newer than its newest id, otherwise the job starts with the cursor.
+func resumeCursor(saved *Cursor, start Cursor) Cursor {
+	switch {
+	case saved == nil:
+		return start
+	case len(saved.NextToken) > 0:
+		return *saved
+	case len(saved.NewestID) > 0:
+		return Cursor{
+			NewestID: saved.NewestID,
+			SinceID:  saved.NewestID,
+		}
+	default:
+		return start
+	}
+}
+
+// waitRateLimit will sleep until the reset when the rate limit has no requests remaining
+func waitRateLimit(ctx context.Context, rl *RateLimit) error {
+	if rl == nil || rl.Remaining > 0 {
+		return nil
+	}
+	return sleep(ctx, time.Until(rl.Reset.Time()))
+}
+
+// pagerOpts are the options of a paginated callout, the pointer will set the page of the cursor
+type pagerOpts[O any] interface {
+	*O
+	paginate(cursor Cursor)
+}
+
+// pagerResponse is the response of a paginated callout
+type pagerResponse[T any] interface {
+	page() *Page[T]
+}
+
+// NewPager returns a pager of a paginated callout of the client and the id of the user, tweet or list it looks up.
+// The pages have the tweet or user dictionaries, or the direct message events.
+//
+//	pager := twitter.NewPager[*twitter.UserDictionary](client.UserFollowersLookup, "2244994945", opts)
+//
+// The callouts are the user tweet, mention and reverse chronological timelines, the user followers and following,
+// the tweet likes and user likes, the list members, the bookmarks and, with NewDMEventsPager, the direct message
+// events.  The timelines can continue a completed crawl with only the newer tweets.
+func NewPager[T any, O any, PO pagerOpts[O], R pagerResponse[T]](lookup func(ctx context.Context, id string, opts O) (R, error), id string, opts O) *Pager[T] {
+	return &Pager[T]{
+		Fetch: func(ctx context.Context, cursor Cursor) (*Page[T], error) {
+			opts := opts
+			PO(&opts).paginate(cursor)
+			resp, err := lookup(ctx, id, opts)
+			if err != nil {
+				return nil, err
+			}
+			return resp.page(), nil
+		},
+	}
+}
+
+// NewDMEventsPager returns a pager of the direct message events of all of the user's conversations, see the
+// DMConversationPager for the history of one conversation
+func NewDMEventsPager(c *Client, opts DMEventsLookupOpts) *Pager[*DMEventObj] {
+	return NewPager[*DMEventObj](func(ctx context.Context, _ string, opts DMEventsLookupOpts) (*dmEventsPage, error) {
+		resp, err := c.DMEventsLookup(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &dmEventsPage{resp}, nil
+	}, "", opts)
+}
+
+func (t *UserTweetTimelineOpts) paginate(cursor Cursor) {
+	t.PaginationToken = cursor.NextToken
+	if len(cursor.SinceID) > 0 {
+		t.SinceID = cursor.SinceID
+	}
+}
+
+func (t *UserMentionTimelineOpts) paginate(cursor Cursor) {
+	t.PaginationToken = cursor.NextToken
+	if len(cursor.SinceID) > 0 {
+		t.SinceID = cursor.SinceID
+	}
+}
+
+func (t *UserTweetReverseChronologicalTimelineOpts) paginate(cursor Cursor) {
+	t.PaginationToken = cursor.NextToken
+	if len(cursor.SinceID) > 0 {
+		t.SinceID = cursor.SinceID
+	}
+}
+
+func (u *UserFollowersLookupOpts) paginate(cursor Cursor) {
+	u.PaginationToken = cursor.NextToken
+}
+
+func (u *UserFollowingLookupOpts) paginate(cursor Cursor) {
+	u.PaginationToken = cursor.NextToken
+}
+
+func (t *TweetLikesLookupOpts) paginate(cursor Cursor) {
+	t.PaginationToken = cursor.NextToken
+}
+
+func (u *UserLikesLookupOpts) paginate(cursor Cursor) {
+	u.PaginationToken = cursor.NextToken
+}
+
+func (l *ListUserMembersOpts) paginate(cursor Cursor) {
+	l.PaginationToken = cursor.NextToken
+}
+
+func (t *TweetBookmarksLookupOpts) paginate(cursor Cursor) {
+	t.PaginationToken = cursor.NextToken
+}
+
+func (d *DMEventsLookupOpts) paginate(cursor Cursor) {
+	d.PaginationToken = cursor.NextToken
+}
+
+func (t *UserTweetTimelineResponse) page() *Page[*TweetDictionary] {
+	page := tweetPage(t.Raw, t.RateLimit)
+	if t.Meta != nil {
+		page.NextToken = t.Meta.NextToken
+		page.NewestID = t.Meta.NewestID
+	}
+	return page
+}
+
+func (t *UserMentionTimelineResponse) page() *Page[*TweetDictionary] {
+	page := tweetPage(t.Raw, t.RateLimit)
+	if t.Meta != nil {
+		page.NextToken = t.Meta.NextToken
+		page.NewestID = t.Meta.NewestID
+	}
+	return page
+}
+
+func (t *UserTweetReverseChronologicalTimelineResponse) page() *Page[*TweetDictionary] {
+	page := tweetPage(t.Raw, t.RateLimit)
+	if t.Meta != nil {
+		page.NextToken = t.Meta.NextToken
+		page.NewestID = t.Meta.NewestID
+	}
+	return page
+}
+
+func (u *UserFollowersLookupResponse) page() *Page[*UserDictionary] {
+	page := userPage(u.Raw, u.RateLimit)
+	if u.Meta != nil {
+		page.NextToken = u.Meta.NextToken
+	}
+	return page
+}
+
+func (u *UserFollowingLookupResponse) page() *Page[*UserDictionary] {
+	page := userPage(u.Raw, u.RateLimit)
+	if u.Meta != nil {
+		page.NextToken = u.Meta.NextToken
+	}
+	return page
+}
+
+func (t *TweetLikesLookupResponse) page() *Page[*UserDictionary] {
+	page := userPage(t.Raw, t.RateLimit)
+	if t.Meta != nil {
+		page.NextToken = t.Meta.NextToken
+	}
+	return page
+}
+
+func (u *UserLikesLookupResponse) page() *Page[*TweetDictionary] {
+	page := tweetPage(u.Raw, u.RateLimit)
+	if u.Meta != nil {
+		page.NextToken = u.Meta.NextToken
+	}
+	return page
+}
+
+func (l *ListUserMembersResponse) page() *Page[*UserDictionary] {
+	page := userPage(l.Raw, l.RateLimit)
+	if l.Meta != nil {
+		page.NextToken = l.Meta.NextToken
+	}
+	return page
+}
+
+func (t *TweetBookmarksLookupResponse) page() *Page[*TweetDictionary] {
+	page := tweetPage(t.Raw, t.RateLimit)
+	if t.Meta != nil {
+		page.NextToken = t.Meta.NextToken
+	}
+	return page
+}
+
+// dmEventsPage is the direct message events response as a page, the response is a generic type so it can not have
+// the method
+type dmEventsPage struct {
+	*DMEventsLookupResponse
+}
+
+func (d *dmEventsPage) page() *Page[*DMEventObj] {
+	page := &Page[*DMEventObj]{
+		Items:     d.Data,
+		RateLimit: d.RateLimit,
+	}
+	if d.Meta != nil {
+		page.NextToken = d.Meta.NextToken
+	}
+	return page
+}
+
+func tweetPage(raw *TweetRaw, rl *RateLimit) *Page[*TweetDictionary] {
+	return &Page[*TweetDictionary]{
+		Items:     tweetPageItems(raw),
+		RateLimit: rl,
+	}
+}
+
+func userPage(raw *UserRaw, rl *RateLimit) *Page[*UserDictionary] {
+	return &Page[*UserDictionary]{
+		Items:     userPageItems(raw),
+		RateLimit: rl,
+	}
+}
+
+// tweetPageItems are the tweet dictionaries of the page, in the order of the page
+func tweetPageItems(raw *TweetRaw) []*TweetDictionary {
+	if raw == nil {
+		return nil
+	}
+	dictionaries := raw.TweetDictionaries()
+	items := make([]*TweetDictionary, 0, len(raw.Tweets))
+	for _, tweet := range raw.Tweets {
+		if tweet != nil {
+			items = append(items, dictionaries[tweet.ID])
+		}
+	}
+	return items
+}
+
+// userPageItems are the user dictionaries of the page, in the order of the page
+func userPageItems(raw *UserRaw) []*UserDictionary {
+	if raw == nil {
+		return nil
+	}
+	dictionaries := raw.UserDictionaries()
+	items := make([]*UserDictionary, 0, len(raw.Users))
+	for _, user := range raw.Users {
+		if user != nil {
+			items = append(items, dictionaries[user.ID])
+		}
+	}
+	return items
+}
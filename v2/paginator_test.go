@@ -0,0 +1,215 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func paginatorIDs[T any](t *testing.T, pager Paginator[T], id func(T) string) string {
+	got := []string{}
+	for pager.Next(context.Background()) {
+		for _, item := range pager.Items() {
+			got = append(got, id(item))
+		}
+	}
+	if err := pager.Err(); err != nil {
+		t.Fatalf("Paginator.Next() error = %v", err)
+	}
+	return strings.Join(got, ",")
+}
+
+func pagesClient(path string, pages map[string]string, header http.Header) *Client {
+	return &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != path {
+				log.Panicf("the path is not correct %s %s", req.URL.Path, path)
+			}
+			key := req.URL.Query().Get("pagination_token")
+			if since := req.URL.Query().Get("since_id"); len(since) > 0 {
+				key = "since:" + since
+			}
+			body, has := pages[key]
+			if !has {
+				log.Panicf("the page is not correct %s", req.URL.RawQuery)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+}
+
+func TestPager_Users(t *testing.T) {
+	userPage := func(ids []string, next string) string {
+		data := []string{}
+		for _, id := range ids {
+			data = append(data, fmt.Sprintf(`{"id":"%s","name":"user %s","username":"user%s"}`, id, id, id))
+		}
+		meta := fmt.Sprintf(`"result_count":%d`, len(ids))
+		if len(next) > 0 {
+			meta += fmt.Sprintf(`,"next_token":"%s"`, next)
+		}
+		return fmt.Sprintf(`{"data":[%s],"meta":{%s}}`, strings.Join(data, ","), meta)
+	}
+	pages := map[string]string{
+		"":   userPage([]string{"1", "2"}, "p2"),
+		"p2": userPage([]string{"3"}, ""),
+	}
+	userID := func(user *UserDictionary) string {
+		return user.User.ID
+	}
+
+	c := pagesClient(userFollowersEndpoint.urlID("", "2244994945"), pages, http.Header{})
+	if got := paginatorIDs[*UserDictionary](t, NewPager[*UserDictionary](c.UserFollowersLookup, "2244994945", UserFollowersLookupOpts{}), userID); got != "1,2,3" {
+		t.Errorf("NewPager() = %v, want 1,2,3", got)
+	}
+
+	store := &MemoryCursorStore{}
+	newPager := func() *Pager[*UserDictionary] {
+		pager := NewPager[*UserDictionary](c.UserFollowersLookup, "2244994945", UserFollowersLookupOpts{})
+		pager.Job = "followers"
+		pager.Store = store
+		return pager
+	}
+
+	// the first page is stopped partway, so it is not finished and the resumed job starts with it
+	pager := newPager()
+	if !pager.Next(context.Background()) {
+		t.Fatalf("Pager.Next() error = %v", pager.Err())
+	}
+	if got := paginatorIDs[*UserDictionary](t, newPager(), userID); got != "1,2,3" {
+		t.Errorf("Pager resumed an unfinished page = %v, want 1,2,3", got)
+	}
+
+	// the finished first page is committed, so the resumed job starts after it
+	store = &MemoryCursorStore{}
+	pager = newPager()
+	if !pager.Next(context.Background()) {
+		t.Fatalf("Pager.Next() error = %v", pager.Err())
+	}
+	if err := pager.Commit(context.Background()); err != nil {
+		t.Fatalf("Pager.Commit() error = %v", err)
+	}
+	resumed := newPager()
+	if got := paginatorIDs[*UserDictionary](t, resumed, userID); got != "3" {
+		t.Errorf("Pager resumed = %v, want 3", got)
+	}
+	if cursor, _ := store.Load(context.Background(), "followers"); cursor == nil || len(cursor.NextToken) > 0 {
+		t.Errorf("Pager cursor = %+v, want the completed crawl", cursor)
+	}
+
+	if (&Pager[*UserDictionary]{}).Next(context.Background()) {
+		t.Errorf("Pager.Next() without a fetcher = true")
+	}
+	unnamed := &Pager[*UserDictionary]{Store: store}
+	if unnamed.Next(context.Background()); !errors.Is(unnamed.Err(), ErrParameter) {
+		t.Errorf("Pager.Next() without a job error = %v, want %v", unnamed.Err(), ErrParameter)
+	}
+}
+
+func TestPager_TimelineSinceID(t *testing.T) {
+	tweetPage := func(ids []string, next string) string {
+		data := []string{}
+		for _, id := range ids {
+			data = append(data, fmt.Sprintf(`{"id":"%s","text":"tweet %s"}`, id, id))
+		}
+		meta := fmt.Sprintf(`"result_count":%d`, len(ids))
+		if len(ids) > 0 {
+			meta += fmt.Sprintf(`,"newest_id":"%s","oldest_id":"%s"`, ids[0], ids[len(ids)-1])
+		}
+		if len(next) > 0 {
+			meta += fmt.Sprintf(`,"next_token":"%s"`, next)
+		}
+		return fmt.Sprintf(`{"data":[%s],"meta":{%s}}`, strings.Join(data, ","), meta)
+	}
+	pages := map[string]string{
+		"":        tweetPage([]string{"9", "8"}, "p2"),
+		"p2":      tweetPage([]string{"7"}, ""),
+		"since:9": tweetPage([]string{"11", "10"}, ""),
+	}
+	tweetID := func(tweet *TweetDictionary) string {
+		return tweet.Tweet.ID
+	}
+	c := pagesClient(userTweetTimelineEndpoint.urlID("", "2244994945"), pages, http.Header{})
+	store := &MemoryCursorStore{}
+
+	pager := NewPager[*TweetDictionary](c.UserTweetTimeline, "2244994945", UserTweetTimelineOpts{})
+	pager.Job = "timeline"
+	pager.Store = store
+	if got := paginatorIDs[*TweetDictionary](t, pager, tweetID); got != "9,8,7" {
+		t.Errorf("NewPager() = %v, want 9,8,7", got)
+	}
+
+	pager = NewPager[*TweetDictionary](c.UserTweetTimeline, "2244994945", UserTweetTimelineOpts{})
+	pager.Job = "timeline"
+	pager.Store = store
+	if got := paginatorIDs[*TweetDictionary](t, pager, tweetID); got != "11,10" {
+		t.Errorf("NewPager() continued = %v, want 11,10", got)
+	}
+	if cursor := pager.Cursor(); cursor.NewestID != "11" {
+		t.Errorf("Pager cursor = %+v", cursor)
+	}
+}
+
+func TestPager_WaitRateLimit(t *testing.T) {
+	pages := map[string]string{
+		"":   `{"data":[{"id":"1","event_type":"MessageCreate","text":"hello"}],"meta":{"result_count":1,"next_token":"p2"}}`,
+		"p2": `{"data":[{"id":"2","event_type":"MessageCreate","text":"hello"}],"meta":{"result_count":1}}`,
+	}
+	header := http.Header{}
+	header.Add(rateLimit, "15")
+	header.Add(rateRemaining, "0")
+	header.Add(rateReset, fmt.Sprintf("%d", time.Now().Add(time.Hour).Unix()))
+	c := pagesClient(dmEventsEndpoint.url(""), pages, header)
+
+	pager := NewDMEventsPager(c, DMEventsLookupOpts{})
+	pager.WaitRateLimit = true
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if !pager.Next(ctx) || len(pager.Items()) != 1 {
+		t.Fatalf("Pager.Next() error = %v", pager.Err())
+	}
+	if pager.Next(ctx) {
+		t.Fatalf("Pager.Next() did not wait for the rate limit reset")
+	}
+	if err := pager.Err(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Pager.Err() = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	pager = NewDMEventsPager(c, DMEventsLookupOpts{})
+	if got := paginatorIDs[*DMEventObj](t, pager, func(event *DMEventObj) string { return event.ID }); got != "1,2" {
+		t.Errorf("NewDMEventsPager() = %v, want 1,2", got)
+	}
+}
+
+func TestNewPager_Callouts(t *testing.T) {
+	c := &Client{}
+	pagers := []interface{}{
+		NewPager[*TweetDictionary](c.UserTweetTimeline, "1", UserTweetTimelineOpts{}),
+		NewPager[*TweetDictionary](c.UserMentionTimeline, "1", UserMentionTimelineOpts{}),
+		NewPager[*TweetDictionary](c.UserTweetReverseChronologicalTimeline, "1", UserTweetReverseChronologicalTimelineOpts{}),
+		NewPager[*UserDictionary](c.UserFollowersLookup, "1", UserFollowersLookupOpts{}),
+		NewPager[*UserDictionary](c.UserFollowingLookup, "1", UserFollowingLookupOpts{}),
+		NewPager[*UserDictionary](c.TweetLikesLookup, "1", TweetLikesLookupOpts{}),
+		NewPager[*TweetDictionary](c.UserLikesLookup, "1", UserLikesLookupOpts{}),
+		NewPager[*UserDictionary](c.ListUserMembers, "1", ListUserMembersOpts{}),
+		NewPager[*TweetDictionary](c.TweetBookmarksLookup, "1", TweetBookmarksLookupOpts{}),
+		NewDMEventsPager(c, DMEventsLookupOpts{}),
+	}
+	for i, pager := range pagers {
+		if pager == nil {
+			t.Errorf("NewPager() %d = nil", i)
+		}
+	}
+}
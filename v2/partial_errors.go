@@ -0,0 +1,359 @@
+package twitter
+
+// PartialErrorer is a response that can have partial errors.  A request can succeed but some of the objects, like an
+// expanded author who is suspended, could not be returned and the reason is a partial error.
+type PartialErrorer interface {
+	PartialErrors() []*ErrorObj
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *Response[TData, TMeta]) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *ListRaw) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *SpacesRaw) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *TweetEditHistoryResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *TweetPollResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *TweetRaw) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *TweetSearchStreamAddRuleResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *TweetSearchStreamDeleteRuleResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *TweetSearchStreamRulesResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserFollowedListsRaw) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserListMembershipsRaw) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserListRaw) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserPinnedListsRaw) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserRaw) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserRetweetRaw) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Errors
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *ListLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *ListTweetLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *ListUserFollowersResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *ListUserMembersResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *QuoteTweetsLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *SpaceBuyersLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *SpaceTweetsLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *SpacesByCreatorLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *SpacesLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *SpacesSearchResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *TweetBookmarksLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *TweetLikesLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *TweetLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *TweetRecentSearchResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *TweetSearchResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserBlocksLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserFollowedListsResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserFollowersLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserFollowingLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserLikesLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserListLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserListMembershipsResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserMentionTimelineResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserMutesLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserPinnedListsResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserRetweetLookupResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserTweetReverseChronologicalTimelineResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
+
+// PartialErrors returns the partial errors of the response
+func (r *UserTweetTimelineResponse) PartialErrors() []*ErrorObj {
+	if r == nil {
+		return nil
+	}
+	return r.Raw.PartialErrors()
+}
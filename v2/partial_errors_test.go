@@ -0,0 +1,81 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPartialErrors(t *testing.T) {
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			body := `{
+				"data": [{"id": "1", "text": "hello", "author_id": "2"}],
+				"errors": [
+					{
+						"value": "2",
+						"detail": "User has been suspended: [2].",
+						"title": "Forbidden",
+						"resource_type": "user",
+						"parameter": "author_id",
+						"resource_id": "2",
+						"type": "https://api.twitter.com/2/problems/resource-not-found"
+					}
+				]
+			}`
+			if strings.HasSuffix(req.URL.Path, "tweets/1") {
+				body = strings.Replace(body, `[{"id": "1", "text": "hello", "author_id": "2"}]`, `{"id": "1", "text": "hello", "author_id": "2"}`, 1)
+			}
+			if strings.Contains(req.URL.Path, "trends") {
+				body = `{"data": [], "errors": [{"title": "Not Found Error", "resource_type": "trend"}]}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+
+	lookup, err := client.TweetLookup(context.Background(), []string{"1", "3"}, TweetLookupOpts{})
+	if err != nil {
+		t.Fatalf("Client.TweetLookup() error = %v", err)
+	}
+	history, err := client.TweetEditHistory(context.Background(), "1", TweetLookupOpts{})
+	if err != nil {
+		t.Fatalf("Client.TweetEditHistory() error = %v", err)
+	}
+	poll, err := client.RefreshPoll(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Client.RefreshPoll() error = %v", err)
+	}
+	trends, err := client.TrendsByWOEID(context.Background(), 1, TrendsByWOEIDOpts{})
+	if err != nil {
+		t.Fatalf("Client.TrendsByWOEID() error = %v", err)
+	}
+	responses := map[string]PartialErrorer{
+		"tweet lookup": lookup,
+		"edit history": history,
+		"poll":         poll,
+		"trends":       trends,
+	}
+	for name, resp := range responses {
+		if errs := resp.PartialErrors(); len(errs) != 1 {
+			t.Errorf("%s PartialErrors() = %v, want one error", name, errs)
+		}
+	}
+	if errs := lookup.PartialErrors(); errs[0].ResourceID != "2" || errs[0].Parameter != "author_id" {
+		t.Errorf("PartialErrors() = %+v", errs[0])
+	}
+
+	var empty *TweetLookupResponse
+	if errs := empty.PartialErrors(); errs != nil {
+		t.Errorf("PartialErrors() of a nil response = %v", errs)
+	}
+	if errs := (&UserLookupResponse{}).PartialErrors(); errs != nil {
+		t.Errorf("PartialErrors() of a response without raw = %v", errs)
+	}
+}
@@ -0,0 +1,135 @@
+package twitter
+
+// AllTweetFields returns the tweet fields that can be requested for any tweet.  The non public, organic and promoted
+// metrics are left out since they are only returned for the authorized user's own tweets, see MetricsTweetFields.
+func AllTweetFields() []TweetField {
+	return []TweetField{
+		TweetFieldID,
+		TweetFieldText,
+		TweetFieldAttachments,
+		TweetFieldAuthorID,
+		TweetFieldContextAnnotations,
+		TweetFieldConversationID,
+		TweetFieldCreatedAt,
+		TweetFieldEditControls,
+		TweetFieldEditHistoryTweetIDs,
+		TweetFieldEntities,
+		TweetFieldGeo,
+		TweetFieldInReplyToUserID,
+		TweetFieldLanguage,
+		TweetFieldPublicMetrics,
+		TweetFieldNoteTweet,
+		TweetFieldPossiblySensitve,
+		TweetFieldReferencedTweets,
+		TweetFieldSource,
+		TweetFieldWithHeld,
+	}
+}
+
+// MetricsTweetFields returns the tweet metric fields.  All but the public metrics require the user context of the
+// tweet's author.
+func MetricsTweetFields() []TweetField {
+	return []TweetField{
+		TweetFieldPublicMetrics,
+		TweetFieldNonPublicMetrics,
+		TweetFieldOrganicMetrics,
+		TweetFieldPromotedMetrics,
+	}
+}
+
+// DefaultTweetExpansions returns the expansions of a tweet's author, referenced tweets, mentions, media, polls and
+// places
+func DefaultTweetExpansions() []Expansion {
+	return []Expansion{
+		ExpansionAuthorID,
+		ExpansionReferencedTweetsID,
+		ExpansionReferencedTweetsIDAuthorID,
+		ExpansionInReplyToUserID,
+		ExpansionEntitiesMentionsUserName,
+		ExpansionAttachmentsMediaKeys,
+		ExpansionAttachmentsPollIDs,
+		ExpansionGeoPlaceID,
+	}
+}
+
+// AllUserFields returns all of the user fields
+func AllUserFields() []UserField {
+	return []UserField{
+		UserFieldCreatedAt,
+		UserFieldDescription,
+		UserFieldEntities,
+		UserFieldID,
+		UserFieldLocation,
+		UserFieldName,
+		UserFieldPinnedTweetID,
+		UserFieldProfileImageURL,
+		UserFieldProtected,
+		UserFieldPublicMetrics,
+		UserFieldURL,
+		UserFieldUserName,
+		UserFieldVerified,
+		UserFieldWithHeld,
+	}
+}
+
+// AllMediaFields returns the media fields that can be requested for any media, the private metrics are left out
+func AllMediaFields() []MediaField {
+	return []MediaField{
+		MediaFieldDurationMS,
+		MediaFieldHeight,
+		MediaFieldMediaKey,
+		MediaFieldPreviewImageURL,
+		MediaFieldType,
+		MediaFieldURL,
+		MediaFieldWidth,
+		MediaFieldPublicMetrics,
+		MediaFieldAltText,
+		MediaFieldVariants,
+	}
+}
+
+// AllPlaceFields returns all of the place fields
+func AllPlaceFields() []PlaceField {
+	return []PlaceField{
+		PlaceFieldContainedWithin,
+		PlaceFieldCountry,
+		PlaceFieldCountryCode,
+		PlaceFieldFullName,
+		PlaceFieldGeo,
+		PlaceFieldID,
+		PlaceFieldName,
+		PlaceFieldPlaceType,
+	}
+}
+
+// AllPollFields returns all of the poll fields
+func AllPollFields() []PollField {
+	return []PollField{
+		PollFieldDurationMinutes,
+		PollFieldEndDateTime,
+		PollFieldID,
+		PollFieldOptions,
+		PollFieldVotingStatus,
+	}
+}
+
+// MergeFields will combine the fields or expansions in order, leaving out the duplicates
+//
+//	opts := twitter.TweetRecentSearchOpts{
+//		TweetFields: twitter.MergeFields(twitter.AllTweetFields(), twitter.MetricsTweetFields()),
+//		Expansions:  twitter.MergeFields(twitter.DefaultTweetExpansions(), []twitter.Expansion{twitter.ExpansionPinnedTweetID}),
+//	}
+func MergeFields[T ~string](fields ...[]T) []T {
+	seen := map[T]bool{}
+	merged := []T{}
+	for _, list := range fields {
+		for _, field := range list {
+			if seen[field] {
+				continue
+			}
+			seen[field] = true
+			merged = append(merged, field)
+		}
+	}
+	return merged
+}
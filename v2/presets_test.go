@@ -0,0 +1,22 @@
+package twitter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeFields(t *testing.T) {
+	got := MergeFields(AllTweetFields(), MetricsTweetFields())
+	if len(got) != len(AllTweetFields())+3 {
+		t.Errorf("MergeFields() = %v, the public metrics should only be once", got)
+	}
+	if got[len(got)-1] != TweetFieldPromotedMetrics {
+		t.Errorf("MergeFields() = %v, the order should be kept", got)
+	}
+
+	expansions := MergeFields([]Expansion{ExpansionAuthorID}, DefaultTweetExpansions()[:2])
+	want := []Expansion{ExpansionAuthorID, ExpansionReferencedTweetsID}
+	if !reflect.DeepEqual(expansions, want) {
+		t.Errorf("MergeFields() = %v, want %v", expansions, want)
+	}
+}
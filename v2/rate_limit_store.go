@@ -0,0 +1,129 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// RateLimitStore will load and save the rate limiter's state, so short lived processes and restarted workers start with
+// the rate limits they have already used.  The key is the rate limiter's key of an endpoint and authorization and
+// should be treated as opaque.  A store can be shared by processes, like a file or Redis, with a user provided
+// implementation.
+type RateLimitStore interface {
+	Load(ctx context.Context) (map[string]RateLimit, error)
+	Save(ctx context.Context, key string, rl RateLimit) error
+}
+
+// MemoryRateLimitStore keeps the rate limits in memory
+type MemoryRateLimitStore struct {
+	mutex  sync.Mutex
+	limits map[string]RateLimit
+}
+
+// Load returns all of the rate limits
+func (m *MemoryRateLimitStore) Load(_ context.Context) (map[string]RateLimit, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	limits := make(map[string]RateLimit, len(m.limits))
+	for key, rl := range m.limits {
+		limits[key] = rl
+	}
+	return limits, nil
+}
+
+// Save will save the rate limit of the key
+func (m *MemoryRateLimitStore) Save(_ context.Context, key string, rl RateLimit) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.limits == nil {
+		m.limits = map[string]RateLimit{}
+	}
+	m.limits[key] = rl
+	return nil
+}
+
+// FileRateLimitStore keeps the rate limits in a JSON file.  The file is replaced on each save so that a crash does not
+// leave a partial file, and the rate limits that have reset are removed.
+type FileRateLimitStore struct {
+	Path  string
+	mutex sync.Mutex
+	now   func() time.Time
+}
+
+func (f *FileRateLimitStore) read() (map[string]RateLimit, error) {
+	limits := map[string]RateLimit{}
+	b, err := os.ReadFile(f.Path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return limits, nil
+	case err != nil:
+		return nil, fmt.Errorf("rate limit store read: %w", err)
+	}
+	if err := json.Unmarshal(b, &limits); err != nil {
+		return nil, fmt.Errorf("rate limit store decode %s: %w", f.Path, err)
+	}
+	return limits, nil
+}
+
+// Load returns all of the rate limits
+func (f *FileRateLimitStore) Load(_ context.Context) (map[string]RateLimit, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.read()
+}
+
+// Save will save the rate limit of the key
+func (f *FileRateLimitStore) Save(_ context.Context, key string, rl RateLimit) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	limits, err := f.read()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if f.now != nil {
+		now = f.now()
+	}
+	for k, limit := range limits {
+		if limit.expired(now) {
+			delete(limits, k)
+		}
+	}
+	limits[key] = rl
+	b, err := json.MarshalIndent(limits, "", "  ")
+	if err != nil {
+		return fmt.Errorf("rate limit store encode: %w", err)
+	}
+	if err := replaceFile(f.Path, b); err != nil {
+		return fmt.Errorf("rate limit store write: %w", err)
+	}
+	return nil
+}
+
+// expired is true when the limit and the daily limits have reset
+func (r RateLimit) expired(now time.Time) bool {
+	for _, limit := range []*RateLimit{&r, r.DailyApp, r.DailyUser} {
+		if limit != nil && limit.Reset.Time().After(now) {
+			return false
+		}
+	}
+	return true
+}
+
+// clone copies the limit with its daily limits, so the rate limiter and the store do not share them
+func (r RateLimit) clone() RateLimit {
+	if r.DailyApp != nil {
+		daily := *r.DailyApp
+		r.DailyApp = &daily
+	}
+	if r.DailyUser != nil {
+		daily := *r.DailyUser
+		r.DailyUser = &daily
+	}
+	return r
+}
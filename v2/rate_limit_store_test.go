@@ -0,0 +1,95 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Store(t *testing.T) {
+	now := time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "limits.json")
+	sent := 0
+	newClient := func() *Client {
+		return &Client{
+			Authorizer: &mockAuth{},
+			Host:       "https://www.test.com",
+			RateLimiter: &RateLimiter{
+				Store: &FileRateLimitStore{Path: path, now: func() time.Time { return now }},
+				now:   func() time.Time { return now },
+			},
+			Client: mockHTTPClient(func(req *http.Request) *http.Response {
+				sent++
+				header := http.Header{}
+				header.Add(rateLimit, "450")
+				header.Add(rateRemaining, strconv.Itoa(1-sent))
+				header.Add(rateReset, "1654081200")
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     header,
+					Body:       io.NopCloser(strings.NewReader(`{"data":[],"meta":{"result_count":0}}`)),
+				}
+			}),
+		}
+	}
+
+	if _, err := newClient().TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{}); err != nil {
+		t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+	}
+
+	restarted := newClient()
+	if _, err := restarted.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{}); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("Client.TweetRecentSearch() after restart error = %v, want rate limited", err)
+	}
+	if sent != 1 {
+		t.Errorf("Client.TweetRecentSearch() sent %d requests, want 1", sent)
+	}
+
+	now = now.Add(time.Hour)
+	if _, err := newClient().TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{}); err != nil {
+		t.Errorf("Client.TweetRecentSearch() after reset error = %v", err)
+	}
+}
+
+func TestFileRateLimitStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	store := &FileRateLimitStore{Path: filepath.Join(t.TempDir(), "limits.json"), now: func() time.Time { return now }}
+	if limits, err := store.Load(ctx); err != nil || len(limits) != 0 {
+		t.Fatalf("FileRateLimitStore.Load() = %v %v, want no limits", limits, err)
+	}
+
+	reset := Epoch(now.Add(time.Minute).Unix())
+	if err := store.Save(ctx, "GET 2/tweets/search/recent a", RateLimit{Limit: 450, Remaining: 0, Reset: reset}); err != nil {
+		t.Fatalf("FileRateLimitStore.Save() error = %v", err)
+	}
+	daily := RateLimit{Limit: 200, Reset: Epoch(now.Add(time.Minute).Unix()), DailyUser: &RateLimit{Limit: 50, Remaining: 3, Reset: Epoch(now.Add(20 * time.Hour).Unix())}}
+	if err := store.Save(ctx, "POST 2/tweets a", daily); err != nil {
+		t.Fatalf("FileRateLimitStore.Save() error = %v", err)
+	}
+
+	now = now.Add(time.Hour)
+	if err := store.Save(ctx, "GET 2/users/{id}/tweets a", RateLimit{Limit: 900, Remaining: 10, Reset: Epoch(now.Add(time.Minute).Unix())}); err != nil {
+		t.Fatalf("FileRateLimitStore.Save() error = %v", err)
+	}
+
+	limits, err := (&FileRateLimitStore{Path: store.Path}).Load(ctx)
+	if err != nil {
+		t.Fatalf("FileRateLimitStore.Load() error = %v", err)
+	}
+	if _, has := limits["GET 2/tweets/search/recent a"]; has {
+		t.Errorf("FileRateLimitStore.Load() has the limit that reset")
+	}
+	if rl, has := limits["POST 2/tweets a"]; !has || rl.DailyUser == nil || rl.DailyUser.Remaining != 3 {
+		t.Errorf("FileRateLimitStore.Load() daily limit = %+v", rl)
+	}
+	if len(limits) != 2 {
+		t.Errorf("FileRateLimitStore.Load() = %d limits, want 2", len(limits))
+	}
+}
@@ -0,0 +1,200 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is matched when twitter responded the request is rate limited, or the client's rate limiter will not send
+// a request
+var ErrRateLimited = errors.New("twitter request would exceed the rate limit")
+
+// RateLimitedError has the endpoint and rate limit of a request that was not sent
+type RateLimitedError struct {
+	Endpoint  string
+	RateLimit RateLimit
+}
+
+func (r *RateLimitedError) Error() string {
+	return fmt.Sprintf("%s: %s has %d of %d remaining, resets at %s", ErrRateLimited.Error(), r.Endpoint, r.RateLimit.Remaining, r.RateLimit.Limit, r.RateLimit.Reset.Time().Format(time.RFC3339))
+}
+
+// Is will match ErrRateLimited
+func (r *RateLimitedError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// RateLimiter will track the rate limits from the response headers for each endpoint and authorization.  When an
+// endpoint has no requests remaining, the next request will wait until the reset or, if Block is false or the wait
+// is longer than MaxWait, a *RateLimitedError is returned without sending the request.
+//
+// SpreadDaily will spread the requests of an endpoint with daily app or user limits evenly until the daily reset, instead
+// of using the daily limit in the first hour.
+//
+// Store will optionally persist the rate limits.  The limits are loaded before the first request and each observed
+// limit is saved, OnStoreError is called when a limit can not be saved.
+type RateLimiter struct {
+	Block        bool
+	MaxWait      time.Duration
+	SpreadDaily  bool
+	Store        RateLimitStore
+	OnStoreError func(err error)
+	mutex        sync.Mutex
+	loaded       bool
+	limits       map[string]*RateLimit
+	last         map[string]time.Time
+	now          func() time.Time
+}
+
+func (r *RateLimiter) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+func rateLimiterKey(req *http.Request) string {
+	h := fnv.New64a()
+	h.Write([]byte(req.Header.Get("Authorization")))
+	return fmt.Sprintf("%s %x", schemaEndpoint(req), h.Sum64())
+}
+
+// wait will wait, or return an error, if the request's endpoint has no requests remaining.  A request that is sent is
+// counted against the remaining requests until the response's rate limit is observed.
+func (r *RateLimiter) wait(req *http.Request) error {
+	if r == nil || req.URL == nil {
+		return nil
+	}
+	if err := r.load(req.Context()); err != nil {
+		return err
+	}
+	key := rateLimiterKey(req)
+	for {
+		r.mutex.Lock()
+		now := r.clock()
+		d, limited := r.delay(key, now)
+		if d <= 0 {
+			r.take(key, now)
+			r.mutex.Unlock()
+			return nil
+		}
+		r.mutex.Unlock()
+
+		if !r.Block || (r.MaxWait > 0 && d > r.MaxWait) {
+			return &RateLimitedError{
+				Endpoint:  schemaEndpoint(req),
+				RateLimit: limited,
+			}
+		}
+		if err := sleep(req.Context(), d); err != nil {
+			return err
+		}
+	}
+}
+
+// delay returns the wait before the request can be sent and the limit that is causing it
+func (r *RateLimiter) delay(key string, now time.Time) (time.Duration, RateLimit) {
+	rl, has := r.limits[key]
+	if !has {
+		return 0, RateLimit{}
+	}
+	var d time.Duration
+	var limited RateLimit
+	if rl.Reset.Time().After(now) && rl.Remaining <= 0 {
+		d = rl.Reset.Time().Sub(now)
+		limited = *rl
+	}
+	for _, daily := range []*RateLimit{rl.DailyApp, rl.DailyUser} {
+		if daily == nil || !daily.Reset.Time().After(now) {
+			continue
+		}
+		window := daily.Reset.Time().Sub(now)
+		wait := time.Duration(0)
+		switch {
+		case daily.Remaining <= 0:
+			wait = window
+		case r.SpreadDaily:
+			wait = r.last[key].Add(window / time.Duration(daily.Remaining)).Sub(now)
+		default:
+		}
+		if wait > d {
+			d = wait
+			limited = *daily
+		}
+	}
+	return d, limited
+}
+
+// take will count a sent request against the remaining requests
+func (r *RateLimiter) take(key string, now time.Time) {
+	if r.last == nil {
+		r.last = map[string]time.Time{}
+	}
+	r.last[key] = now
+	rl, has := r.limits[key]
+	if !has {
+		return
+	}
+	for _, limit := range []*RateLimit{rl, rl.DailyApp, rl.DailyUser} {
+		if limit != nil && limit.Reset.Time().After(now) {
+			limit.Remaining--
+		}
+	}
+}
+
+// observe will record the rate limit of the response
+func (r *RateLimiter) observe(req *http.Request, resp *http.Response) {
+	if r == nil {
+		return
+	}
+	rl := rateFromHeader(resp.Header)
+	if rl == nil {
+		return
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		rl.Remaining = 0
+	}
+	key := rateLimiterKey(req)
+	if r.Store != nil {
+		if err := r.Store.Save(req.Context(), key, rl.clone()); err != nil && r.OnStoreError != nil {
+			r.OnStoreError(fmt.Errorf("rate limiter save %s: %w", key, err))
+		}
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.limits == nil {
+		r.limits = map[string]*RateLimit{}
+	}
+	r.limits[key] = rl
+}
+
+// load will add the stored rate limits that have not reset, a limit that has already been observed is kept
+func (r *RateLimiter) load(ctx context.Context) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.loaded || r.Store == nil {
+		return nil
+	}
+	stored, err := r.Store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("rate limiter load: %w", err)
+	}
+	if r.limits == nil {
+		r.limits = map[string]*RateLimit{}
+	}
+	now := r.clock()
+	for key, rl := range stored {
+		if _, has := r.limits[key]; has || rl.expired(now) {
+			continue
+		}
+		rl := rl.clone()
+		r.limits[key] = &rl
+	}
+	r.loaded = true
+	return nil
+}
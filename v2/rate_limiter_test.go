@@ -0,0 +1,113 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_RateLimiter(t *testing.T) {
+	now := time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	limiter := &RateLimiter{
+		now: func() time.Time { return now },
+	}
+	sent := 0
+	client := &Client{
+		Authorizer:  &mockAuth{},
+		Host:        "https://www.test.com",
+		RateLimiter: limiter,
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			sent++
+			header := http.Header{}
+			header.Add(rateLimit, "450")
+			header.Add(rateRemaining, strconv.Itoa(2-sent))
+			header.Add(rateReset, "1654081200")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"data":[],"meta":{"result_count":0}}`)),
+			}
+		}),
+	}
+
+	search := func(ctx context.Context) error {
+		_, err := client.TweetRecentSearch(ctx, "golang", TweetRecentSearchOpts{})
+		return err
+	}
+	if err := search(context.Background()); err != nil {
+		t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+	}
+	if err := search(context.Background()); err != nil {
+		t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+	}
+	err := search(context.Background())
+	var limited *RateLimitedError
+	if !errors.Is(err, ErrRateLimited) || !errors.As(err, &limited) || limited.Endpoint != "GET 2/tweets/search/recent" {
+		t.Fatalf("Client.TweetRecentSearch() error = %v, want rate limited", err)
+	}
+	if sent != 2 {
+		t.Errorf("Client.TweetRecentSearch() sent %d requests, want 2", sent)
+	}
+
+	if _, err := client.UserMentionTimeline(context.Background(), "2244994945", UserMentionTimelineOpts{}); err != nil {
+		t.Errorf("Client.UserMentionTimeline() error = %v, other endpoints should not be limited", err)
+	}
+
+	limiter.Block = true
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := search(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Client.TweetRecentSearch() error = %v, want to wait until the context is done", err)
+	}
+
+	now = now.Add(time.Hour)
+	if err := search(context.Background()); err != nil {
+		t.Errorf("Client.TweetRecentSearch() after reset error = %v", err)
+	}
+}
+
+func TestClient_RateLimiter_spreadDaily(t *testing.T) {
+	now := time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	limiter := &RateLimiter{
+		SpreadDaily: true,
+		now:         func() time.Time { return now },
+	}
+	client := &Client{
+		Authorizer:  &mockAuth{},
+		Host:        "https://www.test.com",
+		RateLimiter: limiter,
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			header := http.Header{}
+			header.Add(dailyUserRateLimit, "100")
+			header.Add(dailyUserRateRemaining, "10")
+			header.Add(dailyUserRateReset, strconv.FormatInt(now.Add(10*time.Hour).Unix(), 10))
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"1","text":"hello"}}`)),
+			}
+		}),
+	}
+
+	create := func() error {
+		_, err := client.CreateTweet(context.Background(), CreateTweetRequest{Text: "hello"})
+		return err
+	}
+	if err := create(); err != nil {
+		t.Fatalf("Client.CreateTweet() error = %v", err)
+	}
+	err := create()
+	var limited *RateLimitedError
+	if !errors.As(err, &limited) || limited.RateLimit.Limit != 100 {
+		t.Fatalf("Client.CreateTweet() error = %v, want the daily limit to be spread", err)
+	}
+	now = now.Add(time.Hour)
+	if err := create(); err != nil {
+		t.Errorf("Client.CreateTweet() error = %v", err)
+	}
+}
@@ -1,9 +1,12 @@
 package twitter
 
 import (
+	"context"
 	"errors"
 	"net/http"
+	"sort"
 	"strconv"
+	"sync"
 	"time"
 )
 
@@ -11,6 +14,13 @@ const (
 	rateLimit     = "x-rate-limit-limit"
 	rateRemaining = "x-rate-limit-remaining"
 	rateReset     = "x-rate-limit-reset"
+
+	dailyAppRateLimit      = "x-app-limit-24hour-limit"
+	dailyAppRateRemaining  = "x-app-limit-24hour-remaining"
+	dailyAppRateReset      = "x-app-limit-24hour-reset"
+	dailyUserRateLimit     = "x-user-limit-24hour-limit"
+	dailyUserRateRemaining = "x-user-limit-24hour-remaining"
+	dailyUserRateReset     = "x-user-limit-24hour-reset"
 )
 
 // Epoch is the UNIX seconds from 1/1/1970
@@ -21,23 +31,56 @@ func (e Epoch) Time() time.Time {
 	return time.Unix(int64(e), 0)
 }
 
-// RateLimit are the rate limit values from the response header
+// RateLimit are the rate limit values from the response header.  Some endpoints, like creating a tweet, also have
+// daily limits for the app and the user.
 type RateLimit struct {
 	Limit     int
 	Remaining int
 	Reset     Epoch
+	DailyApp  *RateLimit
+	DailyUser *RateLimit
+}
+
+// Wait will wait until the reset or the context is done.  If the reset has passed, it returns right away.
+func (r *RateLimit) Wait(ctx context.Context) error {
+	if r == nil {
+		return ctx.Err()
+	}
+	return sleep(ctx, time.Until(r.Reset.Time()))
 }
 
 func rateFromHeader(header http.Header) *RateLimit {
-	limit, err := strconv.Atoi(header.Get(rateLimit))
+	rl := rateLimitFromHeader(header, rateLimit, rateRemaining, rateReset)
+	dailyApp := dailyAppRateFromHeader(header)
+	dailyUser := dailyUserRateFromHeader(header)
+	if rl == nil && (dailyApp != nil || dailyUser != nil) {
+		rl = &RateLimit{}
+	}
+	if rl != nil {
+		rl.DailyApp = dailyApp
+		rl.DailyUser = dailyUser
+	}
+	return rl
+}
+
+func dailyAppRateFromHeader(header http.Header) *RateLimit {
+	return rateLimitFromHeader(header, dailyAppRateLimit, dailyAppRateRemaining, dailyAppRateReset)
+}
+
+func dailyUserRateFromHeader(header http.Header) *RateLimit {
+	return rateLimitFromHeader(header, dailyUserRateLimit, dailyUserRateRemaining, dailyUserRateReset)
+}
+
+func rateLimitFromHeader(header http.Header, limitKey, remainingKey, resetKey string) *RateLimit {
+	limit, err := strconv.Atoi(header.Get(limitKey))
 	if err != nil {
 		return nil
 	}
-	remaining, err := strconv.Atoi(header.Get(rateRemaining))
+	remaining, err := strconv.Atoi(header.Get(remainingKey))
 	if err != nil {
 		return nil
 	}
-	reset, err := strconv.Atoi(header.Get(rateReset))
+	reset, err := strconv.Atoi(header.Get(resetKey))
 	if err != nil {
 		return nil
 	}
@@ -64,3 +107,60 @@ func RateLimitFromError(err error) (*RateLimit, bool) {
 	}
 	return nil, false
 }
+
+// EndpointRateLimit is the last rate limit seen for an endpoint, the endpoint is the method and path with the ids
+// replaced, like GET 2/users/{id}/tweets
+type EndpointRateLimit struct {
+	Endpoint  string
+	RateLimit RateLimit
+	Observed  time.Time
+}
+
+type rateLimitSnapshot struct {
+	mutex  sync.Mutex
+	limits map[string]EndpointRateLimit
+}
+
+func (r *rateLimitSnapshot) record(req *http.Request, resp *http.Response) {
+	rl := rateFromHeader(resp.Header)
+	if rl == nil {
+		return
+	}
+	endpoint := schemaEndpoint(req)
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.limits == nil {
+		r.limits = map[string]EndpointRateLimit{}
+	}
+	r.limits[endpoint] = EndpointRateLimit{
+		Endpoint:  endpoint,
+		RateLimit: *rl,
+		Observed:  time.Now(),
+	}
+}
+
+// RateLimits returns the last rate limit, including the daily limits, seen for each endpoint that has been called
+func (c *Client) RateLimits() []EndpointRateLimit {
+	c.rateLimits.mutex.Lock()
+	defer c.rateLimits.mutex.Unlock()
+	limits := make([]EndpointRateLimit, 0, len(c.rateLimits.limits))
+	for _, rl := range c.rateLimits.limits {
+		limits = append(limits, rl)
+	}
+	sort.Slice(limits, func(i, j int) bool {
+		return limits[i].Endpoint < limits[j].Endpoint
+	})
+	return limits
+}
+
+// notifyRateLimit will call the client's rate limit hook when the request was rate limited or the remaining requests
+// are below the threshold
+func (c *Client) notifyRateLimit(req *http.Request, resp *http.Response) {
+	if c.OnRateLimit == nil {
+		return
+	}
+	rl := rateFromHeader(resp.Header)
+	if resp.StatusCode == http.StatusTooManyRequests || (rl != nil && rl.Limit > 0 && rl.Remaining < c.RateLimitThreshold) {
+		c.OnRateLimit(schemaEndpoint(req), rl)
+	}
+}
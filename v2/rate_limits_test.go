@@ -1,10 +1,14 @@
 package twitter
 
 import (
+	"context"
 	"errors"
+	"io"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func Test_rateFromHeader(t *testing.T) {
@@ -33,6 +37,39 @@ func Test_rateFromHeader(t *testing.T) {
 				Reset:     Epoch(1644461060),
 			},
 		},
+		{
+			name: "daily",
+			args: args{
+				header: func() http.Header {
+					h := http.Header{}
+					h.Add(rateLimit, "200")
+					h.Add(rateRemaining, "199")
+					h.Add(rateReset, "1644461060")
+					h.Add(dailyAppRateLimit, "1667")
+					h.Add(dailyAppRateRemaining, "1600")
+					h.Add(dailyAppRateReset, "1644544000")
+					h.Add(dailyUserRateLimit, "100")
+					h.Add(dailyUserRateRemaining, "97")
+					h.Add(dailyUserRateReset, "1644544100")
+					return h
+				}(),
+			},
+			want: &RateLimit{
+				Limit:     200,
+				Remaining: 199,
+				Reset:     Epoch(1644461060),
+				DailyApp: &RateLimit{
+					Limit:     1667,
+					Remaining: 1600,
+					Reset:     Epoch(1644544000),
+				},
+				DailyUser: &RateLimit{
+					Limit:     100,
+					Remaining: 97,
+					Reset:     Epoch(1644544100),
+				},
+			},
+		},
 		{
 			name: "fail",
 			args: args{
@@ -146,3 +183,96 @@ func TestRateLimitFromError(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimit_Wait(t *testing.T) {
+	past := &RateLimit{
+		Reset: Epoch(time.Now().Add(-time.Minute).Unix()),
+	}
+	if err := past.Wait(context.Background()); err != nil {
+		t.Errorf("RateLimit.Wait() error = %v", err)
+	}
+
+	future := &RateLimit{
+		Reset: Epoch(time.Now().Add(time.Hour).Unix()),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := future.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("RateLimit.Wait() error = %v, want the context error", err)
+	}
+}
+
+func TestClient_RateLimits(t *testing.T) {
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			header := http.Header{}
+			header.Add(rateLimit, "15")
+			header.Add(rateRemaining, "12")
+			header.Add(rateReset, "1644461060")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"data":[],"meta":{"result_count":0}}`)),
+			}
+		}),
+	}
+	if _, err := client.UserMentionTimeline(context.Background(), "2244994945", UserMentionTimelineOpts{}); err != nil {
+		t.Fatalf("Client.UserMentionTimeline() error = %v", err)
+	}
+	if _, err := client.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{}); err != nil {
+		t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+	}
+
+	limits := client.RateLimits()
+	endpoints := []string{}
+	for _, rl := range limits {
+		endpoints = append(endpoints, rl.Endpoint)
+		if rl.RateLimit.Remaining != 12 || rl.Observed.IsZero() {
+			t.Errorf("Client.RateLimits() %s = %+v", rl.Endpoint, rl)
+		}
+	}
+	if want := []string{"GET 2/tweets/search/recent", "GET 2/users/{id}/mentions"}; !reflect.DeepEqual(endpoints, want) {
+		t.Errorf("Client.RateLimits() endpoints = %v, want %v", endpoints, want)
+	}
+}
+
+func TestClient_OnRateLimit(t *testing.T) {
+	remaining := []string{"10", "4", "0"}
+	sent := 0
+	called := []int{}
+	client := &Client{
+		Authorizer:         &mockAuth{},
+		Host:               "https://www.test.com",
+		RateLimitThreshold: 5,
+		OnRateLimit: func(endpoint string, rl *RateLimit) {
+			if endpoint != "GET 2/tweets/search/recent" {
+				t.Errorf("Client.OnRateLimit() endpoint = %s", endpoint)
+			}
+			called = append(called, rl.Remaining)
+		},
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			header := http.Header{}
+			header.Add(rateLimit, "15")
+			header.Add(rateRemaining, remaining[sent])
+			header.Add(rateReset, "1644461060")
+			status := http.StatusOK
+			if remaining[sent] == "0" {
+				status = http.StatusTooManyRequests
+			}
+			sent++
+			return &http.Response{
+				StatusCode: status,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"data":[],"meta":{"result_count":0}}`)),
+			}
+		}),
+	}
+	for range remaining {
+		client.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{})
+	}
+	if want := []int{4, 0}; !reflect.DeepEqual(called, want) {
+		t.Errorf("Client.OnRateLimit() called with %v, want %v", called, want)
+	}
+}
@@ -0,0 +1,69 @@
+package twitter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+)
+
+type rawJSONKey struct{}
+
+// RawJSON keeps the raw response bodies of the requests sent with its context, so the original payloads can be
+// archived or the fields that are not modeled yet can be parsed.  Stream responses are not kept.
+type RawJSON struct {
+	mutex  sync.Mutex
+	bodies []json.RawMessage
+}
+
+// WithRawJSON will return a context that keeps the raw response bodies of the requests sent with it
+func WithRawJSON(ctx context.Context) (context.Context, *RawJSON) {
+	raw := &RawJSON{}
+	return context.WithValue(ctx, rawJSONKey{}, raw), raw
+}
+
+// Bodies returns the response bodies in the order they were received
+func (r *RawJSON) Bodies() []json.RawMessage {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	bodies := make([]json.RawMessage, len(r.bodies))
+	copy(bodies, r.bodies)
+	return bodies
+}
+
+// Last returns the last response body, or nil if there are none
+func (r *RawJSON) Last() json.RawMessage {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(r.bodies) == 0 {
+		return nil
+	}
+	return r.bodies[len(r.bodies)-1]
+}
+
+// captureRawJSON will keep the response body if the request's context has a raw JSON, the body is replaced so it can
+// still be decoded
+func captureRawJSON(req *http.Request, resp *http.Response) {
+	raw, ok := req.Context().Value(rawJSONKey{}).(*RawJSON)
+	if !ok || resp == nil || resp.Body == nil || streamRequest(req) {
+		return
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body = readCloser{
+		Reader: io.MultiReader(bytes.NewReader(body), resp.Body),
+		Closer: resp.Body,
+	}
+	if err != nil {
+		return
+	}
+	raw.mutex.Lock()
+	defer raw.mutex.Unlock()
+	raw.bodies = append(raw.bodies, body)
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,50 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestWithRawJSON(t *testing.T) {
+	body := `{"data":{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev","new_field":"value"}}`
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+
+	ctx, raw := WithRawJSON(context.Background())
+	if raw.Last() != nil {
+		t.Errorf("RawJSON.Last() should be nil before a request")
+	}
+	resp, err := client.AuthUserLookup(ctx, UserLookupOpts{})
+	if err != nil {
+		t.Fatalf("Client.AuthUserLookup() error = %v", err)
+	}
+	if len(resp.Raw.Users) != 1 || resp.Raw.Users[0].UserName != "TwitterDev" {
+		t.Errorf("Client.AuthUserLookup() = %v, the body should still be decoded", resp.Raw.Users)
+	}
+	if _, err := client.AuthUserLookup(context.Background(), UserLookupOpts{}); err != nil {
+		t.Fatalf("Client.AuthUserLookup() error = %v", err)
+	}
+	if got := raw.Bodies(); len(got) != 1 || string(raw.Last()) != body {
+		t.Fatalf("RawJSON.Bodies() = %v, want only the request with the context", got)
+	}
+	extra := struct {
+		Data struct {
+			NewField string `json:"new_field"`
+		} `json:"data"`
+	}{}
+	if err := json.Unmarshal(raw.Last(), &extra); err != nil || extra.Data.NewField != "value" {
+		t.Errorf("RawJSON.Last() new field = %s %v", extra.Data.NewField, err)
+	}
+}
@@ -0,0 +1,152 @@
+package twitter
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Response is the envelope returned by the twitter v2 endpoints.  Endpoints return their primary
+// objects in data, any expanded objects in includes, partial errors in errors and endpoint specific
+// information, like pagination tokens, in meta.
+//
+// Newer endpoints return this envelope, aliased to the endpoint response name, instead of a
+// response struct per endpoint.
+type Response[TData any, TMeta any] struct {
+	Data         TData             `json:"data"`
+	Includes     *ResponseIncludes `json:"includes,omitempty"`
+	Errors       []*ErrorObj       `json:"errors,omitempty"`
+	Meta         TMeta             `json:"meta"`
+	RateLimit    *RateLimit        `json:"-"`
+	ResponseMeta *ResponseMeta     `json:"-"`
+}
+
+// ResponseMeta is the HTTP information of the response
+type ResponseMeta struct {
+	StatusCode int
+	Header     http.Header
+}
+
+// NoMeta is used for responses that do not return meta
+type NoMeta struct{}
+
+// ResponseIncludes are the expanded objects of a response
+type ResponseIncludes struct {
+	Tweets    []*TweetObj `json:"tweets,omitempty"`
+	Users     []*UserObj  `json:"users,omitempty"`
+	Places    []*PlaceObj `json:"places,omitempty"`
+	Media     []*MediaObj `json:"media,omitempty"`
+	Polls     []*PollObj  `json:"polls,omitempty"`
+	Topics    []*TopicObj `json:"topics,omitempty"`
+	tweetIDs  map[string]*TweetObj
+	userIDs   map[string]*UserObj
+	placeIDs  map[string]*PlaceObj
+	mediaKeys map[string]*MediaObj
+	pollIDs   map[string]*PollObj
+	topicIDs  map[string]*TopicObj
+}
+
+// TweetsByID will return a map of tweet ids to object
+func (r *ResponseIncludes) TweetsByID() map[string]*TweetObj {
+	if r.tweetIDs == nil {
+		r.tweetIDs = map[string]*TweetObj{}
+		for _, tweet := range r.Tweets {
+			r.tweetIDs[tweet.ID] = tweet
+		}
+	}
+	return r.tweetIDs
+}
+
+// UsersByID will return a map of user ids to object
+func (r *ResponseIncludes) UsersByID() map[string]*UserObj {
+	if r.userIDs == nil {
+		r.userIDs = map[string]*UserObj{}
+		for _, user := range r.Users {
+			r.userIDs[user.ID] = user
+		}
+	}
+	return r.userIDs
+}
+
+// PlacesByID will return a map of place ids to object
+func (r *ResponseIncludes) PlacesByID() map[string]*PlaceObj {
+	if r.placeIDs == nil {
+		r.placeIDs = map[string]*PlaceObj{}
+		for _, place := range r.Places {
+			r.placeIDs[place.ID] = place
+		}
+	}
+	return r.placeIDs
+}
+
+// MediaByKey will return a map of media keys to object
+func (r *ResponseIncludes) MediaByKey() map[string]*MediaObj {
+	if r.mediaKeys == nil {
+		r.mediaKeys = map[string]*MediaObj{}
+		for _, m := range r.Media {
+			r.mediaKeys[m.Key] = m
+		}
+	}
+	return r.mediaKeys
+}
+
+// PollsByID will return a map of poll ids to object
+func (r *ResponseIncludes) PollsByID() map[string]*PollObj {
+	if r.pollIDs == nil {
+		r.pollIDs = map[string]*PollObj{}
+		for _, poll := range r.Polls {
+			r.pollIDs[poll.ID] = poll
+		}
+	}
+	return r.pollIDs
+}
+
+// TopicsByID will return a map of topic ids to object
+func (r *ResponseIncludes) TopicsByID() map[string]*TopicObj {
+	if r.topicIDs == nil {
+		r.topicIDs = map[string]*TopicObj{}
+		for _, topic := range r.Topics {
+			r.topicIDs[topic.ID] = topic
+		}
+	}
+	return r.topicIDs
+}
+
+// decodeResponse will decode the response body into the envelope.  If the status code is not the expected
+// status, then an error response or HTTP error is returned.  In strict mode, unknown fields will fail the decoding.
+func decodeResponse[TData any, TMeta any](resp *http.Response, name string, status int, strict bool) (*Response[TData, TMeta], error) {
+	decoder := json.NewDecoder(resp.Body)
+
+	rl := rateFromHeader(resp.Header)
+
+	if resp.StatusCode != status {
+		e := &ErrorResponse{}
+		if err := decoder.Decode(e); err != nil {
+			return nil, &HTTPError{
+				Status:        resp.Status,
+				StatusCode:    resp.StatusCode,
+				URL:           resp.Request.URL.String(),
+				RateLimit:     rl,
+				TransactionID: transactionID(resp.Header),
+			}
+		}
+		e.StatusCode = resp.StatusCode
+		e.RateLimit = rl
+		e.TransactionID = transactionID(resp.Header)
+		return nil, e
+	}
+
+	respBody := &Response[TData, TMeta]{}
+	if err := decodeStrict(decoder, respBody, strict); err != nil {
+		return nil, &ResponseDecodeError{
+			Name:      name,
+			Err:       err,
+			RateLimit: rl,
+		}
+	}
+	respBody.RateLimit = rl
+	respBody.ResponseMeta = &ResponseMeta{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+	}
+	return respBody, nil
+}
@@ -0,0 +1,161 @@
+package twitter
+
+import (
+	"bytes"
+	"container/list"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// ResponseCache will keep the responses that have an ETag or Last-Modified header and send them as a conditional
+// request the next time.  When twitter responds that the resource has not been modified, the cached response is
+// decoded instead.  Only GET requests are cached, and they are keyed by the URL, which has the fields, and the
+// authorization, so users do not see each other's responses.
+//
+// MaxEntries is the number of responses kept, the least recently used response is removed when the cache is full.  It
+// defaults to one thousand.
+type ResponseCache struct {
+	MaxEntries int
+
+	mutex     sync.Mutex
+	responses map[string]*list.Element
+	order     *list.List
+}
+
+const responseCacheMaxEntries = 1000
+
+type cachedResponse struct {
+	key          string
+	etag         string
+	lastModified string
+	header       http.Header
+	body         []byte
+}
+
+// Len returns the number of cached responses
+func (r *ResponseCache) Len() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return len(r.responses)
+}
+
+// Clear will remove the cached responses
+func (r *ResponseCache) Clear() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.responses = nil
+	r.order = nil
+}
+
+func responseCacheKey(req *http.Request) string {
+	h := fnv.New64a()
+	h.Write([]byte(req.Header.Get("Authorization")))
+	return fmt.Sprintf("%s %x", req.URL.String(), h.Sum64())
+}
+
+func (r *ResponseCache) cached(req *http.Request) *cachedResponse {
+	if r == nil || req.Method != http.MethodGet {
+		return nil
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	element, has := r.responses[responseCacheKey(req)]
+	if !has {
+		return nil
+	}
+	r.order.MoveToFront(element)
+	return element.Value.(*cachedResponse)
+}
+
+// conditional will add the validators of the cached response to the request
+func (r *ResponseCache) conditional(req *http.Request) {
+	cached := r.cached(req)
+	if cached == nil {
+		return
+	}
+	if len(cached.etag) > 0 {
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+	if len(cached.lastModified) > 0 {
+		req.Header.Set("If-Modified-Since", cached.lastModified)
+	}
+}
+
+// response will return the cached response when the resource has not been modified, and cache a response that has
+// validators.  The body of a cached response is replaced so it can still be decoded.
+func (r *ResponseCache) response(req *http.Request, resp *http.Response) (*http.Response, error) {
+	if r == nil || req.Method != http.MethodGet {
+		return resp, nil
+	}
+	if resp.StatusCode == http.StatusNotModified {
+		cached := r.cached(req)
+		if cached == nil {
+			return resp, nil
+		}
+		resp.Body.Close()
+		header := cached.header.Clone()
+		for k, v := range resp.Header {
+			header[k] = v
+		}
+		return &http.Response{
+			Status:     fmt.Sprintf("%d %s", http.StatusOK, http.StatusText(http.StatusOK)),
+			StatusCode: http.StatusOK,
+			Proto:      resp.Proto,
+			ProtoMajor: resp.ProtoMajor,
+			ProtoMinor: resp.ProtoMinor,
+			Header:     header,
+			Body:       io.NopCloser(bytes.NewReader(cached.body)),
+			Request:    resp.Request,
+		}, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	lastModified := resp.Header.Get("Last-Modified")
+	if resp.StatusCode != http.StatusOK || (len(etag) == 0 && len(lastModified) == 0) {
+		return resp, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("response cache read: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r.store(&cachedResponse{
+		key:          responseCacheKey(req),
+		etag:         etag,
+		lastModified: lastModified,
+		header:       resp.Header.Clone(),
+		body:         body,
+	})
+	return resp, nil
+}
+
+// store will cache the response as the most recently used, removing the least recently used when the cache is full
+func (r *ResponseCache) store(cached *cachedResponse) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if r.responses == nil {
+		r.responses = map[string]*list.Element{}
+		r.order = list.New()
+	}
+	if element, has := r.responses[cached.key]; has {
+		element.Value = cached
+		r.order.MoveToFront(element)
+	} else {
+		r.responses[cached.key] = r.order.PushFront(cached)
+	}
+
+	maxEntries := r.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = responseCacheMaxEntries
+	}
+	for r.order.Len() > maxEntries {
+		oldest := r.order.Back()
+		r.order.Remove(oldest)
+		delete(r.responses, oldest.Value.(*cachedResponse).key)
+	}
+}
@@ -0,0 +1,116 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_ResponseCache(t *testing.T) {
+	sent := 0
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Cache:      &ResponseCache{},
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			sent++
+			header := http.Header{}
+			header.Add(rateLimit, "15")
+			header.Add(rateRemaining, "14")
+			header.Add(rateReset, "1644461060")
+			if req.Header.Get("If-None-Match") == `"v1"` {
+				header.Set(rateRemaining, "13")
+				return &http.Response{
+					StatusCode: http.StatusNotModified,
+					Header:     header,
+					Body:       io.NopCloser(strings.NewReader("")),
+				}
+			}
+			header.Add("ETag", `"v1"`)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev"}}`)),
+			}
+		}),
+	}
+
+	first, err := client.UserLookup(context.Background(), []string{"2244994945"}, UserLookupOpts{})
+	if err != nil {
+		t.Fatalf("Client.UserLookup() error = %v", err)
+	}
+	second, err := client.UserLookup(context.Background(), []string{"2244994945"}, UserLookupOpts{})
+	if err != nil {
+		t.Fatalf("Client.UserLookup() cached error = %v", err)
+	}
+	if sent != 2 || client.Cache.Len() != 1 {
+		t.Errorf("Client cache sent %d requests and has %d responses", sent, client.Cache.Len())
+	}
+	if !reflect.DeepEqual(first.Raw, second.Raw) {
+		t.Errorf("Client cached response = %+v, want %+v", second.Raw, first.Raw)
+	}
+	if second.RateLimit.Remaining != 13 {
+		t.Errorf("Client cached response should have the current rate limit, remaining %d", second.RateLimit.Remaining)
+	}
+
+	if _, err := client.UserLookup(context.Background(), []string{"2244994945"}, UserLookupOpts{UserFields: []UserField{UserFieldCreatedAt}}); err != nil {
+		t.Fatalf("Client.UserLookup() fields error = %v", err)
+	}
+	if client.Cache.Len() != 2 {
+		t.Errorf("Client cache should key the responses by the fields, has %d responses", client.Cache.Len())
+	}
+}
+
+func TestResponseCache_MaxEntries(t *testing.T) {
+	cache := &ResponseCache{MaxEntries: 2}
+	request := func(id string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, "https://www.test.com/2/users/"+id, nil)
+		if err != nil {
+			t.Fatalf("http.NewRequest() error = %v", err)
+		}
+		return req
+	}
+	store := func(id string) {
+		resp := &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Etag": []string{`"` + id + `"`}},
+			Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"` + id + `"}}`)),
+		}
+		if _, err := cache.response(request(id), resp); err != nil {
+			t.Fatalf("ResponseCache.response() error = %v", err)
+		}
+	}
+
+	store("1")
+	store("2")
+	// the first response is used, so the second is the least recently used
+	if cache.cached(request("1")) == nil {
+		t.Fatalf("ResponseCache should have the first response")
+	}
+	store("3")
+	if cache.Len() != 2 {
+		t.Errorf("ResponseCache.Len() = %d, want 2", cache.Len())
+	}
+	if cache.cached(request("2")) != nil {
+		t.Errorf("ResponseCache should have removed the least recently used response")
+	}
+
+	resp, err := cache.response(request("1"), &http.Response{
+		StatusCode: http.StatusNotModified,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+	})
+	if err != nil {
+		t.Fatalf("ResponseCache.response() not modified error = %v", err)
+	}
+	if resp.Status != "200 OK" || resp.StatusCode != http.StatusOK {
+		t.Errorf("ResponseCache.response() status = %s %d, want 200 OK", resp.Status, resp.StatusCode)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != `{"data":{"id":"1"}}` {
+		t.Errorf("ResponseCache.response() body = %s", body)
+	}
+}
@@ -0,0 +1,85 @@
+package twitter
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+const defaultMaxResponseSize = 32 * 1024 * 1024
+
+// ResponseTooLargeError is a response body that is larger than the client's max response size.  ContentLength is
+// the length the response declared, or -1 if it was not known before the body was read.
+type ResponseTooLargeError struct {
+	URL           string
+	Limit         int64
+	ContentLength int64
+}
+
+func (r *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("%s: the response of %s is larger than %d bytes", ErrResponseTooLarge.Error(), r.URL, r.Limit)
+}
+
+// Is will match ErrResponseTooLarge
+func (r *ResponseTooLargeError) Is(target error) bool {
+	return target == ErrResponseTooLarge
+}
+
+// limitedBody will fail the reads of a body past the limit, the error is returned by every read after
+type limitedBody struct {
+	body      io.ReadCloser
+	remaining int64
+	tooLarge  error
+	err       error
+}
+
+func (l *limitedBody) Read(p []byte) (int, error) {
+	if l.err != nil {
+		return 0, l.err
+	}
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.body.Read(p)
+	if int64(n) > l.remaining {
+		n = int(l.remaining)
+		l.remaining = 0
+		l.err = l.tooLarge
+		return n, l.err
+	}
+	l.remaining -= int64(n)
+	return n, err
+}
+
+func (l *limitedBody) Close() error {
+	return l.body.Close()
+}
+
+func (c *Client) maxResponseSize() int64 {
+	if c.MaxResponseSize != 0 {
+		return c.MaxResponseSize
+	}
+	return defaultMaxResponseSize
+}
+
+// limitResponse will replace the response body with one that fails past the max response size, so every decoder
+// reads at most the limit.  Streams are not limited.
+func (c *Client) limitResponse(req *http.Request, resp *http.Response) {
+	limit := c.maxResponseSize()
+	if limit < 0 || resp.Body == nil || streamRequest(req) {
+		return
+	}
+	body := &limitedBody{
+		body:      resp.Body,
+		remaining: limit,
+		tooLarge: &ResponseTooLargeError{
+			URL:           req.URL.String(),
+			Limit:         limit,
+			ContentLength: resp.ContentLength,
+		},
+	}
+	if resp.ContentLength > limit {
+		body.err = body.tooLarge
+	}
+	resp.Body = body
+}
@@ -0,0 +1,120 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_MaxResponseSize(t *testing.T) {
+	body := `{"data":[{"id":"1","text":"hello"},{"id":"2","text":"hello"}],"meta":{"result_count":2}}`
+	contentLength := int64(-1)
+	newClient := func(maxSize int64, strict bool) *Client {
+		return &Client{
+			Authorizer:      &mockAuth{},
+			Host:            "https://www.test.com",
+			MaxResponseSize: maxSize,
+			Strict:          strict,
+			Client: mockHTTPClient(func(req *http.Request) *http.Response {
+				return &http.Response{
+					StatusCode:    http.StatusOK,
+					Body:          io.NopCloser(strings.NewReader(body)),
+					Header:        responseTestHeader(),
+					ContentLength: contentLength,
+				}
+			}),
+		}
+	}
+
+	tests := []struct {
+		name          string
+		maxSize       int64
+		strict        bool
+		contentLength int64
+		wantErr       bool
+	}{
+		{
+			name: "default",
+		},
+		{
+			name:    "under the limit",
+			maxSize: int64(len(body)),
+		},
+		{
+			name:    "no limit",
+			maxSize: -1,
+		},
+		{
+			name:    "over the limit",
+			maxSize: 32,
+			wantErr: true,
+		},
+		{
+			name:    "over the limit strict",
+			maxSize: 32,
+			strict:  true,
+			wantErr: true,
+		},
+		{
+			name:          "content length over the limit",
+			maxSize:       32,
+			contentLength: int64(len(body)),
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contentLength = tt.contentLength
+			if contentLength == 0 {
+				contentLength = -1
+			}
+			got, err := newClient(tt.maxSize, tt.strict).TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{})
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+				}
+				if len(got.Raw.Tweets) != 2 {
+					t.Errorf("Client.TweetRecentSearch() tweets = %d, want 2", len(got.Raw.Tweets))
+				}
+				return
+			}
+			tooLarge := &ResponseTooLargeError{}
+			if !errors.Is(err, ErrResponseTooLarge) || !errors.As(err, &tooLarge) {
+				t.Fatalf("Client.TweetRecentSearch() error = %v, want %v", err, ErrResponseTooLarge)
+			}
+			if tooLarge.Limit != tt.maxSize || tooLarge.ContentLength != contentLength {
+				t.Errorf("Client.TweetRecentSearch() error = %+v", tooLarge)
+			}
+		})
+	}
+}
+
+func TestClient_MaxResponseSizeStream(t *testing.T) {
+	c := &Client{
+		Authorizer:      &mockAuth{},
+		Host:            "https://www.test.com",
+		MaxResponseSize: 16,
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"id":"1","text":"hello"}}` + "\r\n")),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+
+	stream, err := c.TweetSampleStream(context.Background(), TweetSampleStreamOpts{})
+	if err != nil {
+		t.Fatalf("Client.TweetSampleStream() error = %v", err)
+	}
+	defer stream.Close()
+	select {
+	case <-stream.Tweets():
+	case <-time.After(time.Second):
+		t.Errorf("Client.TweetSampleStream() the stream was limited")
+	}
+}
@@ -0,0 +1,150 @@
+package twitter
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_decodeResponse(t *testing.T) {
+	type data struct {
+		ID string `json:"id"`
+	}
+	type meta struct {
+		ResultCount int `json:"result_count"`
+	}
+	tests := []struct {
+		name    string
+		resp    *http.Response
+		want    *Response[[]*data, *meta]
+		wantErr error
+	}{
+		{
+			name: "success",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body: io.NopCloser(strings.NewReader(`{
+					"data":[{"id":"1"}],
+					"includes":{"users":[{"id":"2","name":"name","username":"username"}]},
+					"errors":[{"title":"Not Found Error","value":"3"}],
+					"meta":{"result_count":1}
+				}`)),
+			},
+			want: &Response[[]*data, *meta]{
+				Data: []*data{{ID: "1"}},
+				Includes: &ResponseIncludes{
+					Users: []*UserObj{{ID: "2", Name: "name", UserName: "username"}},
+				},
+				Errors: []*ErrorObj{{Title: "Not Found Error", Value: "3"}},
+				Meta:   &meta{ResultCount: 1},
+				ResponseMeta: &ResponseMeta{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{},
+				},
+			},
+		},
+		{
+			name: "error response",
+			resp: &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"title":"Invalid Request","detail":"bad"}`)),
+			},
+			wantErr: &ErrorResponse{},
+		},
+		{
+			name: "http error",
+			resp: &http.Response{
+				StatusCode: http.StatusNotFound,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`<html></html>`)),
+				Request:    httptestRequest(),
+			},
+			wantErr: &HTTPError{},
+		},
+		{
+			name: "decode error",
+			resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"data":`)),
+			},
+			wantErr: &ResponseDecodeError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeResponse[[]*data, *meta](tt.resp, "test", http.StatusOK, false)
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if err != nil {
+					t.Fatalf("decodeResponse() error = %v", err)
+				}
+			case *ErrorResponse:
+				if !errors.As(err, &want) {
+					t.Fatalf("decodeResponse() error = %v, want %T", err, tt.wantErr)
+				}
+			case *HTTPError:
+				if !errors.As(err, &want) {
+					t.Fatalf("decodeResponse() error = %v, want %T", err, tt.wantErr)
+				}
+			case *ResponseDecodeError:
+				if !errors.As(err, &want) {
+					t.Fatalf("decodeResponse() error = %v, want %T", err, tt.wantErr)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodeResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func httptestRequest() *http.Request {
+	req, _ := http.NewRequest(http.MethodGet, "https://www.test.com", nil)
+	return req
+}
+
+func responseTestHeader() http.Header {
+	h := http.Header{}
+	h.Add(rateLimit, "15")
+	h.Add(rateRemaining, "12")
+	h.Add(rateReset, "1644461060")
+	return h
+}
+
+func TestResponseIncludes_lookups(t *testing.T) {
+	includes := &ResponseIncludes{
+		Tweets: []*TweetObj{{ID: "1"}},
+		Users:  []*UserObj{{ID: "2"}},
+		Places: []*PlaceObj{{ID: "3"}},
+		Media:  []*MediaObj{{Key: "4"}},
+		Polls:  []*PollObj{{ID: "5"}},
+		Topics: []*TopicObj{{ID: "6"}},
+	}
+	if got := includes.TweetsByID()["1"]; got != includes.Tweets[0] {
+		t.Errorf("ResponseIncludes.TweetsByID() = %v", got)
+	}
+	if got := includes.UsersByID()["2"]; got != includes.Users[0] {
+		t.Errorf("ResponseIncludes.UsersByID() = %v", got)
+	}
+	if got := includes.PlacesByID()["3"]; got != includes.Places[0] {
+		t.Errorf("ResponseIncludes.PlacesByID() = %v", got)
+	}
+	if got := includes.MediaByKey()["4"]; got != includes.Media[0] {
+		t.Errorf("ResponseIncludes.MediaByKey() = %v", got)
+	}
+	if got := includes.PollsByID()["5"]; got != includes.Polls[0] {
+		t.Errorf("ResponseIncludes.PollsByID() = %v", got)
+	}
+	if got := includes.TopicsByID()["6"]; got != includes.Topics[0] {
+		t.Errorf("ResponseIncludes.TopicsByID() = %v", got)
+	}
+	if _, has := includes.UsersByID()["7"]; has {
+		t.Errorf("ResponseIncludes.UsersByID() has an unknown id")
+	}
+}
@@ -0,0 +1,161 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+const (
+	retryDefaultMaxAttempts = 3
+	retryDefaultBackoff     = time.Second
+	retryDefaultMaxBackoff  = 30 * time.Second
+)
+
+// RetryPolicy will send a request again when the response has a retryable status code or there is a transient network
+// error.  The backoff doubles with each attempt, up to the max backoff, and is randomized by the jitter fraction.  A rate
+// limited response with a reset will wait until the reset, if the wait is longer than the max backoff the response is
+// returned instead.
+//
+// Only GET, PUT and DELETE requests are retried for server and network errors, unless RetryAllMethods is set, since the
+// other requests may have been processed.  Rate limited requests are retried for all methods.
+type RetryPolicy struct {
+	// MaxAttempts is the max number of times a request is sent, defaults to 3
+	MaxAttempts int
+	// Backoff is the wait before the first retry, defaults to one second
+	Backoff time.Duration
+	// MaxBackoff is the max wait before a retry, defaults to 30 seconds
+	MaxBackoff time.Duration
+	// Jitter is the fraction of the backoff that is randomized, from 0 to 1
+	Jitter float64
+	// StatusCodes are the retryable status codes, defaults to 429, 500, 502, 503 and 504
+	StatusCodes     []int
+	RetryAllMethods bool
+}
+
+var retryDefaultStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+func (r *RetryPolicy) retryable(statusCode int) bool {
+	codes := r.StatusCodes
+	if len(codes) == 0 {
+		codes = retryDefaultStatusCodes
+	}
+	for _, code := range codes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// next will return the wait before the next attempt and if the request should be retried
+func (r *RetryPolicy) next(req *http.Request, attempt int, resp *http.Response, err error) (time.Duration, bool) {
+	if r == nil || !replayable(req) {
+		return 0, false
+	}
+	maxAttempts := r.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = retryDefaultMaxAttempts
+	}
+	if attempt >= maxAttempts {
+		return 0, false
+	}
+	idempotent := r.RetryAllMethods
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		idempotent = true
+	default:
+	}
+
+	maxBackoff := r.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = retryDefaultMaxBackoff
+	}
+	switch {
+	case err != nil:
+		if !idempotent || !transientError(err) {
+			return 0, false
+		}
+	case !r.retryable(resp.StatusCode):
+		return 0, false
+	case resp.StatusCode == http.StatusTooManyRequests:
+		if rl := rateFromHeader(resp.Header); rl != nil {
+			d := time.Until(rl.Reset.Time())
+			if d > maxBackoff {
+				return 0, false
+			}
+			return r.jitter(d), true
+		}
+	case !idempotent:
+		return 0, false
+	default:
+	}
+
+	backoff := r.Backoff
+	if backoff <= 0 {
+		backoff = retryDefaultBackoff
+	}
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return r.jitter(backoff), true
+}
+
+func (r *RetryPolicy) jitter(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	if r.Jitter <= 0 {
+		return d
+	}
+	j := r.Jitter
+	if j > 1 {
+		j = 1
+	}
+	return d + time.Duration(rand.Float64()*j*float64(d))
+}
+
+// transientError returns true for network errors that may succeed if the request is sent again
+func transientError(err error) bool {
+	var netErr net.Error
+	switch {
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return false
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return true
+	case errors.Is(err, io.ErrUnexpectedEOF), errors.Is(err, io.EOF),
+		errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.ECONNREFUSED), errors.Is(err, syscall.EPIPE):
+		return true
+	default:
+		return false
+	}
+}
+
+// sleep will wait for the duration or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,145 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+type errRoundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f errRoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_Retry(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []int
+		post      bool
+		wantSent  int
+		wantErr   bool
+	}{
+		{
+			name:      "server errors then success",
+			responses: []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			wantSent:  3,
+		},
+		{
+			name:      "max attempts",
+			responses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			wantSent:  3,
+			wantErr:   true,
+		},
+		{
+			name:      "network error",
+			responses: []int{0, http.StatusOK},
+			wantSent:  2,
+		},
+		{
+			name:      "not retryable",
+			responses: []int{http.StatusBadRequest, http.StatusOK},
+			wantSent:  1,
+			wantErr:   true,
+		},
+		{
+			name:      "post server error",
+			responses: []int{http.StatusServiceUnavailable, http.StatusCreated},
+			post:      true,
+			wantSent:  1,
+			wantErr:   true,
+		},
+		{
+			name:      "post rate limited",
+			responses: []int{http.StatusTooManyRequests, http.StatusCreated},
+			post:      true,
+			wantSent:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := 0
+			client := &Client{
+				Authorizer: &mockAuth{},
+				Host:       "https://www.test.com",
+				Retry: &RetryPolicy{
+					Backoff: time.Millisecond,
+					Jitter:  0.5,
+				},
+				Client: &http.Client{
+					Transport: errRoundTripFunc(func(req *http.Request) (*http.Response, error) {
+						status := tt.responses[sent]
+						sent++
+						if status == 0 {
+							return nil, syscall.ECONNRESET
+						}
+						body := `{"data":[],"meta":{"result_count":0}}`
+						if tt.post {
+							if b, _ := io.ReadAll(req.Body); !strings.Contains(string(b), "hello") {
+								t.Errorf("the retry body is not correct %s", string(b))
+							}
+							body = `{"data":{"id":"1","text":"hello"}}`
+						}
+						if status >= http.StatusBadRequest {
+							body = `{"title":"Error","detail":"Error","type":"about:blank","status":500}`
+						}
+						return &http.Response{
+							StatusCode: status,
+							Header:     http.Header{},
+							Body:       io.NopCloser(strings.NewReader(body)),
+						}, nil
+					}),
+				},
+			}
+			var err error
+			if tt.post {
+				_, err = client.CreateTweet(context.Background(), CreateTweetRequest{Text: "hello"})
+			} else {
+				_, err = client.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{})
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Client retry error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if sent != tt.wantSent {
+				t.Errorf("Client retry sent %d, want %d", sent, tt.wantSent)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_next(t *testing.T) {
+	policy := &RetryPolicy{
+		Backoff:     time.Second,
+		MaxBackoff:  3 * time.Second,
+		MaxAttempts: 5,
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://api.twitter.com/2/users/me", nil)
+	resp := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second} {
+		if got, retry := policy.next(req, attempt+1, resp, nil); !retry || got != want {
+			t.Errorf("RetryPolicy.next(%d) = %v %v, want %v", attempt+1, got, retry, want)
+		}
+	}
+	if _, retry := policy.next(req, 5, resp, nil); retry {
+		t.Errorf("RetryPolicy.next() should not retry after the max attempts")
+	}
+	if _, retry := policy.next(req, 1, nil, context.Canceled); retry {
+		t.Errorf("RetryPolicy.next() should not retry a canceled context")
+	}
+
+	resp = &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	resp.Header.Add(rateLimit, "15")
+	resp.Header.Add(rateRemaining, "0")
+	resp.Header.Add(rateReset, "1")
+	if got, retry := policy.next(req, 1, resp, nil); !retry || got != 0 {
+		t.Errorf("RetryPolicy.next() = %v %v, want to retry after the past reset", got, retry)
+	}
+	resp.Header.Set(rateReset, "9999999999")
+	if _, retry := policy.next(req, 1, resp, nil); retry {
+		t.Errorf("RetryPolicy.next() should not wait past the max backoff")
+	}
+}
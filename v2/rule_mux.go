@@ -0,0 +1,117 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// RuleHandler will handle the stream tweets that matched a rule
+type RuleHandler interface {
+	HandleTweet(ctx context.Context, msg *TweetMessage) error
+}
+
+// RuleHandlerFunc is a function that can be used as a rule handler
+type RuleHandlerFunc func(ctx context.Context, msg *TweetMessage) error
+
+// HandleTweet will call the function
+func (f RuleHandlerFunc) HandleTweet(ctx context.Context, msg *TweetMessage) error {
+	return f(ctx, msg)
+}
+
+// RuleMux will route the filtered stream tweets to the handlers of their matching rule tags.  A tweet that matched
+// more than one tag is handled by each tag's handler, and a tweet without a tag that has a handler is handled by the
+// default handler, if there is one.  The mux is safe for concurrent use.
+//
+//	mux := &twitter.RuleMux{}
+//	mux.HandleFunc("tenant-a", func(ctx context.Context, msg *twitter.TweetMessage) error {
+//		...
+//	})
+//	err := mux.Serve(ctx, stream)
+type RuleMux struct {
+	// Default handles the tweets that did not match a tag with a handler
+	Default RuleHandler
+
+	mutex    sync.RWMutex
+	handlers map[string]RuleHandler
+}
+
+// Handle will register the handler of the tag, replacing any previous handler
+func (m *RuleMux) Handle(tag string, handler RuleHandler) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.handlers == nil {
+		m.handlers = map[string]RuleHandler{}
+	}
+	m.handlers[tag] = handler
+}
+
+// HandleFunc will register the function as the handler of the tag
+func (m *RuleMux) HandleFunc(tag string, handler func(ctx context.Context, msg *TweetMessage) error) {
+	m.Handle(tag, RuleHandlerFunc(handler))
+}
+
+// HandleTweet will route the tweet to the handlers of its matching rule tags.  All of the handlers are called and the
+// first error is returned.
+func (m *RuleMux) HandleTweet(ctx context.Context, msg *TweetMessage) error {
+	handlers := m.route(msg)
+	if len(handlers) == 0 {
+		if m.Default == nil {
+			return nil
+		}
+		if err := m.Default.HandleTweet(ctx, msg); err != nil {
+			return fmt.Errorf("rule mux default handler: %w", err)
+		}
+		return nil
+	}
+
+	var first error
+	for _, route := range handlers {
+		if err := route.handler.HandleTweet(ctx, msg); err != nil && first == nil {
+			first = fmt.Errorf("rule mux handler %s: %w", route.tag, err)
+		}
+	}
+	return first
+}
+
+// Serve will route the stream's tweets until the stream is closed, the context is done or a handler returns an error
+func (m *RuleMux) Serve(ctx context.Context, stream *TweetStream) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-stream.Tweets():
+			if !ok {
+				return nil
+			}
+			if err := m.HandleTweet(ctx, msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type ruleRoute struct {
+	tag     string
+	handler RuleHandler
+}
+
+func (m *RuleMux) route(msg *TweetMessage) []ruleRoute {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	routes := []ruleRoute{}
+	seen := map[string]bool{}
+	for _, rule := range msg.MatchingRules {
+		if rule == nil || seen[rule.Tag] {
+			continue
+		}
+		seen[rule.Tag] = true
+		if handler, has := m.handlers[rule.Tag]; has {
+			routes = append(routes, ruleRoute{
+				tag:     rule.Tag,
+				handler: handler,
+			})
+		}
+	}
+	return routes
+}
@@ -0,0 +1,45 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRuleMux(t *testing.T) {
+	handled := []string{}
+	handler := func(name string) func(ctx context.Context, msg *TweetMessage) error {
+		return func(ctx context.Context, msg *TweetMessage) error {
+			handled = append(handled, name+":"+msg.Raw.Tweets[0].ID)
+			return nil
+		}
+	}
+	mux := &RuleMux{Default: RuleHandlerFunc(handler("default"))}
+	mux.HandleFunc("golang", handler("golang"))
+	mux.HandleFunc("gophers", handler("gophers"))
+
+	body := `{"data":{"id":"1","text":"hello"},"matching_rules":[{"id":"10","tag":"golang"},{"id":"11","tag":"gophers"}]}` + "\r\n"
+	body += `{"data":{"id":"2","text":"hello"},"matching_rules":[{"id":"12","tag":"rust"}]}` + "\r\n"
+	body += `{"data":{"id":"3","text":"hello"},"matching_rules":[{"id":"11","tag":"gophers"}]}` + "\r\n"
+	stream := StartTweetStreamWithOpts(io.NopCloser(strings.NewReader(body)), TweetStreamOpts{StallTimeout: 50 * time.Millisecond})
+	if err := mux.Serve(context.Background(), stream); err != nil {
+		t.Fatalf("RuleMux.Serve() error = %v", err)
+	}
+	want := []string{"golang:1", "gophers:1", "default:2", "gophers:3"}
+	if !reflect.DeepEqual(handled, want) {
+		t.Errorf("RuleMux.Serve() = %v, want %v", handled, want)
+	}
+
+	failed := errors.New("failed")
+	mux.HandleFunc("golang", func(ctx context.Context, msg *TweetMessage) error {
+		return failed
+	})
+	msg := &TweetMessage{MatchingRules: []*TweetStreamMatchingRule{{ID: "10", Tag: "golang"}}}
+	if err := mux.HandleTweet(context.Background(), msg); !errors.Is(err, failed) {
+		t.Errorf("RuleMux.HandleTweet() error = %v, want the handler error", err)
+	}
+}
@@ -0,0 +1,204 @@
+package twitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// SchemaKey is when a JSON key was seen in an endpoint's responses
+type SchemaKey struct {
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+}
+
+// SchemaDrift are the keys of an endpoint that have appeared or disappeared
+type SchemaDrift struct {
+	Endpoint    string
+	Appeared    []string
+	Disappeared []string
+}
+
+// SchemaRecorder will record the JSON keys seen in the responses of each endpoint.  It can be saved and loaded between
+// runs so that keys that twitter adds or removes can be reported.  The keys are paths, like data[].public_metrics.like_count,
+// and the endpoint is the method and path with the ids replaced, like GET 2/users/{id}/tweets.
+//
+// Many fields are optional, so a disappeared key is only a hint that should be checked against the fields requested.
+type SchemaRecorder struct {
+	mutex     sync.Mutex
+	endpoints map[string]map[string]*SchemaKey
+	seen      map[string]time.Time
+	now       func() time.Time
+}
+
+func (s *SchemaRecorder) clock() time.Time {
+	if s.now != nil {
+		return s.now()
+	}
+	return time.Now()
+}
+
+// Load will load the keys saved from an earlier run
+func (s *SchemaRecorder) Load(r io.Reader) error {
+	endpoints := map[string]map[string]*SchemaKey{}
+	if err := json.NewDecoder(r).Decode(&endpoints); err != nil {
+		return fmt.Errorf("schema recorder load: %w", err)
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.endpoints = endpoints
+	return nil
+}
+
+// Save will write the keys as JSON
+func (s *SchemaRecorder) Save(w io.Writer) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(s.endpoints); err != nil {
+		return fmt.Errorf("schema recorder save: %w", err)
+	}
+	return nil
+}
+
+// Record will record the keys of a JSON response body for the endpoint
+func (s *SchemaRecorder) Record(endpoint string, body []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Errorf("schema recorder: %w", err)
+	}
+	keys := map[string]struct{}{}
+	schemaKeys("", v, keys)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.endpoints == nil {
+		s.endpoints = map[string]map[string]*SchemaKey{}
+	}
+	if s.seen == nil {
+		s.seen = map[string]time.Time{}
+	}
+	now := s.clock()
+	known, has := s.endpoints[endpoint]
+	if !has {
+		known = map[string]*SchemaKey{}
+		s.endpoints[endpoint] = known
+	}
+	for key := range keys {
+		k, has := known[key]
+		if !has {
+			k = &SchemaKey{
+				FirstSeen: now,
+			}
+			known[key] = k
+		}
+		k.LastSeen = now
+	}
+	s.seen[endpoint] = now
+	return nil
+}
+
+// Drift will report the keys that first appeared after since, and the keys that have not been seen since then for
+// the endpoints that have been recorded after since.
+func (s *SchemaRecorder) Drift(since time.Time) []SchemaDrift {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	drifts := []SchemaDrift{}
+	for endpoint, keys := range s.endpoints {
+		last, has := s.seen[endpoint]
+		if !has || last.Before(since) {
+			continue
+		}
+		drift := SchemaDrift{
+			Endpoint:    endpoint,
+			Appeared:    []string{},
+			Disappeared: []string{},
+		}
+		for key, k := range keys {
+			switch {
+			case !k.FirstSeen.Before(since):
+				drift.Appeared = append(drift.Appeared, key)
+			case k.LastSeen.Before(since):
+				drift.Disappeared = append(drift.Disappeared, key)
+			default:
+			}
+		}
+		if len(drift.Appeared) == 0 && len(drift.Disappeared) == 0 {
+			continue
+		}
+		sort.Strings(drift.Appeared)
+		sort.Strings(drift.Disappeared)
+		drifts = append(drifts, drift)
+	}
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].Endpoint < drifts[j].Endpoint
+	})
+	return drifts
+}
+
+func schemaKeys(prefix string, v interface{}, keys map[string]struct{}) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, child := range value {
+			key := k
+			if len(prefix) > 0 {
+				key = prefix + "." + k
+			}
+			keys[key] = struct{}{}
+			schemaKeys(key, child, keys)
+		}
+	case []interface{}:
+		for _, child := range value {
+			schemaKeys(prefix+"[]", child, keys)
+		}
+	default:
+	}
+}
+
+// schemaEndpoint will replace the ids of the request path so the responses of an endpoint are recorded together
+func schemaEndpoint(req *http.Request) string {
+	segments := pathSegments(req.URL.Path)
+	for i, seg := range segments {
+		switch {
+		case i > 0 && segments[i-1] == "username":
+			segments[i] = "{username}"
+		case i > 0 && strings.IndexFunc(seg, unicode.IsDigit) >= 0:
+			segments[i] = idTag
+		default:
+		}
+	}
+	return req.Method + " " + strings.Join(segments, "/")
+}
+
+// streamRequest returns true if the request is for a stream, which can not be read before it is decoded
+func streamRequest(req *http.Request) bool {
+	segments := pathSegments(req.URL.Path)
+	return len(segments) > 0 && segments[len(segments)-1] == "stream"
+}
+
+// recordSchema will record the keys of a successful JSON response, the body is replaced so it can still be decoded.
+// Streams are not recorded.
+func (c *Client) recordSchema(req *http.Request, resp *http.Response) {
+	if c.Schema == nil || resp == nil || resp.Body == nil || resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return
+	}
+	if ct := resp.Header.Get("Content-Type"); len(ct) > 0 && !strings.Contains(ct, "json") {
+		return
+	}
+	if streamRequest(req) {
+		return
+	}
+	body, err := c.bodyBuffers().peek(resp)
+	if err != nil {
+		return
+	}
+	_ = c.Schema.Record(schemaEndpoint(req), body)
+}
@@ -0,0 +1,88 @@
+package twitter
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_Schema(t *testing.T) {
+	now := time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	recorder := &SchemaRecorder{
+		now: func() time.Time { return now },
+	}
+	body := `{"data":{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev","public_metrics":{"followers_count":1}}}`
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Schema:     recorder,
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+
+	resp, err := client.UserLookup(context.Background(), []string{"2244994945"}, UserLookupOpts{})
+	if err != nil {
+		t.Fatalf("Client.UserLookup() error = %v", err)
+	}
+	if resp.Raw.Users[0].UserName != "TwitterDev" {
+		t.Errorf("Client.UserLookup() = %v, the body was not decoded after recording", resp.Raw.Users[0])
+	}
+
+	saved := &bytes.Buffer{}
+	if err := recorder.Save(saved); err != nil {
+		t.Fatalf("SchemaRecorder.Save() error = %v", err)
+	}
+	recorder = &SchemaRecorder{
+		now: func() time.Time { return now },
+	}
+	if err := recorder.Load(saved); err != nil {
+		t.Fatalf("SchemaRecorder.Load() error = %v", err)
+	}
+	client.Schema = recorder
+
+	now = now.Add(24 * time.Hour)
+	since := now
+	body = `{"data":{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev","verified_type":"none"}}`
+	if _, err := client.UserLookup(context.Background(), []string{"2244994945"}, UserLookupOpts{}); err != nil {
+		t.Fatalf("Client.UserLookup() error = %v", err)
+	}
+
+	want := []SchemaDrift{
+		{
+			Endpoint:    "GET 2/users/{id}",
+			Appeared:    []string{"data.verified_type"},
+			Disappeared: []string{"data.public_metrics", "data.public_metrics.followers_count"},
+		},
+	}
+	if got := recorder.Drift(since); !reflect.DeepEqual(got, want) {
+		t.Errorf("SchemaRecorder.Drift() = %+v, want %+v", got, want)
+	}
+}
+
+func Test_schemaEndpoint(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: "https://api.twitter.com/2/users/2244994945/tweets", want: "GET 2/users/{id}/tweets"},
+		{url: "https://api.twitter.com/2/users/by/username/TwitterDev", want: "GET 2/users/by/username/{username}"},
+		{url: "https://api.twitter.com/2/spaces/1DXxyRYNejbKM", want: "GET 2/spaces/{id}"},
+		{url: "https://api.twitter.com/2/tweets/search/recent?query=golang", want: "GET 2/tweets/search/recent"},
+	}
+	for _, tt := range tests {
+		req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+		if got := schemaEndpoint(req); got != tt.want {
+			t.Errorf("schemaEndpoint() = %v, want %v", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package twitter
+
+import (
+	"net/http"
+	"strings"
+)
+
+// EndpointShim will redirect the requests for an endpoint that has been retired or renamed.  This allows
+// a deployed application to be configured around an API change before the library has been updated.
+//
+// Endpoint is the path of the endpoint to shim, like 2/users/{id}/tweets.  The {id} segment will match any value but the
+// literal segment of another endpoint, like the me of 2/users/me.
+//
+// Path is the alternate path to send the request to.  If the endpoint has an {id}, it will be placed in the path's {id}.
+// If empty, the original path is kept.
+//
+// Params will rename the query parameters, the key is the current parameter name and the value is the new name.  If the
+// new name is empty, the parameter is removed from the request.
+type EndpointShim struct {
+	Endpoint string
+	Path     string
+	Params   map[string]string
+}
+
+type shimMatch struct {
+	shim     *EndpointShim
+	start    int
+	id       string
+	literals int
+}
+
+// match will check if the request path ends with the shim's endpoint.  The number of literal
+// segments are returned so the most specific shim can be used.
+func (s *EndpointShim) match(segments []string) (shimMatch, bool) {
+	start, id, literals, ok := matchEndpoint(pathSegments(s.Endpoint), segments)
+	if !ok {
+		return shimMatch{}, false
+	}
+	return shimMatch{
+		shim:     s,
+		start:    start,
+		id:       id,
+		literals: literals,
+	}, true
+}
+
+// literalEndpoints are the endpoints with a literal segment in the place of another endpoint's {id}, like the me of
+// 2/users/me and the {id} of 2/users/{id}
+var literalEndpoints = []endpoint{
+	userNameLookupEndpoint,
+	userAuthLookupEndpoint,
+	personalizedTrendsEndpoint,
+	tweetRecentSearchEndpoint,
+	tweetSearchEndpoint,
+	tweetRecentCountsEndpoint,
+	tweetAllCountsEndpoint,
+	tweetSampleStreamEndpoint,
+	tweetSearchStreamEndpoint,
+	tweetSearchStreamRulesEndpoint,
+	spaceByCreatorLookupEndpoint,
+	spaceSearchEndpoint,
+	communitySearchEndpoint,
+	dmParticipantEventsEndpoint,
+	dmParticipantMessagesEndpoint,
+}
+
+// matchEndpoint will check if the path segments end with the endpoint pattern, where an {id} segment matches any value
+// that is not the literal segment of an endpoint, so 2/users/{id} does not match 2/users/me.  The start of the match,
+// the id and the number of literal segments are returned.
+func matchEndpoint(pattern, segments []string) (int, string, int, bool) {
+	if len(pattern) == 0 || len(pattern) > len(segments) {
+		return 0, "", 0, false
+	}
+	start := len(segments) - len(pattern)
+	id := ""
+	literals := 0
+	for i, p := range pattern {
+		seg := segments[start+i]
+		switch {
+		case p == idTag && !literalSegment(segments[:start+i+1]):
+			id = seg
+		case p == seg:
+			literals++
+		default:
+			return 0, "", 0, false
+		}
+	}
+	return start, id, literals, true
+}
+
+// literalSegment returns true if the last of the path segments is a literal segment of an endpoint, the segments
+// before it must match the endpoint from its version
+func literalSegment(segments []string) bool {
+	last := len(segments) - 1
+	for _, e := range literalEndpoints {
+		literal := pathSegments(string(e))
+		for k := 1; k < len(literal) && k <= last; k++ {
+			if literal[k] == idTag || literal[k] != segments[last] {
+				continue
+			}
+			matched := true
+			for j := 0; j < k; j++ {
+				if literal[j] != idTag && literal[j] != segments[last-k+j] {
+					matched = false
+					break
+				}
+			}
+			if matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *Client) applyShims(req *http.Request) {
+	if len(c.Shims) == 0 || req.URL == nil {
+		return
+	}
+	segments := pathSegments(req.URL.Path)
+
+	var found *shimMatch
+	for _, shim := range c.Shims {
+		if shim == nil {
+			continue
+		}
+		m, ok := shim.match(segments)
+		if !ok {
+			continue
+		}
+		if found == nil || m.literals > found.literals {
+			found = &m
+		}
+	}
+	if found == nil {
+		return
+	}
+
+	if len(found.shim.Path) > 0 {
+		path := strings.ReplaceAll(strings.Trim(found.shim.Path, "/"), idTag, found.id)
+		prefix := strings.Join(segments[:found.start], "/")
+		if len(prefix) > 0 {
+			path = prefix + "/" + path
+		}
+		req.URL.Path = "/" + path
+		req.URL.RawPath = ""
+	}
+
+	if len(found.shim.Params) > 0 {
+		q := req.URL.Query()
+		for from, to := range found.shim.Params {
+			values, has := q[from]
+			if !has {
+				continue
+			}
+			q.Del(from)
+			if len(to) > 0 {
+				q[to] = values
+			}
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+func pathSegments(path string) []string {
+	segments := []string{}
+	for _, s := range strings.Split(path, "/") {
+		if len(s) > 0 {
+			segments = append(segments, s)
+		}
+	}
+	return segments
+}
@@ -0,0 +1,201 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestClient_applyShims(t *testing.T) {
+	tests := []struct {
+		name  string
+		shims []*EndpointShim
+		url   string
+		want  string
+	}{
+		{
+			name: "no shims",
+			url:  "https://www.test.com/2/users/2244994945/tweets?max_results=10",
+			want: "https://www.test.com/2/users/2244994945/tweets?max_results=10",
+		},
+		{
+			name: "alternate path with id",
+			shims: []*EndpointShim{
+				{
+					Endpoint: "2/users/{id}/tweets",
+					Path:     "2/users/{id}/posts",
+				},
+			},
+			url:  "https://www.test.com/2/users/2244994945/tweets?max_results=10",
+			want: "https://www.test.com/2/users/2244994945/posts?max_results=10",
+		},
+		{
+			name: "host with a base path",
+			shims: []*EndpointShim{
+				{
+					Endpoint: "2/tweets/search/recent",
+					Path:     "/2/posts/search/recent/",
+				},
+			},
+			url:  "https://www.test.com/proxy/2/tweets/search/recent?query=golang",
+			want: "https://www.test.com/proxy/2/posts/search/recent?query=golang",
+		},
+		{
+			name: "parameter renames",
+			shims: []*EndpointShim{
+				{
+					Endpoint: "2/tweets/search/recent",
+					Params: map[string]string{
+						"tweet.fields": "post.fields",
+						"sort_order":   "",
+					},
+				},
+			},
+			url:  "https://www.test.com/2/tweets/search/recent?query=golang&sort_order=recency&tweet.fields=id%2Ctext",
+			want: "https://www.test.com/2/tweets/search/recent?post.fields=id%2Ctext&query=golang",
+		},
+		{
+			name: "most specific shim",
+			shims: []*EndpointShim{
+				{
+					Endpoint: "2/users/{id}",
+					Path:     "2/accounts/{id}",
+				},
+				{
+					Endpoint: "2/users/me",
+					Path:     "2/accounts/me",
+				},
+			},
+			url:  "https://www.test.com/2/users/me",
+			want: "https://www.test.com/2/accounts/me",
+		},
+		{
+			name: "literal segment is not an id",
+			shims: []*EndpointShim{
+				{
+					Endpoint: "2/users/{id}",
+					Path:     "2/accounts/{id}",
+				},
+			},
+			url:  "https://www.test.com/2/users/me",
+			want: "https://www.test.com/2/users/me",
+		},
+		{
+			name: "id that is a version",
+			shims: []*EndpointShim{
+				{
+					Endpoint: "2/users/{id}",
+					Path:     "2/accounts/{id}",
+				},
+			},
+			url:  "https://www.test.com/2/users/2",
+			want: "https://www.test.com/2/accounts/2",
+		},
+		{
+			name: "no match",
+			shims: []*EndpointShim{
+				{
+					Endpoint: "2/lists/{id}/tweets",
+					Path:     "2/lists/{id}/posts",
+				},
+			},
+			url:  "https://www.test.com/2/users/2244994945/tweets",
+			want: "https://www.test.com/2/users/2244994945/tweets",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{
+				Shims: tt.shims,
+			}
+			u, err := url.Parse(tt.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req := &http.Request{URL: u}
+			c.applyShims(req)
+			if got := req.URL.String(); got != tt.want {
+				t.Errorf("Client.applyShims() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Shims(t *testing.T) {
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Shims: []*EndpointShim{
+			{
+				Endpoint: "2/users/{id}/tweets",
+				Path:     "2/users/{id}/posts",
+				Params: map[string]string{
+					"tweet.fields": "post.fields",
+				},
+			},
+		},
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != "/2/users/2244994945/posts" {
+				log.Panicf("the url path is not correct %s", req.URL.Path)
+			}
+			if req.URL.Query().Get("post.fields") != "created_at" {
+				log.Panicf("the parameter was not renamed %s", req.URL.RawQuery)
+			}
+			body := `{
+				"data": [
+					{
+						"id": "1338971066773905408",
+						"text": "Hello"
+					}
+				],
+				"meta": {
+					"result_count": 1
+				}
+			}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+	resp, err := client.UserTweetTimeline(context.Background(), "2244994945", UserTweetTimelineOpts{
+		TweetFields: []TweetField{TweetFieldCreatedAt},
+	})
+	if err != nil {
+		t.Fatalf("Client.UserTweetTimeline() error = %v", err)
+	}
+	if len(resp.Raw.Tweets) != 1 {
+		t.Errorf("Client.UserTweetTimeline() tweets = %d, want 1", len(resp.Raw.Tweets))
+	}
+}
+
+func Test_matchEndpoint(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		id      string
+		ok      bool
+	}{
+		{pattern: "2/users/{id}", path: "/2/users/2244994945", id: "2244994945", ok: true},
+		{pattern: "2/users/{id}", path: "/2/users/me"},
+		{pattern: "2/users/{id}", path: "/2/users/by"},
+		{pattern: "2/users/me", path: "/2/users/me", ok: true},
+		{pattern: "2/users/{id}/tweets", path: "/proxy/2/users/2244994945/tweets", id: "2244994945", ok: true},
+		{pattern: "2/tweets/{id}", path: "/2/tweets/search"},
+		{pattern: "2/spaces/{id}", path: "/2/spaces/search"},
+		{pattern: "2/dm_conversations/{id}/dm_events", path: "/2/dm_conversations/with/dm_events"},
+		{pattern: "2/dm_conversations/with/{id}/dm_events", path: "/2/dm_conversations/with/1234/dm_events", id: "1234", ok: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			_, id, _, ok := matchEndpoint(pathSegments(tt.pattern), pathSegments(tt.path))
+			if ok != tt.ok || id != tt.id {
+				t.Errorf("matchEndpoint() = %s %v, want %s %v", id, ok, tt.id, tt.ok)
+			}
+		})
+	}
+}
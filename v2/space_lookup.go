@@ -57,6 +57,41 @@ type SpacesRawIncludes struct {
 	Topics []*TopicObj `json:"topics,omitempty"`
 }
 
+// merge will add the spaces, includes and errors of the other response, the includes are not repeated
+func (s *SpacesRaw) merge(other *SpacesRaw) {
+	if other == nil {
+		return
+	}
+	s.Spaces = append(s.Spaces, other.Spaces...)
+	s.Errors = append(s.Errors, other.Errors...)
+	if other.Includes == nil {
+		return
+	}
+	if s.Includes == nil {
+		s.Includes = &SpacesRawIncludes{}
+	}
+	users := map[string]bool{}
+	for _, user := range s.Includes.Users {
+		users[user.ID] = true
+	}
+	for _, user := range other.Includes.Users {
+		if user != nil && !users[user.ID] {
+			users[user.ID] = true
+			s.Includes.Users = append(s.Includes.Users, user)
+		}
+	}
+	topics := map[string]bool{}
+	for _, topic := range s.Includes.Topics {
+		topics[topic.ID] = true
+	}
+	for _, topic := range other.Includes.Topics {
+		if topic != nil && !topics[topic.ID] {
+			topics[topic.ID] = true
+			s.Includes.Topics = append(s.Includes.Topics, topic)
+		}
+	}
+}
+
 // SpacesByCreatorLookupOpts are the options for the space by creator
 type SpacesByCreatorLookupOpts struct {
 	Expansions  []Expansion
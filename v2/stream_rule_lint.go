@@ -0,0 +1,235 @@
+package twitter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StreamRuleAccess is the access level of the app, which decides the length of the filtered stream rules and the
+// operators that can be used
+type StreamRuleAccess string
+
+const (
+	// StreamRuleAccessEssential has rules up to 512 characters with the core operators
+	StreamRuleAccessEssential StreamRuleAccess = "essential"
+	// StreamRuleAccessElevated has rules up to 512 characters with the core operators
+	StreamRuleAccessElevated StreamRuleAccess = "elevated"
+	// StreamRuleAccessAcademic has rules up to 1024 characters with the core and advanced operators
+	StreamRuleAccessAcademic StreamRuleAccess = "academic"
+
+	streamRuleMaxLength = 1024
+)
+
+var streamRuleAccessLength = map[StreamRuleAccess]int{
+	StreamRuleAccessEssential: 512,
+	StreamRuleAccessElevated:  512,
+	StreamRuleAccessAcademic:  1024,
+}
+
+// streamRuleOperators are the known operators and if they are advanced.  The is and has operators are keyed with their
+// value.
+var streamRuleOperators = map[string]bool{
+	"from":                 false,
+	"to":                   false,
+	"url":                  false,
+	"retweets_of":          false,
+	"context":              false,
+	"entity":               false,
+	"conversation_id":      false,
+	"lang":                 false,
+	"in_reply_to_tweet_id": false,
+	"retweets_of_tweet_id": false,
+	"quotes_of_tweet_id":   false,
+	"list":                 false,
+	"is:retweet":           false,
+	"is:reply":             false,
+	"is:quote":             false,
+	"is:verified":          false,
+	"has:hashtags":         false,
+	"has:links":            false,
+	"has:mentions":         false,
+	"has:media":            false,
+	"has:images":           false,
+	"has:video_link":       false,
+	"bio":                  true,
+	"bio_name":             true,
+	"bio_location":         true,
+	"place":                true,
+	"place_country":        true,
+	"point_radius":         true,
+	"bounding_box":         true,
+	"sample":               true,
+	"is:nullcast":          true,
+	"has:cashtags":         true,
+	"has:geo":              true,
+}
+
+// StreamRuleFinding is a problem with a filtered stream rule.  Index is the rule's position in the request and Offset
+// is the byte offset in the rule's value, or -1 when the finding is about the whole rule.  A warning is a problem that
+// twitter may not reject, like an operator the linter does not know.
+type StreamRuleFinding struct {
+	Index   int
+	Value   string
+	Offset  int
+	Message string
+	Warning bool
+}
+
+func (f *StreamRuleFinding) String() string {
+	message := f.Message
+	if f.Warning {
+		message = "warning: " + message
+	}
+	if f.Offset < 0 {
+		return fmt.Sprintf("rule %d: %s", f.Index, message)
+	}
+	return fmt.Sprintf("rule %d offset %d: %s", f.Index, f.Offset, message)
+}
+
+// StreamRuleLintError has the findings of the filtered stream rules, it matches ErrParameter
+type StreamRuleLintError struct {
+	Findings []*StreamRuleFinding
+}
+
+func (e *StreamRuleLintError) Error() string {
+	findings := make([]string, len(e.Findings))
+	for i, finding := range e.Findings {
+		findings[i] = finding.String()
+	}
+	return fmt.Sprintf("tweet search stream rules: %s", strings.Join(findings, ", "))
+}
+
+// Unwrap will return the parameter error
+func (e *StreamRuleLintError) Unwrap() error {
+	return ErrParameter
+}
+
+// LintStreamRules will check the filtered stream rules locally for the problems twitter would reject: the length of
+// the access level, unbalanced parentheses and quotes and advanced operators that the access level does not have.  An
+// operator that is not known is a warning, as twitter adds operators.  An empty access level will only check the rule
+// syntax and the longest length.
+func LintStreamRules(rules []TweetSearchStreamRule, access StreamRuleAccess) []*StreamRuleFinding {
+	findings := []*StreamRuleFinding{}
+	for i, rule := range rules {
+		findings = append(findings, rule.lint(i, access)...)
+	}
+	return findings
+}
+
+func (t TweetSearchStreamRule) lint(index int, access StreamRuleAccess) []*StreamRuleFinding {
+	findings := []*StreamRuleFinding{}
+	finding := func(offset int, format string, args ...interface{}) {
+		findings = append(findings, &StreamRuleFinding{
+			Index:   index,
+			Value:   t.Value,
+			Offset:  offset,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+	warning := func(offset int, message string) {
+		finding(offset, "%s", message)
+		findings[len(findings)-1].Warning = true
+	}
+
+	if len(strings.TrimSpace(t.Value)) == 0 {
+		finding(-1, "a value is required")
+		return findings
+	}
+	maxLength, has := streamRuleAccessLength[access]
+	if !has {
+		maxLength = streamRuleMaxLength
+	}
+	if length := len([]rune(t.Value)); length > maxLength {
+		finding(-1, "the length %d is over the %d characters allowed", length, maxLength)
+	}
+
+	depth := 0
+	quoted := -1
+	tokenStart := -1
+	token := func(end int) {
+		if tokenStart < 0 {
+			return
+		}
+		switch message, warn := lintStreamRuleToken(t.Value[tokenStart:end], access); {
+		case len(message) == 0:
+		case warn:
+			warning(tokenStart, message)
+		default:
+			finding(tokenStart, "%s", message)
+		}
+		tokenStart = -1
+	}
+	for i := 0; i < len(t.Value); i++ {
+		c := t.Value[i]
+		switch {
+		case quoted >= 0 && c == '\\':
+			i++
+		case c == '"' && quoted >= 0:
+			quoted = -1
+		case quoted >= 0:
+		case c == '"':
+			token(i)
+			quoted = i
+		case c == '(':
+			token(i)
+			depth++
+		case c == ')':
+			token(i)
+			if depth == 0 {
+				finding(i, "the closing parenthesis does not have an opening parenthesis")
+				continue
+			}
+			depth--
+		case c == ' ' || c == '\t' || c == '\n':
+			token(i)
+		case tokenStart < 0:
+			tokenStart = i
+		default:
+		}
+	}
+	token(len(t.Value))
+	if quoted >= 0 {
+		finding(quoted, "the quote is not closed")
+	}
+	if depth > 0 {
+		finding(-1, "%d parentheses are not closed", depth)
+	}
+	return findings
+}
+
+// lintStreamRuleToken returns the problem with an operator and if it is only a warning, a token that is not an operator
+// has no problem.  A token with a scheme, like a bare url, is a keyword and not an operator.
+func lintStreamRuleToken(token string, access StreamRuleAccess) (string, bool) {
+	token = strings.TrimPrefix(token, "-")
+	if strings.HasPrefix(token, "$") && len(token) > 1 {
+		return lintStreamRuleOperator("cashtag", true, access), false
+	}
+
+	idx := strings.Index(token, ":")
+	if idx <= 0 || strings.Contains(token, "://") {
+		return "", false
+	}
+	name := token[:idx]
+	if strings.Trim(name, "abcdefghijklmnopqrstuvwxyz_") != "" {
+		return "", false
+	}
+	if name == "is" || name == "has" {
+		name = token
+	}
+	advanced, has := streamRuleOperators[name]
+	if !has {
+		return fmt.Sprintf("unknown operator %s", name), true
+	}
+	return lintStreamRuleOperator(name, advanced, access), false
+}
+
+func lintStreamRuleOperator(description string, advanced bool, access StreamRuleAccess) string {
+	switch {
+	case !advanced:
+		return ""
+	case access == StreamRuleAccessEssential || access == StreamRuleAccessElevated:
+		return fmt.Sprintf("the %s operator is not available with %s access", description, access)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,195 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLintStreamRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    []TweetSearchStreamRule
+		access   StreamRuleAccess
+		messages []string
+	}{
+		{
+			name: "valid",
+			rules: []TweetSearchStreamRule{
+				{Value: `(cat OR dog) from:twitterdev -is:retweet lang:en "hello world" 10:30`},
+				{Value: `bio:gopher has:geo`},
+				{Value: `has:video_link https://t.co/x -url:"https://go.dev"`},
+			},
+			access: StreamRuleAccessAcademic,
+		},
+		{
+			name: "empty",
+			rules: []TweetSearchStreamRule{
+				{Value: " "},
+			},
+			messages: []string{"rule 0: a value is required"},
+		},
+		{
+			name: "parentheses",
+			rules: []TweetSearchStreamRule{
+				{Value: "(cat OR (dog"},
+				{Value: "cat) dog"},
+			},
+			messages: []string{
+				"rule 0: 2 parentheses are not closed",
+				"rule 1 offset 3: the closing parenthesis does not have an opening parenthesis",
+			},
+		},
+		{
+			name: "quotes",
+			rules: []TweetSearchStreamRule{
+				{Value: `"say \"hi\")" (cat`},
+				{Value: `cat "dog`},
+			},
+			messages: []string{
+				"rule 0: 1 parentheses are not closed",
+				"rule 1 offset 4: the quote is not closed",
+			},
+		},
+		{
+			name: "unknown operator",
+			rules: []TweetSearchStreamRule{
+				{Value: "cat form:twitterdev"},
+			},
+			messages: []string{"rule 0 offset 4: warning: unknown operator form"},
+		},
+		{
+			name: "advanced operator",
+			rules: []TweetSearchStreamRule{
+				{Value: "cat -has:geo $TWTR place:seattle"},
+			},
+			access: StreamRuleAccessEssential,
+			messages: []string{
+				"rule 0 offset 4: the has:geo operator is not available with essential access",
+				"rule 0 offset 13: the cashtag operator is not available with essential access",
+				"rule 0 offset 19: the place operator is not available with essential access",
+			},
+		},
+		{
+			name: "length",
+			rules: []TweetSearchStreamRule{
+				{Value: strings.Repeat("a", 513)},
+			},
+			access:   StreamRuleAccessElevated,
+			messages: []string{"rule 0: the length 513 is over the 512 characters allowed"},
+		},
+		{
+			name: "length without access",
+			rules: []TweetSearchStreamRule{
+				{Value: strings.Repeat("a", 513)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := LintStreamRules(tt.rules, tt.access)
+			messages := []string{}
+			for _, finding := range findings {
+				messages = append(messages, finding.String())
+			}
+			if strings.Join(messages, "\n") != strings.Join(tt.messages, "\n") {
+				t.Errorf("LintStreamRules() = %v, want %v", messages, tt.messages)
+			}
+		})
+	}
+}
+
+func TestClient_TweetSearchStreamAddRule_Lint(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			t.Errorf("the request should not be sent")
+			return nil
+		}),
+		Host:             "https://www.test.com",
+		StreamRuleLint:   true,
+		StreamRuleAccess: StreamRuleAccessEssential,
+	}
+	rules := []TweetSearchStreamRule{
+		{Value: "cat has:geo", Tag: "cats"},
+	}
+	_, err := c.TweetSearchStreamAddRule(context.Background(), rules, false)
+	if !errors.Is(err, ErrParameter) {
+		t.Fatalf("Client.TweetSearchStreamAddRule() error = %v, want %v", err, ErrParameter)
+	}
+	lintErr := &StreamRuleLintError{}
+	if !errors.As(err, &lintErr) {
+		t.Fatalf("Client.TweetSearchStreamAddRule() error = %T, want %T", err, lintErr)
+	}
+	if len(lintErr.Findings) != 1 || lintErr.Findings[0].Offset != 4 {
+		t.Errorf("Client.TweetSearchStreamAddRule() findings = %v", lintErr.Findings)
+	}
+}
+
+func TestClient_TweetSearchStreamAddRule_LintWarning(t *testing.T) {
+	sent := 0
+	warnings := make(chan *Warning, 1)
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			sent++
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"data":[{"value":"cat followers_count:100","tag":"cats","id":"1"}],"meta":{"sent":"2021-06-01T00:00:00.000Z","summary":{"created":1,"not_created":0}}}`)),
+			}
+		}),
+		Host:             "https://www.test.com",
+		Warnings:         warnings,
+		StreamRuleLint:   true,
+		StreamRuleAccess: StreamRuleAccessEssential,
+	}
+	rules := []TweetSearchStreamRule{
+		{Value: "cat followers_count:100", Tag: "cats"},
+	}
+	if _, err := c.TweetSearchStreamAddRule(context.Background(), rules, false); err != nil {
+		t.Fatalf("Client.TweetSearchStreamAddRule() error = %v", err)
+	}
+	if sent != 1 {
+		t.Errorf("Client.TweetSearchStreamAddRule() sent %d requests, want 1", sent)
+	}
+	select {
+	case w := <-warnings:
+		if w.Type != WarningStreamRule || w.Message != "rule 0 offset 4: warning: unknown operator followers_count" {
+			t.Errorf("Client.TweetSearchStreamAddRule() warning = %v", w)
+		}
+	default:
+		t.Errorf("Client.TweetSearchStreamAddRule() should warn about the unknown operator")
+	}
+}
+
+func TestClient_TweetSearchStreamAddRule_NoLint(t *testing.T) {
+	sent := 0
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			sent++
+			return &http.Response{
+				StatusCode: http.StatusCreated,
+				Body:       io.NopCloser(strings.NewReader(`{"data":[{"value":"cat has:geo (dog","tag":"cats","id":"1"}],"meta":{"sent":"2021-06-01T00:00:00.000Z","summary":{"created":1,"not_created":0}}}`)),
+			}
+		}),
+		Host:             "https://www.test.com",
+		StreamRuleAccess: StreamRuleAccessEssential,
+	}
+	rules := []TweetSearchStreamRule{
+		{Value: "cat has:geo (dog", Tag: "cats"},
+	}
+	if _, err := c.TweetSearchStreamAddRule(context.Background(), rules, false); err != nil {
+		t.Fatalf("Client.TweetSearchStreamAddRule() error = %v, the rules are only linted when asked for", err)
+	}
+	if sent != 1 {
+		t.Errorf("Client.TweetSearchStreamAddRule() sent %d requests, want 1", sent)
+	}
+	_, err := c.TweetSearchStreamAddRule(context.Background(), []TweetSearchStreamRule{{Tag: "empty"}}, false)
+	if !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.TweetSearchStreamAddRule() error = %v, want %v", err, ErrParameter)
+	}
+}
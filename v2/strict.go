@@ -0,0 +1,154 @@
+package twitter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ErrUnknownFields is returned in strict mode when a response has fields that are not in the response struct
+var ErrUnknownFields = errors.New("twitter response has unknown fields")
+
+// UnknownFieldsError has the paths of the response fields that are not in the response struct, like
+// data[].display_text_range.  It is wrapped in a *ResponseDecodeError.
+type UnknownFieldsError struct {
+	Fields []string
+}
+
+func (u *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrUnknownFields.Error(), strings.Join(u.Fields, ", "))
+}
+
+// Is will match ErrUnknownFields
+func (u *UnknownFieldsError) Is(target error) bool {
+	return target == ErrUnknownFields
+}
+
+// decode will decode the response body into the value.  In strict mode, all of the fields that are not in the value
+// are returned as an *UnknownFieldsError.
+func (c *Client) decode(decoder *json.Decoder, v interface{}) error {
+	return decodeStrict(decoder, v, c.Strict)
+}
+
+func decodeStrict(decoder *json.Decoder, v interface{}, strict bool) error {
+	if !strict {
+		return decoder.Decode(v)
+	}
+	var raw json.RawMessage
+	if err := decoder.Decode(&raw); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return err
+	}
+	var body interface{}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return err
+	}
+	unknown := map[string]struct{}{}
+	unknownFields("", body, reflect.TypeOf(v), unknown)
+	if len(unknown) == 0 {
+		return nil
+	}
+	fields := make([]string, 0, len(unknown))
+	for field := range unknown {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return &UnknownFieldsError{
+		Fields: fields,
+	}
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// unknownFields will walk the JSON value with the type it was decoded into, and add the paths of the object keys
+// that do not have a field.  Types that decode themselves are not walked, unless they keep an Extra map.
+func unknownFields(path string, v interface{}, t reflect.Type, unknown map[string]struct{}) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if reflect.PtrTo(t).Implements(unmarshalerType) && !hasExtra(t) {
+		return
+	}
+	switch value := v.(type) {
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := jsonFields(t)
+			for k, child := range value {
+				key := k
+				if len(path) > 0 {
+					key = path + "." + k
+				}
+				ft, has := fields[strings.ToLower(k)]
+				if !has {
+					unknown[key] = struct{}{}
+					continue
+				}
+				unknownFields(key, child, ft, unknown)
+			}
+		case reflect.Map:
+			for k, child := range value {
+				unknownFields(path+"."+k, child, t.Elem(), unknown)
+			}
+		default:
+		}
+	case []interface{}:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return
+		}
+		for _, child := range value {
+			unknownFields(path+"[]", child, t.Elem(), unknown)
+		}
+	default:
+	}
+}
+
+// hasExtra returns true if the struct keeps its unknown fields in Extra, the unknown fields are still reported
+func hasExtra(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	f, has := t.FieldByName("Extra")
+	return has && f.Type == reflect.TypeOf(map[string]json.RawMessage{})
+}
+
+// jsonFields returns the types of the struct's fields by their lower case JSON names, like the json package they
+// include the fields of embedded structs
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		ft := f.Type
+		if f.Anonymous && len(name) == 0 {
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range jsonFields(ft) {
+					if _, has := fields[k]; !has {
+						fields[k] = v
+					}
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if len(name) == 0 {
+			name = f.Name
+		}
+		fields[strings.ToLower(name)] = ft
+	}
+	return fields
+}
@@ -0,0 +1,147 @@
+package twitter
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+const tokenPoolDefaultReset = 15 * time.Minute
+
+// BearerToken will add the token as the bearer authorization
+type BearerToken string
+
+// Add will add the authorization header
+func (b BearerToken) Add(req *http.Request) {
+	req.Header.Add("Authorization", "Bearer "+string(b))
+}
+
+// TokenPoolLimit is the last rate limit seen for one of the pool's authorizers and an endpoint
+type TokenPoolLimit struct {
+	Index     int
+	Endpoint  string
+	RateLimit RateLimit
+}
+
+// TokenPool will round robin the requests across the authorizers, like bearer tokens for different apps or user
+// tokens.  The rate limits are tracked for each authorizer and endpoint, an authorizer that has no requests remaining
+// is skipped until its reset.  When a request is rate limited, the client will send it again with the next authorizer.
+//
+// The authorizers must set different authorization headers, the header is used to find which authorizer sent a request.
+type TokenPool struct {
+	Authorizers []Authorizer
+	mutex       sync.Mutex
+	next        int
+	headers     map[string]int
+	limits      map[int]map[string]RateLimit
+	now         func() time.Time
+}
+
+func (p *TokenPool) clock() time.Time {
+	if p.now != nil {
+		return p.now()
+	}
+	return time.Now()
+}
+
+// Add will add the authorization of the next authorizer that has requests remaining for the endpoint
+func (p *TokenPool) Add(req *http.Request) {
+	if len(p.Authorizers) == 0 {
+		return
+	}
+	endpoint := schemaEndpoint(req)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	i, _ := p.pick(endpoint, -1)
+	p.next = (i + 1) % len(p.Authorizers)
+	p.Authorizers[i].Add(req)
+	if p.headers == nil {
+		p.headers = map[string]int{}
+	}
+	p.headers[req.Header.Get("Authorization")] = i
+}
+
+// ObserveRateLimit will record the rate limit of the authorizer that sent the request
+func (p *TokenPool) ObserveRateLimit(req *http.Request, statusCode int, rl *RateLimit) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	i, has := p.headers[req.Header.Get("Authorization")]
+	if !has {
+		return
+	}
+	limit := RateLimit{}
+	switch {
+	case rl != nil:
+		limit = *rl
+	case statusCode == http.StatusTooManyRequests:
+		limit.Reset = Epoch(p.clock().Add(tokenPoolDefaultReset).Unix())
+	default:
+		return
+	}
+	if statusCode == http.StatusTooManyRequests {
+		limit.Remaining = 0
+	}
+	if p.limits == nil {
+		p.limits = map[int]map[string]RateLimit{}
+	}
+	if p.limits[i] == nil {
+		p.limits[i] = map[string]RateLimit{}
+	}
+	p.limits[i][schemaEndpoint(req)] = limit
+}
+
+// Rotate returns true if another authorizer has requests remaining for the rate limited request's endpoint
+func (p *TokenPool) Rotate(req *http.Request) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	used, has := p.headers[req.Header.Get("Authorization")]
+	if !has {
+		return false
+	}
+	_, available := p.pick(schemaEndpoint(req), used)
+	return available
+}
+
+// Limits returns the last rate limits seen for each authorizer and endpoint
+func (p *TokenPool) Limits() []TokenPoolLimit {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	limits := []TokenPoolLimit{}
+	for i := range p.Authorizers {
+		for endpoint, rl := range p.limits[i] {
+			limits = append(limits, TokenPoolLimit{
+				Index:     i,
+				Endpoint:  endpoint,
+				RateLimit: rl,
+			})
+		}
+	}
+	return limits
+}
+
+// pick returns the next authorizer, other than the skipped one, with requests remaining for the endpoint.  If
+// all of them are exhausted, the one that resets first is returned with false.
+func (p *TokenPool) pick(endpoint string, skip int) (int, bool) {
+	now := p.clock()
+	soonest := -1
+	var soonestReset time.Time
+	for n := 0; n < len(p.Authorizers); n++ {
+		i := (p.next + n) % len(p.Authorizers)
+		if i == skip {
+			continue
+		}
+		rl, has := p.limits[i][endpoint]
+		if !has || rl.Remaining > 0 || !rl.Reset.Time().After(now) {
+			return i, true
+		}
+		if soonest < 0 || rl.Reset.Time().Before(soonestReset) {
+			soonest = i
+			soonestReset = rl.Reset.Time()
+		}
+	}
+	if soonest < 0 {
+		return p.next % len(p.Authorizers), false
+	}
+	return soonest, false
+}
@@ -0,0 +1,63 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTokenPool(t *testing.T) {
+	now := time.Date(2022, time.June, 1, 10, 0, 0, 0, time.UTC)
+	pool := &TokenPool{
+		Authorizers: []Authorizer{BearerToken("a"), BearerToken("b"), BearerToken("c")},
+		now:         func() time.Time { return now },
+	}
+	used := []string{}
+	client := &Client{
+		Authorizer: pool,
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+			used = append(used, token)
+			header := http.Header{}
+			header.Add(rateLimit, "450")
+			header.Add(rateReset, "1654081200")
+			if token == "a" {
+				header.Add(rateRemaining, "0")
+				return &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     header,
+					Body:       io.NopCloser(strings.NewReader(`{"title":"Too Many Requests","detail":"Too Many Requests","type":"about:blank","status":429}`)),
+				}
+			}
+			header.Add(rateRemaining, "10")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"data":[],"meta":{"result_count":0}}`)),
+			}
+		}),
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{}); err != nil {
+			t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+		}
+	}
+	if want := []string{"a", "b", "c", "b"}; !reflect.DeepEqual(used, want) {
+		t.Errorf("TokenPool used %v, want %v", used, want)
+	}
+	if limits := pool.Limits(); len(limits) != 3 {
+		t.Errorf("TokenPool.Limits() = %v", limits)
+	}
+
+	pool.Authorizers = pool.Authorizers[:1]
+	_, err := client.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{})
+	if rl, has := RateLimitFromError(err); !has || rl.Remaining != 0 {
+		t.Errorf("Client.TweetRecentSearch() error = %v, want the rate limit error", err)
+	}
+}
@@ -0,0 +1,58 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// Tracer will start a span for each API call.  The span's context is used for the call's requests, including any
+// retries.  The endpoint is the method and path with the ids replaced, like GET 2/users/{id}/tweets.
+type Tracer interface {
+	StartSpan(ctx context.Context, endpoint string, req *http.Request) (context.Context, Span)
+}
+
+// Span is a started API call span
+type Span interface {
+	End(result *SpanResult)
+}
+
+// SpanResult is the result of an API call.  The result count is from the response's meta, if present.
+type SpanResult struct {
+	StatusCode     int
+	ResultCount    int
+	HasResultCount bool
+	RateLimit      *RateLimit
+	Err            error
+}
+
+// spanResult will create the result of the call, the response body is read for the result count and replaced so
+// it can still be decoded.  Streams are not read.
+func (c *Client) spanResult(req *http.Request, resp *http.Response, err error) *SpanResult {
+	result := &SpanResult{
+		Err: err,
+	}
+	if resp == nil {
+		return result
+	}
+	result.StatusCode = resp.StatusCode
+	result.RateLimit = rateFromHeader(resp.Header)
+
+	if resp.Body == nil || streamRequest(req) {
+		return result
+	}
+	body, readErr := c.bodyBuffers().peek(resp)
+	if readErr != nil {
+		return result
+	}
+	meta := struct {
+		Meta *struct {
+			ResultCount *int `json:"result_count"`
+		} `json:"meta"`
+	}{}
+	if json.Unmarshal(body, &meta) == nil && meta.Meta != nil && meta.Meta.ResultCount != nil {
+		result.ResultCount = *meta.Meta.ResultCount
+		result.HasResultCount = true
+	}
+	return result
+}
@@ -0,0 +1,72 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type spanKey struct{}
+
+type mockSpan struct {
+	endpoint string
+	result   *SpanResult
+}
+
+func (m *mockSpan) End(result *SpanResult) {
+	m.result = result
+}
+
+type mockTracer struct {
+	spans []*mockSpan
+}
+
+func (m *mockTracer) StartSpan(ctx context.Context, endpoint string, req *http.Request) (context.Context, Span) {
+	span := &mockSpan{
+		endpoint: endpoint,
+	}
+	m.spans = append(m.spans, span)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func TestClient_Tracer(t *testing.T) {
+	tracer := &mockTracer{}
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Tracer:     tracer,
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if _, ok := req.Context().Value(spanKey{}).(*mockSpan); !ok {
+				t.Errorf("the request context does not have the span")
+			}
+			header := http.Header{}
+			header.Add(rateLimit, "450")
+			header.Add(rateRemaining, "449")
+			header.Add(rateReset, "1644461060")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"data":[{"id":"1","text":"golang"},{"id":"2","text":"go"}],"meta":{"result_count":2,"newest_id":"2","oldest_id":"1"}}`)),
+			}
+		}),
+	}
+	resp, err := client.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{})
+	if err != nil {
+		t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+	}
+	if len(resp.Raw.Tweets) != 2 {
+		t.Errorf("Client.TweetRecentSearch() = %v, the body was not decoded after tracing", resp.Raw.Tweets)
+	}
+	if len(tracer.spans) != 1 {
+		t.Fatalf("Client.Tracer started %d spans", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.endpoint != "GET 2/tweets/search/recent" || span.result == nil {
+		t.Fatalf("Client.Tracer span = %+v", span)
+	}
+	if span.result.StatusCode != http.StatusOK || !span.result.HasResultCount || span.result.ResultCount != 2 || span.result.RateLimit.Remaining != 449 {
+		t.Errorf("Client.Tracer span result = %+v", span.result)
+	}
+}
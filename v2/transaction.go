@@ -0,0 +1,381 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RollbackFunc will undo a completed transaction step
+type RollbackFunc func(ctx context.Context) error
+
+// TransactionError is returned when a transaction step fails.  The completed steps have been rolled back, best effort,
+// and any rollback failures are present.
+type TransactionError struct {
+	Step           string
+	Err            error
+	RolledBack     []string
+	RollbackErrors []error
+}
+
+func (t *TransactionError) Error() string {
+	msg := fmt.Sprintf("transaction step %s: %v", t.Step, t.Err)
+	if len(t.RollbackErrors) > 0 {
+		errs := make([]string, len(t.RollbackErrors))
+		for i, err := range t.RollbackErrors {
+			errs[i] = err.Error()
+		}
+		msg += fmt.Sprintf(" (rollback errors: %s)", strings.Join(errs, "; "))
+	}
+	return msg
+}
+
+// Unwrap will return the step error
+func (t *TransactionError) Unwrap() error {
+	return t.Err
+}
+
+// detachedContext keeps the values of the parent but is never canceled, so a rollback can run after the
+// step's context has been canceled.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (d detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (d detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (d detachedContext) Err() error {
+	return nil
+}
+
+func (d detachedContext) Value(key interface{}) interface{} {
+	return d.parent.Value(key)
+}
+
+type transactionStep struct {
+	name     string
+	rollback RollbackFunc
+}
+
+// Transaction will track the completed steps of a multi step operation so they can be undone if a later step fails.
+// The rollback is best effort, some operations like deleting a tweet can not be perfectly undone.
+type Transaction struct {
+	mutex sync.Mutex
+	steps []transactionStep
+}
+
+// Step will run the step and, if successful, record its rollback.  If the step fails, the completed steps are
+// rolled back in reverse order and a *TransactionError is returned.  The rollback may be nil if there is nothing to undo.
+func (t *Transaction) Step(ctx context.Context, name string, step func(ctx context.Context) (RollbackFunc, error)) error {
+	rollback, err := step(ctx)
+	if err != nil {
+		rolledBack, rollbackErrs := t.Rollback(detachedContext{ctx})
+		return &TransactionError{
+			Step:           name,
+			Err:            err,
+			RolledBack:     rolledBack,
+			RollbackErrors: rollbackErrs,
+		}
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.steps = append(t.steps, transactionStep{
+		name:     name,
+		rollback: rollback,
+	})
+	return nil
+}
+
+// Completed returns the names of the completed steps
+func (t *Transaction) Completed() []string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	names := make([]string, len(t.steps))
+	for i, s := range t.steps {
+		names[i] = s.name
+	}
+	return names
+}
+
+// Rollback will undo the completed steps in reverse order.  All of the steps are attempted, the names of the rolled
+// back steps and any errors are returned.
+func (t *Transaction) Rollback(ctx context.Context) ([]string, []error) {
+	t.mutex.Lock()
+	steps := t.steps
+	t.steps = nil
+	t.mutex.Unlock()
+
+	rolledBack := []string{}
+	var errs []error
+	for i := len(steps) - 1; i >= 0; i-- {
+		if steps[i].rollback == nil {
+			continue
+		}
+		if err := steps[i].rollback(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("rollback %s: %w", steps[i].name, err))
+			continue
+		}
+		rolledBack = append(rolledBack, steps[i].name)
+	}
+	return rolledBack, errs
+}
+
+// PostThreadResponse is the response from posting a thread
+type PostThreadResponse struct {
+	Tweets    []*CreateTweetData
+	RateLimit *RateLimit
+}
+
+// PostThread will post the tweets as a thread, each tweet is a reply to the one before it.  If the first tweet has a
+// reply, the thread is posted as a reply to that tweet.  If any tweet fails, the tweets already posted are deleted.
+func (c *Client) PostThread(ctx context.Context, tweets []CreateTweetRequest) (*PostThreadResponse, error) {
+	if len(tweets) == 0 {
+		return nil, fmt.Errorf("post thread: a tweet is required: %w", ErrParameter)
+	}
+	for i, tweet := range tweets {
+		if i > 0 && tweet.Reply != nil && len(tweet.Reply.InReplyToTweetID) > 0 {
+			return nil, fmt.Errorf("post thread: tweet %d can not reply to another tweet: %w", i, ErrParameter)
+		}
+		if err := tweet.validate(); err != nil {
+			return nil, fmt.Errorf("post thread: tweet %d: %w", i, err)
+		}
+	}
+
+	tx := &Transaction{}
+	thread := &PostThreadResponse{
+		Tweets: make([]*CreateTweetData, 0, len(tweets)),
+	}
+	for i, tweet := range tweets {
+		if i > 0 {
+			reply := &CreateTweetReply{}
+			if tweet.Reply != nil {
+				*reply = *tweet.Reply
+			}
+			reply.InReplyToTweetID = thread.Tweets[i-1].ID
+			tweet.Reply = reply
+		}
+		err := tx.Step(ctx, fmt.Sprintf("tweet %d", i), func(ctx context.Context) (RollbackFunc, error) {
+			resp, err := c.CreateTweet(ctx, tweet)
+			if err != nil {
+				return nil, err
+			}
+			thread.Tweets = append(thread.Tweets, resp.Tweet)
+			thread.RateLimit = resp.RateLimit
+			return func(ctx context.Context) error {
+				_, err := c.DeleteTweet(ctx, resp.Tweet.ID)
+				return err
+			}, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("post thread: %w", err)
+		}
+	}
+	return thread, nil
+}
+
+// SyncListMembersResponse is the response from syncing the list members
+type SyncListMembersResponse struct {
+	Added     []string
+	Removed   []string
+	RateLimit *RateLimit
+}
+
+// SyncListMembers will add and remove members so the list has exactly the user ids.  If any change fails, the
+// completed changes are undone.
+func (c *Client) SyncListMembers(ctx context.Context, listID string, userIDs []string) (*SyncListMembersResponse, error) {
+	if len(listID) == 0 {
+		return nil, fmt.Errorf("sync list members: a list id is required: %w", ErrParameter)
+	}
+
+	current := map[string]bool{}
+	members := []string{}
+	opts := ListUserMembersOpts{
+		MaxResults: listUserMemberMaxResults,
+	}
+	for {
+		resp, err := c.ListUserMembers(ctx, listID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("sync list members: %w", err)
+		}
+		if resp.Raw != nil {
+			for _, user := range resp.Raw.Users {
+				if user != nil && !current[user.ID] {
+					current[user.ID] = true
+					members = append(members, user.ID)
+				}
+			}
+		}
+		if resp.Meta == nil || len(resp.Meta.NextToken) == 0 {
+			break
+		}
+		opts.PaginationToken = resp.Meta.NextToken
+	}
+
+	wanted := map[string]bool{}
+	for _, id := range userIDs {
+		wanted[id] = true
+	}
+
+	tx := &Transaction{}
+	result := &SyncListMembersResponse{
+		Added:   []string{},
+		Removed: []string{},
+	}
+	for _, id := range unique(userIDs) {
+		if current[id] {
+			continue
+		}
+		userID := id
+		err := tx.Step(ctx, "add "+userID, func(ctx context.Context) (RollbackFunc, error) {
+			resp, err := c.AddListMember(ctx, listID, userID)
+			if err != nil {
+				return nil, err
+			}
+			result.Added = append(result.Added, userID)
+			result.RateLimit = resp.RateLimit
+			return func(ctx context.Context) error {
+				_, err := c.RemoveListMember(ctx, listID, userID)
+				return err
+			}, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("sync list members: %w", err)
+		}
+	}
+	for _, id := range members {
+		if wanted[id] {
+			continue
+		}
+		userID := id
+		err := tx.Step(ctx, "remove "+userID, func(ctx context.Context) (RollbackFunc, error) {
+			resp, err := c.RemoveListMember(ctx, listID, userID)
+			if err != nil {
+				return nil, err
+			}
+			result.Removed = append(result.Removed, userID)
+			result.RateLimit = resp.RateLimit
+			return func(ctx context.Context) error {
+				_, err := c.AddListMember(ctx, listID, userID)
+				return err
+			}, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("sync list members: %w", err)
+		}
+	}
+	return result, nil
+}
+
+// RetagStreamRulesResponse is the response from retagging the stream rules
+type RetagStreamRulesResponse struct {
+	Rules     []*TweetSearchStreamRuleEntity
+	RateLimit *RateLimit
+}
+
+// RetagStreamRules will replace the tag of the filtered stream rules that have the old tag.  The rules can not be
+// updated in place and a value can only have one rule, so the old rules are deleted and then the retagged rules are
+// added.  If the add fails, the retagged rules that were created are deleted and the old rules are restored.
+func (c *Client) RetagStreamRules(ctx context.Context, oldTag, newTag string) (*RetagStreamRulesResponse, error) {
+	if len(oldTag) == 0 || len(newTag) == 0 || oldTag == newTag {
+		return nil, fmt.Errorf("retag stream rules: the old and new tags are required and must be different: %w", ErrParameter)
+	}
+
+	current, err := c.TweetSearchStreamRules(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("retag stream rules: %w", err)
+	}
+	oldRules := []*TweetSearchStreamRuleEntity{}
+	for _, rule := range current.Rules {
+		if rule != nil && rule.Tag == oldTag {
+			oldRules = append(oldRules, rule)
+		}
+	}
+	retag := &RetagStreamRulesResponse{
+		Rules:     []*TweetSearchStreamRuleEntity{},
+		RateLimit: current.RateLimit,
+	}
+	if len(oldRules) == 0 {
+		return retag, nil
+	}
+
+	tx := &Transaction{}
+	err = tx.Step(ctx, "delete rules", func(ctx context.Context) (RollbackFunc, error) {
+		ids := make([]TweetSearchStreamRuleID, len(oldRules))
+		for i, rule := range oldRules {
+			ids[i] = rule.ID
+		}
+		resp, err := c.TweetSearchStreamDeleteRuleByID(ctx, ids, false)
+		if err != nil {
+			return nil, err
+		}
+		retag.RateLimit = resp.RateLimit
+		return func(ctx context.Context) error {
+			return c.addStreamRules(ctx, oldRules, oldTag)
+		}, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retag stream rules: %w", err)
+	}
+
+	err = tx.Step(ctx, "add rules", func(ctx context.Context) (RollbackFunc, error) {
+		rules := make([]TweetSearchStreamRule, len(oldRules))
+		for i, rule := range oldRules {
+			rules[i] = TweetSearchStreamRule{
+				Value: rule.Value,
+				Tag:   newTag,
+			}
+		}
+		resp, err := c.TweetSearchStreamAddRule(ctx, rules, false)
+		if err != nil {
+			return nil, err
+		}
+		ids := []TweetSearchStreamRuleID{}
+		for _, rule := range resp.Rules {
+			if rule != nil {
+				ids = append(ids, rule.ID)
+				retag.Rules = append(retag.Rules, rule)
+			}
+		}
+		retag.RateLimit = resp.RateLimit
+		if len(ids) != len(rules) {
+			err := fmt.Errorf("%d of %d rules were not created", len(rules)-len(ids), len(rules))
+			if len(ids) > 0 {
+				if _, rerr := c.TweetSearchStreamDeleteRuleByID(ctx, ids, false); rerr != nil {
+					err = fmt.Errorf("%v and the created rules could not be removed: %w", err, rerr)
+				}
+			}
+			return nil, err
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retag stream rules: %w", err)
+	}
+	return retag, nil
+}
+
+// addStreamRules will add the rules with the tag, it is the rollback of deleting them
+func (c *Client) addStreamRules(ctx context.Context, rules []*TweetSearchStreamRuleEntity, tag string) error {
+	add := make([]TweetSearchStreamRule, len(rules))
+	for i, rule := range rules {
+		add[i] = TweetSearchStreamRule{
+			Value: rule.Value,
+			Tag:   tag,
+		}
+	}
+	resp, err := c.TweetSearchStreamAddRule(ctx, add, false)
+	if err != nil {
+		return err
+	}
+	if created := len(resp.Rules); created != len(add) {
+		return fmt.Errorf("%d of %d rules were not restored", len(add)-created, len(add))
+	}
+	return nil
+}
@@ -0,0 +1,291 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestTransaction(t *testing.T) {
+	undone := []string{}
+	step := func(name string, fail bool) func(ctx context.Context) (RollbackFunc, error) {
+		return func(ctx context.Context) (RollbackFunc, error) {
+			if fail {
+				return nil, errors.New("step failed")
+			}
+			return func(ctx context.Context) error {
+				undone = append(undone, name)
+				return nil
+			}, nil
+		}
+	}
+
+	tx := &Transaction{}
+	if err := tx.Step(context.Background(), "one", step("one", false)); err != nil {
+		t.Fatalf("Transaction.Step() error = %v", err)
+	}
+	if err := tx.Step(context.Background(), "two", step("two", false)); err != nil {
+		t.Fatalf("Transaction.Step() error = %v", err)
+	}
+	if got := tx.Completed(); !reflect.DeepEqual(got, []string{"one", "two"}) {
+		t.Errorf("Transaction.Completed() = %v", got)
+	}
+
+	err := tx.Step(context.Background(), "three", step("three", true))
+	var txErr *TransactionError
+	if !errors.As(err, &txErr) {
+		t.Fatalf("Transaction.Step() error = %v, want a transaction error", err)
+	}
+	if txErr.Step != "three" || !reflect.DeepEqual(txErr.RolledBack, []string{"two", "one"}) {
+		t.Errorf("Transaction.Step() error = %+v", txErr)
+	}
+	if !reflect.DeepEqual(undone, []string{"two", "one"}) {
+		t.Errorf("Transaction rollback order = %v", undone)
+	}
+	if len(tx.Completed()) != 0 {
+		t.Errorf("Transaction.Completed() after rollback = %v", tx.Completed())
+	}
+}
+
+func TestClient_PostThread(t *testing.T) {
+	var mutex sync.Mutex
+	deleted := []string{}
+	posted := 0
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			mutex.Lock()
+			defer mutex.Unlock()
+			switch req.Method {
+			case http.MethodPost:
+				tweet := CreateTweetRequest{}
+				if err := json.NewDecoder(req.Body).Decode(&tweet); err != nil {
+					log.Panicf("the request body is not correct %v", err)
+				}
+				posted++
+				if posted > 1 && (tweet.Reply == nil || tweet.Reply.InReplyToTweetID != fmt.Sprintf("%d", posted-1)) {
+					log.Panicf("the tweet is not a reply to the previous tweet %v", tweet.Reply)
+				}
+				if tweet.Text == "fail" {
+					return &http.Response{
+						StatusCode: http.StatusServiceUnavailable,
+						Body:       io.NopCloser(strings.NewReader(`{"title":"Service Unavailable","detail":"Service Unavailable","type":"about:blank","status":503}`)),
+					}
+				}
+				return &http.Response{
+					StatusCode: http.StatusCreated,
+					Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`{"data":{"id":"%d","text":"%s"}}`, posted, tweet.Text))),
+				}
+			case http.MethodDelete:
+				parts := strings.Split(req.URL.Path, "/")
+				deleted = append(deleted, parts[len(parts)-1])
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"data":{"deleted":true}}`)),
+				}
+			default:
+				log.Panicf("the method is not correct %s", req.Method)
+			}
+			return nil
+		}),
+	}
+
+	thread, err := client.PostThread(context.Background(), []CreateTweetRequest{{Text: "1/2"}, {Text: "2/2"}})
+	if err != nil {
+		t.Fatalf("Client.PostThread() error = %v", err)
+	}
+	if len(thread.Tweets) != 2 || thread.Tweets[1].ID != "2" {
+		t.Errorf("Client.PostThread() = %v", thread.Tweets)
+	}
+
+	posted = 0
+	_, err = client.PostThread(context.Background(), []CreateTweetRequest{{Text: "1/3"}, {Text: "2/3"}, {Text: "fail"}})
+	var txErr *TransactionError
+	if !errors.As(err, &txErr) {
+		t.Fatalf("Client.PostThread() error = %v, want a transaction error", err)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Client.PostThread() error = %v, want the callout error", err)
+	}
+	if !reflect.DeepEqual(deleted, []string{"2", "1"}) {
+		t.Errorf("Client.PostThread() deleted = %v", deleted)
+	}
+}
+
+func TestClient_SyncListMembers(t *testing.T) {
+	added := []string{}
+	removed := []string{}
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			body := `{"data":{"is_member":true}}`
+			switch req.Method {
+			case http.MethodGet:
+				body = `{"data":[{"id":"1","name":"one","username":"one"},{"id":"2","name":"two","username":"two"}],"meta":{"result_count":2}}`
+			case http.MethodPost:
+				member := struct {
+					UserID string `json:"user_id"`
+				}{}
+				if err := json.NewDecoder(req.Body).Decode(&member); err != nil {
+					log.Panicf("the request body is not correct %v", err)
+				}
+				added = append(added, member.UserID)
+			case http.MethodDelete:
+				parts := strings.Split(req.URL.Path, "/")
+				removed = append(removed, parts[len(parts)-1])
+				body = `{"data":{"is_member":false}}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+
+	got, err := client.SyncListMembers(context.Background(), "1234", []string{"2", "3"})
+	if err != nil {
+		t.Fatalf("Client.SyncListMembers() error = %v", err)
+	}
+	if !reflect.DeepEqual(got.Added, []string{"3"}) || !reflect.DeepEqual(got.Removed, []string{"1"}) {
+		t.Errorf("Client.SyncListMembers() = %+v", got)
+	}
+	if !reflect.DeepEqual(added, []string{"3"}) || !reflect.DeepEqual(removed, []string{"1"}) {
+		t.Errorf("Client.SyncListMembers() sent added %v removed %v", added, removed)
+	}
+}
+
+// streamRulesClient is a mock of the rules endpoint that keeps the rules and, like twitter, does not create a rule
+// with a value that already exists.  Adding a rule with the fail tag is an unavailable error.
+func streamRulesClient(rules map[string]TweetSearchStreamRule, failTag string) *Client {
+	type rulesBody struct {
+		Add    []TweetSearchStreamRule `json:"add"`
+		Delete *struct {
+			IDs []string `json:"ids"`
+		} `json:"delete"`
+	}
+	next := 100
+	return &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.Method == http.MethodGet {
+				data := []string{}
+				for id, rule := range rules {
+					data = append(data, fmt.Sprintf(`{"id":"%s","value":"%s","tag":"%s"}`, id, rule.Value, rule.Tag))
+				}
+				body := fmt.Sprintf(`{"data":[%s],"meta":{"sent":"2021-06-01T00:00:00.000Z"}}`, strings.Join(data, ","))
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(body)),
+				}
+			}
+			rb := rulesBody{}
+			if err := json.NewDecoder(req.Body).Decode(&rb); err != nil {
+				log.Panicf("the request body is not correct %v", err)
+			}
+			switch {
+			case len(rb.Add) > 0:
+				if rb.Add[0].Tag == failTag {
+					return &http.Response{
+						StatusCode: http.StatusServiceUnavailable,
+						Body:       io.NopCloser(strings.NewReader(`{"title":"Service Unavailable","detail":"Service Unavailable","type":"about:blank","status":503}`)),
+					}
+				}
+				data := []string{}
+				errs := []string{}
+				for _, add := range rb.Add {
+					duplicate := ""
+					for id, rule := range rules {
+						if rule.Value == add.Value {
+							duplicate = id
+						}
+					}
+					if len(duplicate) > 0 {
+						errs = append(errs, fmt.Sprintf(`{"value":"%s","id":"%s","title":"DuplicateRule","type":"https://api.twitter.com/2/problems/duplicate-rules"}`, add.Value, duplicate))
+						continue
+					}
+					next++
+					id := fmt.Sprint(next)
+					rules[id] = add
+					data = append(data, fmt.Sprintf(`{"id":"%s","value":"%s","tag":"%s"}`, id, add.Value, add.Tag))
+				}
+				body := fmt.Sprintf(`{"data":[%s],"errors":[%s],"meta":{"sent":"2021-06-01T00:00:00.000Z","summary":{"created":%d,"not_created":%d}}}`, strings.Join(data, ","), strings.Join(errs, ","), len(data), len(errs))
+				return &http.Response{
+					StatusCode: http.StatusCreated,
+					Body:       io.NopCloser(strings.NewReader(body)),
+				}
+			case rb.Delete != nil:
+				for _, id := range rb.Delete.IDs {
+					delete(rules, id)
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`{"meta":{"sent":"2021-06-01T00:00:00.000Z","summary":{"deleted":%d}}}`, len(rb.Delete.IDs)))),
+				}
+			}
+			log.Panicf("the request is not correct %v", rb)
+			return nil
+		}),
+	}
+}
+
+func streamRuleTags(rules map[string]TweetSearchStreamRule) map[string]string {
+	tags := map[string]string{}
+	for _, rule := range rules {
+		tags[rule.Value] = rule.Tag
+	}
+	return tags
+}
+
+func TestClient_RetagStreamRules(t *testing.T) {
+	rules := map[string]TweetSearchStreamRule{
+		"1": {Value: "golang", Tag: "old"},
+		"2": {Value: "rust", Tag: "other"},
+		"3": {Value: "gopher", Tag: "old"},
+	}
+	client := streamRulesClient(rules, "")
+
+	retag, err := client.RetagStreamRules(context.Background(), "old", "new")
+	if err != nil {
+		t.Fatalf("Client.RetagStreamRules() error = %v", err)
+	}
+	if len(retag.Rules) != 2 {
+		t.Errorf("Client.RetagStreamRules() rules = %d, want 2", len(retag.Rules))
+	}
+	want := map[string]string{"golang": "new", "rust": "other", "gopher": "new"}
+	if got := streamRuleTags(rules); !reflect.DeepEqual(got, want) {
+		t.Errorf("Client.RetagStreamRules() rules = %v, want %v", got, want)
+	}
+}
+
+func TestClient_RetagStreamRules_Rollback(t *testing.T) {
+	rules := map[string]TweetSearchStreamRule{
+		"1": {Value: "golang", Tag: "old"},
+		"2": {Value: "rust", Tag: "other"},
+	}
+	client := streamRulesClient(rules, "new")
+
+	_, err := client.RetagStreamRules(context.Background(), "old", "new")
+	var txErr *TransactionError
+	if !errors.As(err, &txErr) || txErr.Step != "add rules" {
+		t.Fatalf("Client.RetagStreamRules() error = %v, want the add step to fail", err)
+	}
+	if len(txErr.RollbackErrors) > 0 {
+		t.Errorf("Client.RetagStreamRules() rollback errors = %v", txErr.RollbackErrors)
+	}
+	want := map[string]string{"golang": "old", "rust": "other"}
+	if got := streamRuleTags(rules); !reflect.DeepEqual(got, want) {
+		t.Errorf("Client.RetagStreamRules() rules = %v, want the old rules restored %v", got, want)
+	}
+}
@@ -0,0 +1,57 @@
+package twitter
+
+// TrendField are the trend field options
+type TrendField string
+
+const (
+	// TrendFieldTrendName is the trend name field
+	TrendFieldTrendName TrendField = "trend_name"
+	// TrendFieldTweetCount is the trend tweet count field
+	TrendFieldTweetCount TrendField = "tweet_count"
+)
+
+func trendFieldStringArray(arr []TrendField) []string {
+	strs := make([]string, len(arr))
+	for i, field := range arr {
+		strs[i] = string(field)
+	}
+	return strs
+}
+
+// PersonalizedTrendField are the personalized trend field options
+type PersonalizedTrendField string
+
+const (
+	// PersonalizedTrendFieldCategory is the personalized trend category field
+	PersonalizedTrendFieldCategory PersonalizedTrendField = "category"
+	// PersonalizedTrendFieldPostCount is the personalized trend post count field
+	PersonalizedTrendFieldPostCount PersonalizedTrendField = "post_count"
+	// PersonalizedTrendFieldTrendName is the personalized trend name field
+	PersonalizedTrendFieldTrendName PersonalizedTrendField = "trend_name"
+	// PersonalizedTrendFieldTrendingSince is the personalized trend trending since field
+	PersonalizedTrendFieldTrendingSince PersonalizedTrendField = "trending_since"
+)
+
+func personalizedTrendFieldStringArray(arr []PersonalizedTrendField) []string {
+	strs := make([]string, len(arr))
+	for i, field := range arr {
+		strs[i] = string(field)
+	}
+	return strs
+}
+
+// TrendObj is a trending topic for a location.  The tweet count is the volume of tweets and may be zero
+// if twitter does not report it.
+type TrendObj struct {
+	TrendName  string `json:"trend_name"`
+	TweetCount int    `json:"tweet_count,omitempty"`
+}
+
+// PersonalizedTrendObj is a trending topic for the authenticated user.  The post count is a display
+// string, like 12.5K posts.
+type PersonalizedTrendObj struct {
+	Category      string `json:"category,omitempty"`
+	PostCount     string `json:"post_count,omitempty"`
+	TrendName     string `json:"trend_name"`
+	TrendingSince string `json:"trending_since,omitempty"`
+}
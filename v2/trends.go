@@ -0,0 +1,47 @@
+package twitter
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// TrendsByWOEIDOpts are the trends by location options
+type TrendsByWOEIDOpts struct {
+	MaxTrends   int
+	TrendFields []TrendField
+}
+
+func (t TrendsByWOEIDOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if t.MaxTrends > 0 {
+		q.Add("max_trends", strconv.Itoa(t.MaxTrends))
+	}
+	if len(t.TrendFields) > 0 {
+		q.Add("trend.fields", strings.Join(trendFieldStringArray(t.TrendFields), ","))
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// TrendsByWOEIDResponse is the response from the trends by location
+type TrendsByWOEIDResponse = Response[[]*TrendObj, NoMeta]
+
+// PersonalizedTrendsOpts are the personalized trends options
+type PersonalizedTrendsOpts struct {
+	PersonalizedTrendFields []PersonalizedTrendField
+}
+
+func (p PersonalizedTrendsOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if len(p.PersonalizedTrendFields) > 0 {
+		q.Add("personalized_trend.fields", strings.Join(personalizedTrendFieldStringArray(p.PersonalizedTrendFields), ","))
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// PersonalizedTrendsResponse is the response from the personalized trends
+type PersonalizedTrendsResponse = Response[[]*PersonalizedTrendObj, NoMeta]
@@ -78,3 +78,31 @@ type RemoveTweetBookmarkResponse struct {
 type TweetBookmarkData struct {
 	Bookmarked bool `json:"bookmarked"`
 }
+
+// TweetBookmarkFoldersOpts are the bookmark folders lookup options
+type TweetBookmarkFoldersOpts struct {
+	MaxResults      int
+	PaginationToken string
+}
+
+func (t TweetBookmarkFoldersOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if t.MaxResults > 0 {
+		q.Add("max_results", strconv.Itoa(t.MaxResults))
+	}
+	if len(t.PaginationToken) > 0 {
+		q.Add("pagination_token", t.PaginationToken)
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// BookmarkFolderObj is a folder of the user's bookmarks
+type BookmarkFolderObj struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// TweetBookmarkFoldersResponse is the response to the bookmark folders lookup
+type TweetBookmarkFoldersResponse = Response[[]*BookmarkFolderObj, *TweetBookmarksLookupMeta]
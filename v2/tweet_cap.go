@@ -0,0 +1,344 @@
+package twitter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// tweetCapEndpoints are the endpoints that read posts, which are counted against the monthly cap
+var tweetCapEndpoints = []string{
+	string(tweetLookupEndpoint),
+	string(tweetLookupEndpoint) + "/" + idTag,
+	string(tweetRecentSearchEndpoint),
+	string(tweetSearchEndpoint),
+	string(userTweetTimelineEndpoint),
+	string(userMentionTimelineEndpoint),
+	string(userTweetReverseChronologicalTimelineEndpoint),
+	string(userLikedTweetEndpoint),
+	string(listTweetLookupEndpoint),
+	string(spaceTweetsLookupEndpoint),
+	string(quoteTweetLookupEndpoint),
+	string(tweetBookmarksEndpoint),
+	string(tweetBookmarkFoldersEndpoint) + "/" + idTag,
+	string(notesEligiblePostsEndpoint),
+	string(tweetSampleStreamEndpoint),
+	string(tweetSearchStreamEndpoint),
+}
+
+// TweetCapThreshold is a percent of the monthly cap.  The client warns when the threshold is reached and, with Stop,
+// the requests that read posts are not sent until the cap resets.
+type TweetCapThreshold struct {
+	Percent int
+	Stop    bool
+}
+
+var defaultTweetCapThresholds = []TweetCapThreshold{
+	{Percent: 80},
+	{Percent: 100, Stop: true},
+}
+
+// TweetCapUsage is the number of posts read in the current cap period
+type TweetCapUsage struct {
+	Cap     int64
+	Used    int64
+	Reset   time.Time
+	Stopped bool
+}
+
+// TweetCapExceededError has the usage and the threshold that stopped the request
+type TweetCapExceededError struct {
+	Usage     TweetCapUsage
+	Threshold TweetCapThreshold
+}
+
+func (t *TweetCapExceededError) Error() string {
+	return fmt.Sprintf("%s: %d of the monthly cap of %d posts have been read, the %d%% threshold stops reads until %s", ErrBudgetExceeded.Error(), t.Usage.Used, t.Usage.Cap, t.Threshold.Percent, t.Usage.Reset.Format(time.RFC3339))
+}
+
+// Is will match ErrBudgetExceeded
+func (t *TweetCapExceededError) Is(target error) bool {
+	return target == ErrBudgetExceeded
+}
+
+// TweetCap will count the posts read by the client against the project's monthly cap.  The posts are counted from the
+// responses of the endpoints that read posts and from the streams.  ResetDay is the day of the month, in UTC, the cap
+// resets, it defaults to the first.  Thresholds default to a warning at 80% and a stop at 100%.  OnThreshold is
+// optional and is called when a threshold is reached, the client's warning channel also receives a warning.
+//
+// The count only has the posts read by the clients that share the tracker, Seed or Client.SeedTweetCap will set the
+// count from the usage API.
+type TweetCap struct {
+	Cap         int64
+	ResetDay    int
+	Thresholds  []TweetCapThreshold
+	OnThreshold func(usage TweetCapUsage, threshold TweetCapThreshold)
+
+	mutex   sync.Mutex
+	used    int64
+	start   time.Time
+	reset   time.Time
+	reached map[int]bool
+	now     func() time.Time
+}
+
+func (t *TweetCap) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+func (t *TweetCap) thresholds() []TweetCapThreshold {
+	if len(t.Thresholds) > 0 {
+		return t.Thresholds
+	}
+	return defaultTweetCapThresholds
+}
+
+// roll will start a new count when the cap period has reset
+func (t *TweetCap) roll(now time.Time) {
+	start, reset := tweetCapPeriod(now, t.ResetDay)
+	if start.Equal(t.start) {
+		return
+	}
+	t.start = start
+	t.reset = reset
+	t.used = 0
+	t.reached = map[int]bool{}
+}
+
+// tweetCapPeriod returns the start and the reset of the cap period, a reset day past the end of a month is the last day
+func tweetCapPeriod(now time.Time, day int) (time.Time, time.Time) {
+	if day <= 0 {
+		day = 1
+	}
+	now = now.UTC()
+	resetDate := func(year int, month time.Month) time.Time {
+		last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		if day < last {
+			last = day
+		}
+		return time.Date(year, month, last, 0, 0, 0, 0, time.UTC)
+	}
+	start := resetDate(now.Year(), now.Month())
+	if start.After(now) {
+		start = resetDate(now.Year(), now.Month()-1)
+	}
+	return start, resetDate(start.Year(), start.Month()+1)
+}
+
+func (t *TweetCap) usage() TweetCapUsage {
+	usage := TweetCapUsage{
+		Cap:   t.Cap,
+		Used:  t.used,
+		Reset: t.reset,
+	}
+	_, usage.Stopped = t.stopped()
+	return usage
+}
+
+// stopped returns the reached threshold that stops the reads
+func (t *TweetCap) stopped() (TweetCapThreshold, bool) {
+	for i, threshold := range t.thresholds() {
+		if threshold.Stop && t.reached[i] {
+			return threshold, true
+		}
+	}
+	return TweetCapThreshold{}, false
+}
+
+// reach will record and return the thresholds that have been reached since the last call
+func (t *TweetCap) reach() []TweetCapThreshold {
+	if t.Cap <= 0 {
+		return nil
+	}
+	reached := []TweetCapThreshold{}
+	for i, threshold := range t.thresholds() {
+		if !t.reached[i] && t.used*100 >= t.Cap*int64(threshold.Percent) {
+			t.reached[i] = true
+			reached = append(reached, threshold)
+		}
+	}
+	return reached
+}
+
+// Usage returns the posts read in the current cap period
+func (t *TweetCap) Usage() TweetCapUsage {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.roll(t.clock())
+	return t.usage()
+}
+
+// Seed will set the cap, the reset day and the posts read from the usage API.  The thresholds that the usage has
+// already reached are returned.
+func (t *TweetCap) Seed(usage *TweetUsageObj) ([]TweetCapThreshold, error) {
+	if usage == nil {
+		return nil, fmt.Errorf("tweet cap seed: a usage is required: %w", ErrParameter)
+	}
+	used, err := usage.ProjectUsage.Int64()
+	if err != nil {
+		return nil, fmt.Errorf("tweet cap seed: project usage [%s]: %v: %w", usage.ProjectUsage, err, ErrParameter)
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if projectCap, err := usage.ProjectCap.Int64(); err == nil && projectCap > 0 {
+		t.Cap = projectCap
+	}
+	if usage.CapResetDay > 0 {
+		t.ResetDay = usage.CapResetDay
+	}
+	t.start = time.Time{}
+	t.roll(t.clock())
+	t.used = used
+	return t.reach(), nil
+}
+
+// allow will return an error if a stop threshold has been reached
+func (t *TweetCap) allow() error {
+	if t == nil {
+		return nil
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.roll(t.clock())
+	threshold, stopped := t.stopped()
+	if !stopped {
+		return nil
+	}
+	return &TweetCapExceededError{
+		Usage:     t.usage(),
+		Threshold: threshold,
+	}
+}
+
+// consume will count the posts and return the thresholds that have been reached
+func (t *TweetCap) consume(posts int64) (TweetCapUsage, []TweetCapThreshold) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.roll(t.clock())
+	t.used += posts
+	reached := t.reach()
+	return t.usage(), reached
+}
+
+func tweetCapRequest(req *http.Request) bool {
+	if req.Method != http.MethodGet || req.URL == nil {
+		return false
+	}
+	segments := pathSegments(req.URL.Path)
+	for _, pattern := range tweetCapEndpoints {
+		if start, _, _, ok := matchEndpoint(pathSegments(pattern), segments); ok && start == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// checkTweetCap will return an error if the request reads posts and the cap has stopped the reads
+func (c *Client) checkTweetCap(req *http.Request) error {
+	if c.TweetCap == nil || !tweetCapRequest(req) {
+		return nil
+	}
+	return c.TweetCap.allow()
+}
+
+// countTweetCap will count the posts of a successful response, the body is replaced so it can still be decoded.
+// Streams are counted as the tweets are received.
+func (c *Client) countTweetCap(req *http.Request, resp *http.Response) {
+	if c.TweetCap == nil || resp == nil || resp.Body == nil || resp.StatusCode != http.StatusOK || streamRequest(req) || !tweetCapRequest(req) {
+		return
+	}
+	body, err := c.bodyBuffers().peek(resp)
+	if err != nil {
+		return
+	}
+	data := struct {
+		Data json.RawMessage `json:"data"`
+	}{}
+	if json.Unmarshal(body, &data) != nil {
+		return
+	}
+	posts := 0
+	switch trimmed := bytes.TrimSpace(data.Data); {
+	case len(trimmed) == 0:
+	case trimmed[0] == '[':
+		tweets := []json.RawMessage{}
+		if json.Unmarshal(trimmed, &tweets) == nil {
+			posts = len(tweets)
+		}
+	case trimmed[0] == '{':
+		posts = 1
+	default:
+	}
+	c.consumeTweetCap(req, posts)
+}
+
+// tweetCapConsumer returns the function a stream calls for each tweet, nil if the client does not have a tweet cap
+func (c *Client) tweetCapConsumer(req *http.Request) func(posts int) {
+	if c.TweetCap == nil {
+		return nil
+	}
+	return func(posts int) {
+		c.consumeTweetCap(req, posts)
+	}
+}
+
+func (c *Client) consumeTweetCap(req *http.Request, posts int) {
+	if posts <= 0 {
+		return
+	}
+	usage, reached := c.TweetCap.consume(int64(posts))
+	c.notifyTweetCap(req, usage, reached)
+}
+
+// notifyTweetCap will warn and call the tweet cap's hook for each reached threshold
+func (c *Client) notifyTweetCap(req *http.Request, usage TweetCapUsage, reached []TweetCapThreshold) {
+	for _, threshold := range reached {
+		action := "warning"
+		if threshold.Stop {
+			action = "reads are stopped"
+		}
+		w := &Warning{
+			Type:    WarningTweetCap,
+			Message: fmt.Sprintf("%d of the monthly cap of %d posts have been read, the %d%% threshold is reached and %s until %s", usage.Used, usage.Cap, threshold.Percent, action, usage.Reset.Format(time.RFC3339)),
+		}
+		if req != nil {
+			w.Method = req.Method
+			w.URL = req.URL.String()
+			if op, ok := OperationFromContext(req.Context()); ok {
+				w.Operation = op.Name
+			}
+		}
+		c.warn(w)
+		if c.TweetCap.OnThreshold != nil {
+			c.TweetCap.OnThreshold(usage, threshold)
+		}
+	}
+}
+
+// SeedTweetCap will set the client's tweet cap from the usage API, so the posts read before the client started are
+// counted
+func (c *Client) SeedTweetCap(ctx context.Context) (TweetCapUsage, error) {
+	if c.TweetCap == nil {
+		return TweetCapUsage{}, fmt.Errorf("seed tweet cap: the client does not have a tweet cap: %w", ErrParameter)
+	}
+	resp, err := c.TweetUsage(ctx, TweetUsageOpts{
+		UsageFields: []UsageField{UsageFieldCapResetDay, UsageFieldProjectCap, UsageFieldProjectUsage},
+	})
+	if err != nil {
+		return TweetCapUsage{}, fmt.Errorf("seed tweet cap: %w", err)
+	}
+	reached, err := c.TweetCap.Seed(resp.Data)
+	if err != nil {
+		return TweetCapUsage{}, err
+	}
+	usage := c.TweetCap.Usage()
+	c.notifyTweetCap(nil, usage, reached)
+	return usage, nil
+}
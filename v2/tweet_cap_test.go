@@ -0,0 +1,207 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTweetCapPeriod(t *testing.T) {
+	tests := []struct {
+		name      string
+		now       time.Time
+		day       int
+		wantStart time.Time
+		wantReset time.Time
+	}{
+		{
+			name:      "default day",
+			now:       time.Date(2022, time.June, 10, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "before the reset day",
+			now:       time.Date(2022, time.June, 10, 12, 0, 0, 0, time.UTC),
+			day:       19,
+			wantStart: time.Date(2022, time.May, 19, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2022, time.June, 19, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "reset day past the end of the month",
+			now:       time.Date(2022, time.February, 10, 12, 0, 0, 0, time.UTC),
+			day:       31,
+			wantStart: time.Date(2022, time.January, 31, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2022, time.February, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "last day of the month",
+			now:       time.Date(2022, time.February, 28, 12, 0, 0, 0, time.UTC),
+			day:       31,
+			wantStart: time.Date(2022, time.February, 28, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2022, time.March, 31, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, reset := tweetCapPeriod(tt.now, tt.day)
+			if !start.Equal(tt.wantStart) || !reset.Equal(tt.wantReset) {
+				t.Errorf("tweetCapPeriod() = %v, %v, want %v, %v", start, reset, tt.wantStart, tt.wantReset)
+			}
+		})
+	}
+}
+
+func TestClient_TweetCap(t *testing.T) {
+	now := time.Date(2022, time.June, 10, 12, 0, 0, 0, time.UTC)
+	reached := []TweetCapThreshold{}
+	warnings := make(chan *Warning, 10)
+	sent := 0
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Warnings:   warnings,
+		TweetCap: &TweetCap{
+			Cap: 3,
+			OnThreshold: func(usage TweetCapUsage, threshold TweetCapThreshold) {
+				reached = append(reached, threshold)
+			},
+			now: func() time.Time { return now },
+		},
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			sent++
+			body := `{"data":[{"id":"2","text":"hello"},{"id":"1","text":"hello"}],"meta":{"result_count":2}}`
+			if strings.Contains(req.URL.Path, "/2/users/") {
+				body = `{"data":{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev"}}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+
+	if _, err := c.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{}); err != nil {
+		t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+	}
+	if len(reached) != 0 {
+		t.Errorf("TweetCap.OnThreshold() reached = %v, want none", reached)
+	}
+	resp, err := c.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{})
+	if err != nil {
+		t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+	}
+	if len(resp.Raw.Tweets) != 2 {
+		t.Errorf("Client.TweetRecentSearch() tweets = %d, the counted body was not decoded", len(resp.Raw.Tweets))
+	}
+	want := []TweetCapThreshold{{Percent: 80}, {Percent: 100, Stop: true}}
+	if fmt.Sprint(reached) != fmt.Sprint(want) {
+		t.Errorf("TweetCap.OnThreshold() reached = %v, want %v", reached, want)
+	}
+	if len(warnings) != 2 {
+		t.Errorf("Client.Warnings = %d, want 2", len(warnings))
+	} else if w := <-warnings; w.Type != WarningTweetCap || w.Method != http.MethodGet {
+		t.Errorf("Client.Warnings = %+v", w)
+	}
+
+	_, err = c.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{})
+	capErr := &TweetCapExceededError{}
+	if !errors.Is(err, ErrBudgetExceeded) || !errors.As(err, &capErr) {
+		t.Fatalf("Client.TweetRecentSearch() error = %v, want %v", err, ErrBudgetExceeded)
+	}
+	if capErr.Usage.Used != 4 || !capErr.Usage.Reset.Equal(time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("TweetCapExceededError usage = %+v", capErr.Usage)
+	}
+	if sent != 2 {
+		t.Errorf("Client.TweetRecentSearch() sent %d requests, want 2", sent)
+	}
+	if _, err := c.UserLookup(context.Background(), []string{"2244994945"}, UserLookupOpts{}); err != nil {
+		t.Errorf("Client.UserLookup() error = %v, users are not counted against the cap", err)
+	}
+
+	now = now.AddDate(0, 1, 0)
+	if _, err := c.TweetRecentSearch(context.Background(), "golang", TweetRecentSearchOpts{}); err != nil {
+		t.Errorf("Client.TweetRecentSearch() after the reset error = %v", err)
+	}
+	if usage := c.TweetCap.Usage(); usage.Used != 2 || usage.Stopped {
+		t.Errorf("TweetCap.Usage() = %+v", usage)
+	}
+}
+
+func TestClient_SeedTweetCap(t *testing.T) {
+	now := time.Date(2022, time.June, 10, 12, 0, 0, 0, time.UTC)
+	warnings := make(chan *Warning, 10)
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Warnings:   warnings,
+		TweetCap: &TweetCap{
+			now: func() time.Time { return now },
+		},
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"cap_reset_day":19,"project_cap":"100","project_usage":"85"}}`)),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+
+	usage, err := c.SeedTweetCap(context.Background())
+	if err != nil {
+		t.Fatalf("Client.SeedTweetCap() error = %v", err)
+	}
+	want := TweetCapUsage{
+		Cap:   100,
+		Used:  85,
+		Reset: time.Date(2022, time.June, 19, 0, 0, 0, 0, time.UTC),
+	}
+	if usage != want {
+		t.Errorf("Client.SeedTweetCap() = %+v, want %+v", usage, want)
+	}
+	if len(warnings) != 1 {
+		t.Errorf("Client.Warnings = %d, want the 80%% threshold", len(warnings))
+	}
+
+	if _, err := (&Client{}).SeedTweetCap(context.Background()); !errors.Is(err, ErrParameter) {
+		t.Errorf("Client.SeedTweetCap() error = %v, want %v", err, ErrParameter)
+	}
+}
+
+func TestClient_TweetCapStream(t *testing.T) {
+	c := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		TweetCap:   &TweetCap{Cap: 100},
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			body := `{"data":{"id":"1","text":"hello"}}` + "\r\n" + `{"data":{"id":"2","text":"hello"}}` + "\r\n"
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+
+	stream, err := c.TweetSampleStream(context.Background(), TweetSampleStreamOpts{})
+	if err != nil {
+		t.Fatalf("Client.TweetSampleStream() error = %v", err)
+	}
+	defer stream.Close()
+	for i := 0; i < 2; i++ {
+		select {
+		case <-stream.Tweets():
+		case <-time.After(time.Second):
+			t.Fatalf("Client.TweetSampleStream() tweet %d was not received", i)
+		}
+	}
+	if usage := c.TweetCap.Usage(); usage.Used != 2 {
+		t.Errorf("TweetCap.Usage() = %+v, want 2 posts", usage)
+	}
+}
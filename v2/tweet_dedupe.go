@@ -0,0 +1,68 @@
+package twitter
+
+import (
+	"container/list"
+	"sync"
+)
+
+const tweetDedupeSize = 10000
+
+// TweetDedupe will remember the most recent tweet ids that have been yielded, so the pagers and watchers can suppress
+// the duplicates from overlapping windows and polls.  The oldest ids are forgotten once the size is reached.  The
+// dedupe is safe for concurrent use and can be shared.
+type TweetDedupe struct {
+	// Size is the number of tweet ids remembered, defaults to ten thousand
+	Size int
+
+	mutex sync.Mutex
+	ids   map[string]*list.Element
+	order *list.List
+}
+
+// Seen will record the tweet id and returns true if it was already recorded
+func (d *TweetDedupe) Seen(id string) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.ids == nil {
+		d.ids = map[string]*list.Element{}
+		d.order = list.New()
+	}
+	if element, has := d.ids[id]; has {
+		d.order.MoveToFront(element)
+		return true
+	}
+	d.ids[id] = d.order.PushFront(id)
+
+	size := d.Size
+	if size <= 0 {
+		size = tweetDedupeSize
+	}
+	for d.order.Len() > size {
+		oldest := d.order.Back()
+		d.order.Remove(oldest)
+		delete(d.ids, oldest.Value.(string))
+	}
+	return false
+}
+
+// Len returns the number of tweet ids remembered
+func (d *TweetDedupe) Len() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return len(d.ids)
+}
+
+// filter will remove the tweets that have been seen, a nil dedupe does not filter
+func (d *TweetDedupe) filter(raw *TweetRaw) {
+	if d == nil || raw == nil {
+		return
+	}
+	tweets := make([]*TweetObj, 0, len(raw.Tweets))
+	for _, tweet := range raw.Tweets {
+		if tweet == nil || d.Seen(tweet.ID) {
+			continue
+		}
+		tweets = append(tweets, tweet)
+	}
+	raw.Tweets = tweets
+}
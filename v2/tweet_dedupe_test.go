@@ -0,0 +1,46 @@
+package twitter
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestTweetDedupe_Seen(t *testing.T) {
+	dedupe := &TweetDedupe{Size: 2}
+	if dedupe.Seen("1") || dedupe.Seen("2") {
+		t.Errorf("TweetDedupe.Seen() should not have seen new ids")
+	}
+	if !dedupe.Seen("1") {
+		t.Errorf("TweetDedupe.Seen() should have seen 1")
+	}
+	if dedupe.Seen("3") {
+		t.Errorf("TweetDedupe.Seen() should not have seen 3")
+	}
+	if dedupe.Seen("2") {
+		t.Errorf("TweetDedupe.Seen() should have forgotten the least recent id 2")
+	}
+	if dedupe.Len() != 2 {
+		t.Errorf("TweetDedupe.Len() = %d, want 2", dedupe.Len())
+	}
+}
+
+func TestTweetDedupe_Pager(t *testing.T) {
+	client := searchPagesClient(map[string]string{
+		"":   searchPage([]string{"9", "8"}, "p2"),
+		"p2": searchPage([]string{"8", "7"}, ""),
+	})
+	pager := &TweetSearchPager{Client: client, Query: "golang", Dedupe: &TweetDedupe{}}
+	got := []string{}
+	for pager.Next(context.Background()) {
+		for _, tweet := range pager.Page().Raw.Tweets {
+			got = append(got, tweet.ID)
+		}
+	}
+	if err := pager.Err(); err != nil {
+		t.Fatalf("TweetSearchPager.Next() error = %v", err)
+	}
+	if strings.Join(got, ",") != "9,8,7" {
+		t.Errorf("TweetSearchPager with dedupe = %v, want 9,8,7", got)
+	}
+}
@@ -0,0 +1,76 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+)
+
+// TweetEditHistoryResponse has every version of a tweet, oldest first, so the last tweet is the current version.
+// A version that could not be looked up, like a deleted tweet, is not in the tweets and its partial error is in the errors.
+type TweetEditHistoryResponse struct {
+	Tweets    []*TweetObj
+	Errors    []*ErrorObj
+	RateLimit *RateLimit
+}
+
+// Edited will return true if the tweet has more than one version.  The edit history tweet ids field is required.
+func (t *TweetObj) Edited() bool {
+	return len(t.EditHistoryTweetIDs) > 1
+}
+
+// TweetEditHistory will look up every version of a tweet.  The id can be any version of the tweet.  The edit history
+// tweet ids field is always requested, the other options are used for each version.
+func (c *Client) TweetEditHistory(ctx context.Context, id string, opts TweetLookupOpts) (*TweetEditHistoryResponse, error) {
+	if len(id) == 0 {
+		return nil, fmt.Errorf("tweet edit history: an id is required: %w", ErrParameter)
+	}
+	opts.TweetFields = append(append([]TweetField{}, opts.TweetFields...), TweetFieldEditHistoryTweetIDs)
+
+	resp, err := c.TweetLookup(ctx, []string{id}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("tweet edit history: %w", err)
+	}
+	history := &TweetEditHistoryResponse{
+		Errors:    resp.Raw.Errors,
+		RateLimit: resp.RateLimit,
+	}
+	if len(resp.Raw.Tweets) == 0 || resp.Raw.Tweets[0] == nil {
+		return history, nil
+	}
+	tweet := resp.Raw.Tweets[0]
+	if !tweet.Edited() {
+		history.Tweets = []*TweetObj{tweet}
+		return history, nil
+	}
+
+	versions := map[string]*TweetObj{
+		tweet.ID: tweet,
+	}
+	ids := []string{}
+	for _, version := range tweet.EditHistoryTweetIDs {
+		if _, has := versions[version]; !has {
+			ids = append(ids, version)
+		}
+	}
+	for _, batch := range chunk(unique(ids), tweetMaxIDs) {
+		resp, err := c.TweetLookup(ctx, batch, opts)
+		if err != nil {
+			return nil, fmt.Errorf("tweet edit history versions: %w", err)
+		}
+		history.RateLimit = resp.RateLimit
+		history.Errors = append(history.Errors, resp.Raw.Errors...)
+		for _, version := range resp.Raw.Tweets {
+			if version != nil {
+				versions[version.ID] = version
+			}
+		}
+	}
+
+	for _, version := range tweet.EditHistoryTweetIDs {
+		if v, has := versions[version]; has {
+			history.Tweets = append(history.Tweets, v)
+			delete(versions, version)
+		}
+	}
+	return history, nil
+}
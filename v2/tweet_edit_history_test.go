@@ -0,0 +1,55 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_TweetEditHistory(t *testing.T) {
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if fields := req.URL.Query().Get("tweet.fields"); !strings.Contains(fields, "edit_history_tweet_ids") {
+				log.Panicf("the tweet fields are not correct %s", fields)
+			}
+			var body string
+			switch req.URL.Path {
+			case tweetLookupEndpoint.url("") + "/2":
+				body = `{"data":{"id":"2","text":"edited","edit_history_tweet_ids":["1","2","3"]}}`
+			case tweetLookupEndpoint.url(""):
+				if ids := req.URL.Query().Get("ids"); ids != "1,3" {
+					log.Panicf("the ids are not correct %s", ids)
+				}
+				body = `{
+					"data":[{"id":"3","text":"latest","edit_history_tweet_ids":["1","2","3"]},{"id":"1","text":"original","edit_history_tweet_ids":["1","2","3"]}]
+				}`
+			default:
+				log.Panicf("the path is not correct %s", req.URL.Path)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+	got, err := client.TweetEditHistory(context.Background(), "2", TweetLookupOpts{TweetFields: []TweetField{TweetFieldCreatedAt}})
+	if err != nil {
+		t.Fatalf("Client.TweetEditHistory() error = %v", err)
+	}
+	texts := []string{}
+	for _, tweet := range got.Tweets {
+		texts = append(texts, tweet.Text)
+	}
+	if want := "original,edited,latest"; strings.Join(texts, ",") != want {
+		t.Errorf("Client.TweetEditHistory() = %v, want %s", texts, want)
+	}
+	if !got.Tweets[0].Edited() {
+		t.Errorf("TweetObj.Edited() should be true")
+	}
+}
@@ -0,0 +1,77 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// TweetLite is a lightweight tweet for pipelines that only need the common fields.  It can be used with the lite
+// search functions, or callers can define their own struct with the json tags of the tweet fields they need.
+type TweetLite struct {
+	ID        string `json:"id"`
+	Text      string `json:"text"`
+	AuthorID  string `json:"author_id,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// TweetRecentSearchLite is the recent search, but the tweets are decoded into T and all other fields are skipped.
+// If the options do not have tweet fields, the json tag names of T are requested as the tweet fields.
+func TweetRecentSearchLite[T any](ctx context.Context, c *Client, query string, opts TweetRecentSearchOpts) (*Response[[]*T, *TweetRecentSearchMeta], error) {
+	if len(opts.TweetFields) == 0 {
+		opts.TweetFields = liteTweetFields[T]()
+	}
+	req, err := c.tweetRecentSearchRequest(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("tweet recent search response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[[]*T, *TweetRecentSearchMeta](resp, "tweet recent search", http.StatusOK, false)
+}
+
+// TweetSearchLite is the full archive search, but the tweets are decoded into T and all other fields are skipped.
+// If the options do not have tweet fields, the json tag names of T are requested as the tweet fields.
+func TweetSearchLite[T any](ctx context.Context, c *Client, query string, opts TweetSearchOpts) (*Response[[]*T, *TweetSearchMeta], error) {
+	if len(opts.TweetFields) == 0 {
+		opts.TweetFields = liteTweetFields[T]()
+	}
+	req, err := c.tweetSearchRequest(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("tweet search response: %w", err)
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse[[]*T, *TweetSearchMeta](resp, "tweet search", http.StatusOK, false)
+}
+
+// liteTweetFields returns the json tag names of the struct, other than the default id and text, as tweet fields
+func liteTweetFields[T any]() []TweetField {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	fields := []TweetField{}
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("json")
+		name := strings.Split(tag, ",")[0]
+		switch TweetField(name) {
+		case "", "-", TweetFieldID, TweetFieldText:
+		default:
+			fields = append(fields, TweetField(name))
+		}
+	}
+	return fields
+}
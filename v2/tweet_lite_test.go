@@ -0,0 +1,103 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTweetRecentSearchLite(t *testing.T) {
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if strings.Contains(req.URL.String(), tweetRecentSearchEndpoint.url("")) == false {
+				log.Panicf("the url is not correct %s %s", req.URL.String(), tweetRecentSearchEndpoint)
+			}
+			if req.URL.Query().Get("tweet.fields") != "author_id,created_at" {
+				log.Panicf("the tweet fields are not correct %s", req.URL.Query().Get("tweet.fields"))
+			}
+			body := `{
+				"data": [
+					{
+						"id": "1373001119480344583",
+						"text": "Looking to get started with the Twitter API?",
+						"author_id": "2244994945",
+						"created_at": "2021-03-19T19:59:10.000Z",
+						"public_metrics": {"retweet_count": 3, "reply_count": 1, "like_count": 12, "quote_count": 0},
+						"entities": {"urls": [{"start": 0, "end": 23, "url": "https://t.co/abc"}]}
+					}
+				],
+				"meta": {
+					"newest_id": "1373001119480344583",
+					"oldest_id": "1373001119480344583",
+					"result_count": 1,
+					"next_token": "b26v89c19zqg8o3fosbv4n8t9pbf"
+				}
+			}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+				Header:     responseTestHeader(),
+			}
+		}),
+	}
+
+	got, err := TweetRecentSearchLite[TweetLite](context.Background(), client, "from:TwitterDev", TweetRecentSearchOpts{})
+	if err != nil {
+		t.Fatalf("TweetRecentSearchLite() error = %v", err)
+	}
+	want := []*TweetLite{
+		{
+			ID:        "1373001119480344583",
+			Text:      "Looking to get started with the Twitter API?",
+			AuthorID:  "2244994945",
+			CreatedAt: "2021-03-19T19:59:10.000Z",
+		},
+	}
+	if !reflect.DeepEqual(got.Data, want) {
+		t.Errorf("TweetRecentSearchLite() = %v, want %v", got.Data, want)
+	}
+	if got.Meta == nil || got.Meta.NextToken != "b26v89c19zqg8o3fosbv4n8t9pbf" {
+		t.Errorf("TweetRecentSearchLite() meta = %v", got.Meta)
+	}
+}
+
+func TestTweetSearchLite_CustomStruct(t *testing.T) {
+	type langTweet struct {
+		ID   string `json:"id"`
+		Lang string `json:"lang"`
+	}
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if strings.Contains(req.URL.String(), tweetSearchEndpoint.url("")) == false {
+				log.Panicf("the url is not correct %s %s", req.URL.String(), tweetSearchEndpoint)
+			}
+			if req.URL.Query().Get("tweet.fields") != "lang" {
+				log.Panicf("the tweet fields are not correct %s", req.URL.Query().Get("tweet.fields"))
+			}
+			body := `{"data":[{"id":"1","text":"hola","lang":"es"}],"meta":{"result_count":1}}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+
+	got, err := TweetSearchLite[langTweet](context.Background(), client, "hola", TweetSearchOpts{})
+	if err != nil {
+		t.Fatalf("TweetSearchLite() error = %v", err)
+	}
+	if len(got.Data) != 1 || got.Data[0].Lang != "es" {
+		t.Errorf("TweetSearchLite() = %v", got.Data)
+	}
+	if _, err := TweetSearchLite[langTweet](context.Background(), client, "", TweetSearchOpts{}); err == nil {
+		t.Errorf("TweetSearchLite() expected a parameter error")
+	}
+}
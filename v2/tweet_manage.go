@@ -26,17 +26,41 @@ func (t CreateTweetRequest) validate() error {
 			return fmt.Errorf("create tweet error: %w", err)
 		}
 	}
-	if t.Poll != nil {
+	if t.Reply != nil {
 		if err := t.Reply.validate(); err != nil {
 			return fmt.Errorf("create tweet error: %w", err)
 		}
 	}
+	if t.Poll != nil && len(t.QuoteTweetID) > 0 {
+		return fmt.Errorf("create tweet poll can not quote a tweet %w", ErrParameter)
+	}
 	if (t.Media == nil || len(t.Media.IDs) == 0) && len(t.Text) == 0 {
 		return fmt.Errorf("create tweet text is required if no media ids %w", ErrParameter)
 	}
 	return nil
 }
 
+// QuoteTweetOpts are the options of a quote tweet
+type QuoteTweetOpts struct {
+	ForSuperFollowersOnly bool
+	ReplySettings         string
+	Geo                   *CreateTweetGeo
+	Media                 *CreateTweetMedia
+	Reply                 *CreateTweetReply
+}
+
+func (q QuoteTweetOpts) request(targetID, text string) CreateTweetRequest {
+	return CreateTweetRequest{
+		ForSuperFollowersOnly: q.ForSuperFollowersOnly,
+		QuoteTweetID:          targetID,
+		Text:                  text,
+		ReplySettings:         q.ReplySettings,
+		Geo:                   q.Geo,
+		Media:                 q.Media,
+		Reply:                 q.Reply,
+	}
+}
+
 // CreateTweetGeo allows for the tweet to coontain geo
 type CreateTweetGeo struct {
 	PlaceID string `json:"place_id,omitempty"`
@@ -0,0 +1,19 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+)
+
+// TweetMetricsLookup will look up the tweets with the public, non public, organic and promoted metrics, along with the
+// tweet fields of the options.  All but the public metrics are only returned for the tweets of the authorized user, so
+// the request must have the author's user context, like a context with the author's OAuth token, see WithAuthorizer.
+// These metrics are not returned for tweets older than 30 days.
+func (c *Client) TweetMetricsLookup(ctx context.Context, ids []string, opts TweetLookupOpts) (*TweetLookupResponse, error) {
+	opts.TweetFields = MergeFields(opts.TweetFields, MetricsTweetFields())
+	resp, err := c.TweetLookup(ctx, ids, opts)
+	if err != nil {
+		return nil, fmt.Errorf("tweet metrics: %w", err)
+	}
+	return resp, nil
+}
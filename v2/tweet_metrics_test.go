@@ -0,0 +1,60 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type userContextAuth struct{}
+
+func (userContextAuth) Add(req *http.Request) {
+	req.Header.Add("Authorization", "OAuth user")
+}
+
+func TestClient_TweetMetricsLookup(t *testing.T) {
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if auth := req.Header.Get("Authorization"); auth != "OAuth user" {
+				log.Panicf("the authorization is not correct %s", auth)
+			}
+			if fields := req.URL.Query().Get("tweet.fields"); fields != "created_at,public_metrics,non_public_metrics,organic_metrics,promoted_metrics" {
+				log.Panicf("the tweet fields are not correct %s", fields)
+			}
+			body := `{
+				"data": {
+					"id": "1",
+					"text": "metrics",
+					"public_metrics": {"retweet_count":1,"reply_count":2,"like_count":3,"quote_count":4,"impression_count":50},
+					"non_public_metrics": {"impression_count":50,"url_link_clicks":5,"user_profile_clicks":6,"engagements":20},
+					"organic_metrics": {"impression_count":40,"like_count":3,"reply_count":2,"retweet_count":1,"url_link_clicks":4,"user_profile_clicks":5},
+					"promoted_metrics": {"impression_count":10,"like_count":0,"reply_count":0,"retweet_count":0,"url_link_clicks":1,"user_profile_clicks":1}
+				}
+			}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+	ctx := WithAuthorizer(context.Background(), userContextAuth{})
+	got, err := client.TweetMetricsLookup(ctx, []string{"1"}, TweetLookupOpts{TweetFields: []TweetField{TweetFieldCreatedAt}})
+	if err != nil {
+		t.Fatalf("Client.TweetMetricsLookup() error = %v", err)
+	}
+	tweet := got.Raw.Tweets[0]
+	switch {
+	case tweet.NonPublicMetrics.Engagements != 20 || tweet.NonPublicMetrics.UserProfileClicks != 6:
+		t.Errorf("Client.TweetMetricsLookup() non public metrics = %+v", tweet.NonPublicMetrics)
+	case tweet.OrganicMetrics.Impressions != 40 || tweet.OrganicMetrics.URLLinkClicks != 4:
+		t.Errorf("Client.TweetMetricsLookup() organic metrics = %+v", tweet.OrganicMetrics)
+	case tweet.PromotedMetrics.Impressions != 10:
+		t.Errorf("Client.TweetMetricsLookup() promoted metrics = %+v", tweet.PromotedMetrics)
+	}
+}
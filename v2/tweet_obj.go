@@ -1,5 +1,7 @@
 package twitter
 
+import "encoding/json"
+
 // TweetField defines the fields of the basic building block of all things twitter
 type TweetField string
 
@@ -18,6 +20,10 @@ const (
 	TweetFieldConversationID TweetField = "conversation_id"
 	// TweetFieldCreatedAt is the creation time of the Tweet.
 	TweetFieldCreatedAt TweetField = "created_at"
+	// TweetFieldEditControls are the details of how long, and how many more times, the Tweet can be edited.
+	TweetFieldEditControls TweetField = "edit_controls"
+	// TweetFieldEditHistoryTweetIDs are the ids of every version of the Tweet, oldest first.  A Tweet with no edits has only its own id.
+	TweetFieldEditHistoryTweetIDs TweetField = "edit_history_tweet_ids"
 	// TweetFieldEntities are the entities which have been parsed out of the text of the Tweet. Additionally see entities in Twitter Objects.
 	TweetFieldEntities TweetField = "entities"
 	// TweetFieldGeo contains details about the location tagged by the user in this Tweet, if they specified one.
@@ -30,6 +36,8 @@ const (
 	TweetFieldNonPublicMetrics TweetField = "non_public_metrics"
 	// TweetFieldPublicMetrics are the public engagement metrics for the Tweet at the time of the request.
 	TweetFieldPublicMetrics TweetField = "public_metrics"
+	// TweetFieldNoteTweet is the full text and entities of a long form Tweet.  The text of a long form Tweet is truncated.
+	TweetFieldNoteTweet TweetField = "note_tweet"
 	// TweetFieldOrganicMetrics are the engagement metrics, tracked in an organic context, for the Tweet at the time of the request.
 	TweetFieldOrganicMetrics TweetField = "organic_metrics"
 	// TweetFieldPromotedMetrics are the engagement metrics, tracked in a promoted context, for the Tweet at the time of the request.
@@ -52,27 +60,32 @@ func tweetFieldStringArray(arr []TweetField) []string {
 	return strs
 }
 
-// TweetObj is the primary object on the tweets endpoints
+// TweetObj is the primary object on the tweets endpoints.  The fields of the response that are not in the struct, like
+// newer fields, are kept in Extra.
 type TweetObj struct {
-	ID                 string                       `json:"id"`
-	Text               string                       `json:"text"`
-	Attachments        *TweetAttachmentsObj         `json:"attachments,omitempty"`
-	AuthorID           string                       `json:"author_id,omitempty"`
-	ContextAnnotations []*TweetContextAnnotationObj `json:"context_annotations,omitempty"`
-	ConversationID     string                       `json:"conversation_id,omitempty"`
-	CreatedAt          string                       `json:"created_at,omitempty"`
-	Entities           *EntitiesObj                 `json:"entities,omitempty"`
-	Geo                *TweetGeoObj                 `json:"geo,omitempty"`
-	InReplyToUserID    string                       `json:"in_reply_to_user_id,omitempty"`
-	Language           string                       `json:"lang,omitempty"`
-	NonPublicMetrics   *TweetMetricsObj             `json:"non_public_metrics,omitempty"`
-	OrganicMetrics     *TweetMetricsObj             `json:"organic_metrics,omitempty"`
-	PossiblySensitive  bool                         `json:"possibly_sensitive,omitempty"`
-	PromotedMetrics    *TweetMetricsObj             `json:"promoted_metrics,omitempty"`
-	PublicMetrics      *TweetMetricsObj             `json:"public_metrics,omitempty"`
-	ReferencedTweets   []*TweetReferencedTweetObj   `json:"referenced_tweets,omitempty"`
-	Source             string                       `json:"source,omitempty"`
-	WithHeld           *WithHeldObj                 `json:"withheld,omitempty"`
+	ID                  string                       `json:"id"`
+	Text                string                       `json:"text"`
+	Attachments         *TweetAttachmentsObj         `json:"attachments,omitempty"`
+	AuthorID            string                       `json:"author_id,omitempty"`
+	ContextAnnotations  []*TweetContextAnnotationObj `json:"context_annotations,omitempty"`
+	ConversationID      string                       `json:"conversation_id,omitempty"`
+	CreatedAt           string                       `json:"created_at,omitempty"`
+	EditControls        *TweetEditControlsObj        `json:"edit_controls,omitempty"`
+	EditHistoryTweetIDs []string                     `json:"edit_history_tweet_ids,omitempty"`
+	Entities            *EntitiesObj                 `json:"entities,omitempty"`
+	Geo                 *TweetGeoObj                 `json:"geo,omitempty"`
+	InReplyToUserID     string                       `json:"in_reply_to_user_id,omitempty"`
+	Language            string                       `json:"lang,omitempty"`
+	NonPublicMetrics    *TweetMetricsObj             `json:"non_public_metrics,omitempty"`
+	NoteTweet           *TweetNoteTweetObj           `json:"note_tweet,omitempty"`
+	OrganicMetrics      *TweetMetricsObj             `json:"organic_metrics,omitempty"`
+	PossiblySensitive   bool                         `json:"possibly_sensitive,omitempty"`
+	PromotedMetrics     *TweetMetricsObj             `json:"promoted_metrics,omitempty"`
+	PublicMetrics       *TweetMetricsObj             `json:"public_metrics,omitempty"`
+	ReferencedTweets    []*TweetReferencedTweetObj   `json:"referenced_tweets,omitempty"`
+	Source              string                       `json:"source,omitempty"`
+	WithHeld            *WithHeldObj                 `json:"withheld,omitempty"`
+	Extra               map[string]json.RawMessage   `json:"-"`
 }
 
 // TweetAttachmentsObj specifics the type of attachment present in the tweet
@@ -81,6 +94,36 @@ type TweetAttachmentsObj struct {
 	PollIDs   []string `json:"poll_ids"`
 }
 
+// TweetNoteTweetObj is the full text and entities of a long form tweet
+type TweetNoteTweetObj struct {
+	Text     string       `json:"text"`
+	Entities *EntitiesObj `json:"entities,omitempty"`
+}
+
+// FullText will return the text of the note tweet for a long form tweet, otherwise the tweet's text.  The note tweet
+// field is required for the full text of a long form tweet.
+func (t *TweetObj) FullText() string {
+	if t.NoteTweet != nil && len(t.NoteTweet.Text) > 0 {
+		return t.NoteTweet.Text
+	}
+	return t.Text
+}
+
+// FullEntities will return the entities of the full text
+func (t *TweetObj) FullEntities() *EntitiesObj {
+	if t.NoteTweet != nil && len(t.NoteTweet.Text) > 0 {
+		return t.NoteTweet.Entities
+	}
+	return t.Entities
+}
+
+// TweetEditControlsObj are the details of how the Tweet can be edited
+type TweetEditControlsObj struct {
+	EditsRemaining int    `json:"edits_remaining"`
+	IsEditEligible bool   `json:"is_edit_eligible"`
+	EditableUntil  string `json:"editable_until"`
+}
+
 // TweetContextAnnotationObj contain the context annotation
 type TweetContextAnnotationObj struct {
 	Domain TweetContextObj `json:"domain"`
@@ -116,6 +159,7 @@ type TweetMetricsObj struct {
 	Retweets          int `json:"retweet_count"`
 	Quotes            int `json:"quote_count"`
 	Bookmarks         int `json:"bookmark_count"`
+	Engagements       int `json:"engagements"`
 }
 
 // TweetReferencedTweetObj is a Tweet this Tweet refers to
@@ -0,0 +1,33 @@
+package twitter
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTweetObj_FullText(t *testing.T) {
+	tweet := &TweetObj{}
+	body := `{
+		"id":"1",
+		"text":"a long tweet…",
+		"entities":{"hashtags":[{"start":7,"end":11,"tag":"go"}]},
+		"note_tweet":{"text":"a long tweet about #golang","entities":{"hashtags":[{"start":19,"end":26,"tag":"golang"}]}}
+	}`
+	if err := json.Unmarshal([]byte(body), tweet); err != nil {
+		t.Fatalf("TweetObj.UnmarshalJSON() error = %v", err)
+	}
+	if got := tweet.FullText(); got != "a long tweet about #golang" {
+		t.Errorf("TweetObj.FullText() = %s", got)
+	}
+	if got := tweet.FullEntities(); got.HashTags[0].Tag != "golang" {
+		t.Errorf("TweetObj.FullEntities() = %v", got.HashTags)
+	}
+	if len(tweet.Extra) != 0 {
+		t.Errorf("TweetObj.UnmarshalJSON() extra = %v, the note tweet is a field", tweet.Extra)
+	}
+
+	classic := &TweetObj{Text: "hello"}
+	if got := classic.FullText(); got != "hello" {
+		t.Errorf("TweetObj.FullText() = %s, want hello", got)
+	}
+}
@@ -0,0 +1,69 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TweetPollResponse is the current state of a tweet's poll.  The poll is nil if the tweet does not have a poll, or the
+// tweet could not be looked up and its partial error is in the errors.  The end time is zero if the poll does not have
+// one.
+type TweetPollResponse struct {
+	Poll      *PollObj
+	EndTime   time.Time
+	Errors    []*ErrorObj
+	RateLimit *RateLimit
+}
+
+// Open will return true if the poll can still receive votes
+func (p *PollObj) Open() bool {
+	return p.VotingStatus == "open"
+}
+
+// TotalVotes returns the sum of the votes of the options
+func (p *PollObj) TotalVotes() int {
+	total := 0
+	for _, option := range p.Options {
+		if option != nil {
+			total += option.Votes
+		}
+	}
+	return total
+}
+
+// RefreshPoll will look up a tweet with its poll expanded, so a poll's votes can be followed by calling it on an
+// interval.
+func (c *Client) RefreshPoll(ctx context.Context, tweetID string) (*TweetPollResponse, error) {
+	if len(tweetID) == 0 {
+		return nil, fmt.Errorf("refresh poll: an id is required: %w", ErrParameter)
+	}
+	resp, err := c.TweetLookup(ctx, []string{tweetID}, TweetLookupOpts{
+		Expansions:  []Expansion{ExpansionAttachmentsPollIDs},
+		TweetFields: []TweetField{TweetFieldAttachments},
+		PollFields:  AllPollFields(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("refresh poll: %w", err)
+	}
+	poll := &TweetPollResponse{
+		Errors:    resp.Raw.Errors,
+		RateLimit: resp.RateLimit,
+	}
+	if len(resp.Raw.Tweets) == 0 || resp.Raw.Tweets[0] == nil {
+		return poll, nil
+	}
+	dictionary := CreateTweetDictionary(*resp.Raw.Tweets[0], resp.Raw.Includes)
+	if len(dictionary.AttachmentPolls) == 0 {
+		return poll, nil
+	}
+	poll.Poll = dictionary.AttachmentPolls[0]
+	if len(poll.Poll.EndDateTime) > 0 {
+		end, err := ParseTime(poll.Poll.EndDateTime)
+		if err != nil {
+			return nil, fmt.Errorf("refresh poll end: %w", err)
+		}
+		poll.EndTime = end
+	}
+	return poll, nil
+}
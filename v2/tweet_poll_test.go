@@ -0,0 +1,55 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_RefreshPoll(t *testing.T) {
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Path != tweetLookupEndpoint.url("")+"/1" {
+				log.Panicf("the path is not correct %s", req.URL.Path)
+			}
+			if expansions := req.URL.Query().Get("expansions"); expansions != "attachments.poll_ids" {
+				log.Panicf("the expansions are not correct %s", expansions)
+			}
+			body := `{
+				"data": {"id":"1","text":"which?","attachments":{"poll_ids":["7"]}},
+				"includes": {
+					"polls": [{
+						"id":"7",
+						"options":[{"position":1,"label":"go","votes":12},{"position":2,"label":"rust","votes":8}],
+						"duration_minutes":60,
+						"end_datetime":"2022-03-01T10:00:00.000Z",
+						"voting_status":"open"
+					}]
+				}
+			}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+	got, err := client.RefreshPoll(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Client.RefreshPoll() error = %v", err)
+	}
+	switch {
+	case got.Poll == nil || got.Poll.ID != "7":
+		t.Fatalf("Client.RefreshPoll() poll = %+v", got.Poll)
+	case !got.Poll.Open() || got.Poll.TotalVotes() != 20 || got.Poll.Options[0].Label != "go":
+		t.Errorf("Client.RefreshPoll() poll = %+v", got.Poll)
+	case !got.EndTime.Equal(time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)):
+		t.Errorf("Client.RefreshPoll() end time = %v", got.EndTime)
+	}
+}
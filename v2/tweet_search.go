@@ -184,22 +184,34 @@ type TweetSearchStreamRule struct {
 	Tag   string `json:"tag,omitempty"`
 }
 
-func (t TweetSearchStreamRule) validate() error {
-	if len(t.Value) == 0 {
-		return fmt.Errorf("tweet search stream rule value is required: %w", ErrParameter)
-	}
-	return nil
-}
-
 type tweetSearchStreamRules []TweetSearchStreamRule
 
-func (t tweetSearchStreamRules) validate() error {
-	for _, rule := range t {
-		if err := rule.validate(); err != nil {
-			return err
+// validate will check the rules have a value or, with lint, lint the rules for the access level.  The error has all of
+// the findings that are not warnings, the warnings are returned.
+func (t tweetSearchStreamRules) validate(lint bool, access StreamRuleAccess) ([]*StreamRuleFinding, error) {
+	if !lint {
+		for _, rule := range t {
+			if len(rule.Value) == 0 {
+				return nil, fmt.Errorf("tweet search stream rule value is required: %w", ErrParameter)
+			}
 		}
+		return nil, nil
+	}
+	warnings := []*StreamRuleFinding{}
+	findings := []*StreamRuleFinding{}
+	for _, finding := range LintStreamRules(t, access) {
+		if finding.Warning {
+			warnings = append(warnings, finding)
+			continue
+		}
+		findings = append(findings, finding)
 	}
-	return nil
+	if len(findings) > 0 {
+		return warnings, &StreamRuleLintError{
+			Findings: findings,
+		}
+	}
+	return warnings, nil
 }
 
 // TweetSearchStreamRuleID is the filter rule id
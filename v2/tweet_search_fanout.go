@@ -0,0 +1,291 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const tweetSearchFanOutConcurrency = 4
+
+// TweetSearchQuery is one of the queries of a fan out search, the name is used in the matches and errors
+type TweetSearchQuery struct {
+	Name  string
+	Query string
+	// Opts are the search options, the pagination token is set by the fan out
+	Opts TweetSearchOpts
+}
+
+// TweetSearchMatch is a tweet of the fan out search with the names of all of the queries that matched it
+type TweetSearchMatch struct {
+	Tweet   *TweetDictionary
+	Queries []string
+}
+
+// TweetSearchFanOut will search with many queries at the same time and merge the results into one stream, newest
+// first.  A tweet that matches more than one query is delivered once with all of the query names.
+//
+//	fanOut := &twitter.TweetSearchFanOut{Client: client, Queries: queries, MaxRequests: 100}
+//	for match := range fanOut.Search(ctx) {
+//		...
+//	}
+//	if err := fanOut.Err(); err != nil {
+//		...
+//	}
+//
+// The queries share the request budget and the rate limit.  When a page has no requests remaining, all of the queries
+// wait until the reset.  When MaxRequests pages have been requested, the queries stop, the fetched tweets are still
+// delivered and Err matches ErrBudgetExceeded.  A failed query stops the search.
+type TweetSearchFanOut struct {
+	Client  *Client
+	Queries []TweetSearchQuery
+	// FullArchive will use the full archive search instead of the recent search
+	FullArchive bool
+	// Provider is the source of the pages, it defaults to the full archive search or the client's search provider
+	Provider TweetSearchProvider
+	// Concurrency is the number of searches sent at the same time, defaults to four
+	Concurrency int
+	// MaxRequests is the number of pages requested by all of the queries, zero is no limit
+	MaxRequests int
+	// Buffer is the size of the match channel
+	Buffer int
+	// Dedupe will suppress the tweets that have already been delivered, like by a previous search
+	Dedupe *TweetDedupe
+
+	mutex     sync.Mutex
+	requests  int
+	exhausted bool
+	hold      time.Time
+	err       error
+}
+
+type tweetSearchFanOutStream struct {
+	name   string
+	pages  chan []*TweetDictionary
+	tweets []*TweetDictionary
+	done   bool
+}
+
+// Search will run the queries until they have no more pages, the budget is used or a query fails.  The channel is
+// closed when the search stops, and then Err has the reason.  The fan out can only be searched once at a time.
+func (f *TweetSearchFanOut) Search(ctx context.Context) <-chan *TweetSearchMatch {
+	matches := make(chan *TweetSearchMatch, f.Buffer)
+	f.mutex.Lock()
+	f.err = nil
+	f.mutex.Unlock()
+	go func() {
+		defer close(matches)
+		err := f.run(ctx, matches)
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		switch {
+		case f.err != nil:
+		case err != nil:
+			f.err = err
+		case f.exhausted:
+			f.err = fmt.Errorf("tweet search fan out: %d requests sent: %w", f.requests, ErrBudgetExceeded)
+		default:
+		}
+	}()
+	return matches
+}
+
+// Err is the reason the search stopped, it is valid after the channel is closed
+func (f *TweetSearchFanOut) Err() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.err
+}
+
+func (f *TweetSearchFanOut) run(ctx context.Context, matches chan<- *TweetSearchMatch) error {
+	if f.Client == nil && f.Provider == nil {
+		return fmt.Errorf("tweet search fan out: a client or provider is required: %w", ErrParameter)
+	}
+	if len(f.Queries) == 0 {
+		return fmt.Errorf("tweet search fan out: a query is required: %w", ErrParameter)
+	}
+	names := map[string]bool{}
+	for _, query := range f.Queries {
+		if len(query.Name) == 0 || len(query.Query) == 0 || names[query.Name] {
+			return fmt.Errorf("tweet search fan out: each query requires a unique name and a query: %w", ErrParameter)
+		}
+		names[query.Name] = true
+	}
+	f.mutex.Lock()
+	f.requests = 0
+	f.exhausted = false
+	f.hold = time.Time{}
+	f.mutex.Unlock()
+
+	concurrency := f.Concurrency
+	if concurrency <= 0 {
+		concurrency = tweetSearchFanOutConcurrency
+	}
+	sem := make(chan struct{}, concurrency)
+
+	wg := sync.WaitGroup{}
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	streams := make([]*tweetSearchFanOutStream, len(f.Queries))
+	for i, query := range f.Queries {
+		stream := &tweetSearchFanOutStream{
+			name:  query.Name,
+			pages: make(chan []*TweetDictionary, 1),
+		}
+		streams[i] = stream
+		wg.Add(1)
+		go func(query TweetSearchQuery) {
+			defer wg.Done()
+			defer close(stream.pages)
+			if err := f.query(ctx, query, sem, stream.pages); err != nil {
+				f.fail(err)
+				cancel()
+			}
+		}(query)
+	}
+	return f.merge(ctx, streams, matches)
+}
+
+// query will page through the query's results, sending the tweets of each page
+func (f *TweetSearchFanOut) query(ctx context.Context, query TweetSearchQuery, sem chan struct{}, pages chan<- []*TweetDictionary) error {
+	pager := &TweetSearchPager{
+		Client:      f.Client,
+		Query:       query.Query,
+		Opts:        query.Opts,
+		FullArchive: f.FullArchive,
+		Provider:    f.Provider,
+	}
+	for {
+		if !f.reserve(ctx, sem) {
+			return nil
+		}
+		more := pager.Next(ctx)
+		<-sem
+		if !more {
+			if err := pager.Err(); err != nil {
+				return fmt.Errorf("tweet search fan out %s: %w", query.Name, err)
+			}
+			return nil
+		}
+
+		page := pager.Page()
+		f.limit(page.RateLimit)
+		select {
+		case pages <- pager.Items():
+		case <-ctx.Done():
+			return nil
+		}
+		if len(pager.Cursor().NextToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// reserve will wait for the rate limit hold and a concurrency slot, and count the request against the budget.  False
+// is returned when the budget has been used or the context is done.
+func (f *TweetSearchFanOut) reserve(ctx context.Context, sem chan struct{}) bool {
+	for {
+		f.mutex.Lock()
+		hold := time.Until(f.hold)
+		f.mutex.Unlock()
+		if err := sleep(ctx, hold); err != nil {
+			return false
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return false
+		}
+
+		f.mutex.Lock()
+		switch {
+		case time.Now().Before(f.hold):
+			f.mutex.Unlock()
+			<-sem
+			continue
+		case f.MaxRequests > 0 && f.requests >= f.MaxRequests:
+			f.exhausted = true
+			f.mutex.Unlock()
+			<-sem
+			return false
+		default:
+		}
+		f.requests++
+		f.mutex.Unlock()
+		return true
+	}
+}
+
+// limit will hold the queries until the reset if the rate limit has no remaining requests
+func (f *TweetSearchFanOut) limit(rl *RateLimit) {
+	if rl == nil || rl.Remaining > 0 || !rl.Reset.Time().After(time.Now()) {
+		return
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if reset := rl.Reset.Time(); reset.After(f.hold) {
+		f.hold = reset
+	}
+}
+
+func (f *TweetSearchFanOut) fail(err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.err == nil {
+		f.err = err
+	}
+}
+
+// merge will deliver the newest tweet of the streams until all of them are done.  Each stream is newest first, so
+// the tweet is only delivered once every stream has its next tweet.
+func (f *TweetSearchFanOut) merge(ctx context.Context, streams []*tweetSearchFanOutStream, matches chan<- *TweetSearchMatch) error {
+	for {
+		var newest string
+		for _, stream := range streams {
+			for !stream.done && len(stream.tweets) == 0 {
+				tweets, ok := <-stream.pages
+				stream.tweets = tweets
+				stream.done = !ok
+			}
+			if len(stream.tweets) > 0 && (len(newest) == 0 || newerTweetID(stream.tweets[0].Tweet.ID, newest)) {
+				newest = stream.tweets[0].Tweet.ID
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if len(newest) == 0 {
+			return nil
+		}
+
+		match := &TweetSearchMatch{}
+		for _, stream := range streams {
+			if len(stream.tweets) > 0 && stream.tweets[0].Tweet.ID == newest {
+				match.Tweet = stream.tweets[0]
+				match.Queries = append(match.Queries, stream.name)
+				stream.tweets = stream.tweets[1:]
+			}
+		}
+		if f.Dedupe != nil && f.Dedupe.Seen(newest) {
+			continue
+		}
+		select {
+		case matches <- match:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// newerTweetID is true if the first tweet id is newer than the second
+func newerTweetID(id, than string) bool {
+	a, aErr := strconv.ParseUint(id, 10, 64)
+	b, bErr := strconv.ParseUint(than, 10, 64)
+	if aErr != nil || bErr != nil {
+		return id > than
+	}
+	return a > b
+}
@@ -0,0 +1,144 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func fanOutProvider(pages map[string]map[string][]string, failing string) TweetSearchProvider {
+	return TweetSearchProviderFunc(func(ctx context.Context, query string, opts TweetSearchOpts) (*TweetSearchPage, error) {
+		if query == failing {
+			return nil, fmt.Errorf("%s failed", query)
+		}
+		queryPages := pages[query]
+		ids, has := queryPages[opts.NextToken]
+		if !has {
+			return nil, fmt.Errorf("%s has no page %s", query, opts.NextToken)
+		}
+		page := &TweetSearchPage{
+			Raw:  &TweetRaw{},
+			Meta: &TweetSearchMeta{ResultCount: len(ids)},
+		}
+		for _, id := range ids {
+			page.Raw.Tweets = append(page.Raw.Tweets, &TweetObj{ID: id, Text: "tweet " + id})
+		}
+		index := 0
+		if len(opts.NextToken) > 0 {
+			index, _ = strconv.Atoi(opts.NextToken[strings.LastIndex(opts.NextToken, "-")+1:])
+		}
+		if next := fmt.Sprintf("%s-%d", query, index+1); len(queryPages[next]) > 0 {
+			page.Meta.NextToken = next
+		}
+		return page, nil
+	})
+}
+
+func fanOutMatches(fanOut *TweetSearchFanOut) []string {
+	got := []string{}
+	for match := range fanOut.Search(context.Background()) {
+		got = append(got, match.Tweet.Tweet.ID+":"+strings.Join(match.Queries, "+"))
+	}
+	return got
+}
+
+func TestTweetSearchFanOut(t *testing.T) {
+	pages := map[string]map[string][]string{
+		"golang": {
+			"":         {"1500", "1400"},
+			"golang-1": {"1200", "999"},
+		},
+		"gopher": {
+			"":         {"1450", "1400"},
+			"gopher-1": {"1300"},
+		},
+		"rustlang": {
+			"": {},
+		},
+	}
+	fanOut := &TweetSearchFanOut{
+		Provider:    fanOutProvider(pages, ""),
+		Concurrency: 2,
+		Queries: []TweetSearchQuery{
+			{Name: "go", Query: "golang"},
+			{Name: "gopher", Query: "gopher"},
+			{Name: "rust", Query: "rustlang"},
+		},
+		Dedupe: &TweetDedupe{},
+	}
+	fanOut.Dedupe.Seen("1300")
+
+	got := strings.Join(fanOutMatches(fanOut), ",")
+	if want := "1500:go,1450:gopher,1400:go+gopher,1200:go,999:go"; got != want {
+		t.Errorf("TweetSearchFanOut.Search() = %s, want %s", got, want)
+	}
+	if err := fanOut.Err(); err != nil {
+		t.Errorf("TweetSearchFanOut.Err() = %v", err)
+	}
+}
+
+func TestTweetSearchFanOut_Budget(t *testing.T) {
+	pages := map[string]map[string][]string{
+		"golang": {
+			"":         {"1500"},
+			"golang-1": {"1200"},
+			"golang-2": {"1100"},
+		},
+	}
+	requests := 0
+	mutex := sync.Mutex{}
+	provider := fanOutProvider(pages, "")
+	fanOut := &TweetSearchFanOut{
+		Provider: TweetSearchProviderFunc(func(ctx context.Context, query string, opts TweetSearchOpts) (*TweetSearchPage, error) {
+			mutex.Lock()
+			requests++
+			mutex.Unlock()
+			return provider.SearchTweets(ctx, query, opts)
+		}),
+		Queries:     []TweetSearchQuery{{Name: "go", Query: "golang"}},
+		MaxRequests: 2,
+	}
+
+	got := strings.Join(fanOutMatches(fanOut), ",")
+	if want := "1500:go,1200:go"; got != want {
+		t.Errorf("TweetSearchFanOut.Search() = %s, want %s", got, want)
+	}
+	if err := fanOut.Err(); !errors.Is(err, ErrBudgetExceeded) {
+		t.Errorf("TweetSearchFanOut.Err() = %v, want budget exceeded", err)
+	}
+	if requests != 2 {
+		t.Errorf("TweetSearchFanOut.Search() sent %d requests, want 2", requests)
+	}
+}
+
+func TestTweetSearchFanOut_Error(t *testing.T) {
+	pages := map[string]map[string][]string{
+		"golang": {
+			"": {"1500"},
+		},
+	}
+	fanOut := &TweetSearchFanOut{
+		Provider: fanOutProvider(pages, "gopher"),
+		Queries: []TweetSearchQuery{
+			{Name: "go", Query: "golang"},
+			{Name: "gopher", Query: "gopher"},
+		},
+	}
+	fanOutMatches(fanOut)
+	if err := fanOut.Err(); err == nil || !strings.Contains(err.Error(), "tweet search fan out gopher") {
+		t.Errorf("TweetSearchFanOut.Err() = %v, want the gopher query error", err)
+	}
+
+	fanOut = &TweetSearchFanOut{
+		Provider: fanOutProvider(pages, ""),
+		Queries:  []TweetSearchQuery{{Name: "go", Query: "golang"}, {Name: "go", Query: "gopher"}},
+	}
+	fanOutMatches(fanOut)
+	if err := fanOut.Err(); !errors.Is(err, ErrParameter) {
+		t.Errorf("TweetSearchFanOut.Err() = %v, want parameter error", err)
+	}
+}
@@ -0,0 +1,207 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+)
+
+// TweetSearchPage is a page of search results
+type TweetSearchPage struct {
+	Raw       *TweetRaw
+	Meta      *TweetSearchMeta
+	RateLimit *RateLimit
+}
+
+// TweetSearchPager will page through the results of the recent search, or the full archive search.  The pager is not
+// safe for concurrent use.
+//
+//	pager := &twitter.TweetSearchPager{Client: client, Query: "golang"}
+//	for pager.Next(ctx) {
+//		page := pager.Page()
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+//
+// With a cursor store, the cursor of the job is saved once the caller has finished a page, which is when the next page
+// is asked for or the page is committed with Commit.  A pager for the same job will resume the crawl at the first page
+// that was not finished or, if the crawl was completed, search for only the tweets newer than the crawl.
+//
+// With prefetch, the next page is fetched in the background while the caller processes the current page.  A page is
+// not prefetched when the rate limit of the current page has no requests remaining.  With WaitRateLimit, such a page
+// will hold the next page until the rate limit resets.
+type TweetSearchPager struct {
+	Client *Client
+	Query  string
+	// Opts are the search options, the pagination token and, when resuming, the since id are set by the pager
+	Opts TweetSearchOpts
+	// FullArchive will use the full archive search instead of the recent search
+	FullArchive bool
+	// Provider is the source of the pages, it defaults to the full archive search or the client's search provider
+	Provider TweetSearchProvider
+	// Job is the name of the cursor in the store
+	Job   string
+	Store CursorStore
+	// Prefetch will fetch the next page while the current page is processed
+	Prefetch bool
+	// Dedupe will remove the tweets that have already been yielded from the pages
+	Dedupe *TweetDedupe
+	// WaitRateLimit will wait for the rate limit reset before the next page
+	WaitRateLimit bool
+
+	started    bool
+	done       bool
+	cursor     Cursor
+	checkpoint cursorCheckpoint
+	page       *TweetSearchPage
+	prefetch   chan tweetSearchFetch
+	err        error
+}
+
+type tweetSearchFetch struct {
+	page *TweetSearchPage
+	err  error
+}
+
+// Next will fetch the next page and returns false when there are no more pages or there is an error
+func (p *TweetSearchPager) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+	if err := p.checkpoint.commit(ctx); err != nil {
+		p.err = err
+		return false
+	}
+	if p.done {
+		return false
+	}
+	if !p.started {
+		if err := p.start(ctx); err != nil {
+			p.err = err
+			return false
+		}
+		p.started = true
+	}
+
+	var page *TweetSearchPage
+	var err error
+	if p.prefetch != nil {
+		select {
+		case fetch := <-p.prefetch:
+			page, err = fetch.page, fetch.err
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		p.prefetch = nil
+	} else {
+		if p.WaitRateLimit && p.page != nil {
+			err = waitRateLimit(ctx, p.page.RateLimit)
+		}
+		if err == nil {
+			page, err = p.search(ctx, p.nextOpts())
+		}
+	}
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.page = page
+	p.Dedupe.filter(page.Raw)
+
+	if page.Meta != nil && len(p.cursor.NextToken) == 0 && len(page.Meta.NewestID) > 0 {
+		p.cursor.NewestID = page.Meta.NewestID
+	}
+	p.cursor.NextToken = ""
+	if page.Meta != nil {
+		p.cursor.NextToken = page.Meta.NextToken
+	}
+	p.done = len(p.cursor.NextToken) == 0
+	p.checkpoint.hold(p.cursor)
+	if p.Prefetch && !p.done && (page.RateLimit == nil || page.RateLimit.Remaining > 0) {
+		p.startPrefetch(ctx)
+	}
+	return true
+}
+
+// Commit will save the cursor after the current page, for a caller that stops before the next page is asked for
+func (p *TweetSearchPager) Commit(ctx context.Context) error {
+	return p.checkpoint.commit(ctx)
+}
+
+// Page is the current page
+func (p *TweetSearchPager) Page() *TweetSearchPage {
+	return p.page
+}
+
+// Items are the tweets of the current page
+func (p *TweetSearchPager) Items() []*TweetDictionary {
+	if p.page == nil {
+		return nil
+	}
+	return tweetPageItems(p.page.Raw)
+}
+
+// Err is the error that stopped the paging
+func (p *TweetSearchPager) Err() error {
+	return p.err
+}
+
+// Cursor is the cursor after the current page
+func (p *TweetSearchPager) Cursor() Cursor {
+	return p.cursor
+}
+
+func (p *TweetSearchPager) nextOpts() TweetSearchOpts {
+	opts := p.Opts
+	opts.NextToken = p.cursor.NextToken
+	if len(p.cursor.SinceID) > 0 {
+		opts.SinceID = p.cursor.SinceID
+	}
+	return opts
+}
+
+// startPrefetch will fetch the next page in the background, the channel is buffered so an abandoned fetch will not
+// block
+func (p *TweetSearchPager) startPrefetch(ctx context.Context) {
+	fetch := make(chan tweetSearchFetch, 1)
+	opts := p.nextOpts()
+	go func() {
+		page, err := p.search(ctx, opts)
+		fetch <- tweetSearchFetch{
+			page: page,
+			err:  err,
+		}
+	}()
+	p.prefetch = fetch
+}
+
+func (p *TweetSearchPager) start(ctx context.Context) error {
+	checkpoint, err := newCursorCheckpoint("tweet search pager", p.Job, p.Store)
+	if err != nil {
+		return err
+	}
+	p.checkpoint = checkpoint
+	saved, err := p.checkpoint.load(ctx)
+	if err != nil {
+		return err
+	}
+	p.cursor = resumeCursor(saved, Cursor{SinceID: p.Opts.SinceID})
+	return nil
+}
+
+func (p *TweetSearchPager) search(ctx context.Context, opts TweetSearchOpts) (*TweetSearchPage, error) {
+	var provider TweetSearchProvider
+	switch {
+	case p.Provider != nil:
+		provider = p.Provider
+	case p.FullArchive:
+		provider = &TweetFullArchiveSearchProvider{Client: p.Client}
+	default:
+		provider = p.Client.searchProvider()
+	}
+	page, err := provider.SearchTweets(ctx, p.Query, opts)
+	if err != nil {
+		return nil, fmt.Errorf("tweet search pager: %w", err)
+	}
+	return page, nil
+}
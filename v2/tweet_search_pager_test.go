@@ -0,0 +1,159 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func searchPagesClient(pages map[string]string) *Client {
+	return &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			q := req.URL.Query()
+			key := q.Get("next_token")
+			if since := q.Get("since_id"); len(since) > 0 && len(key) == 0 {
+				key = "since:" + since
+			}
+			body, has := pages[key]
+			if !has {
+				log.Panicf("the page is not correct %s", req.URL.RawQuery)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+}
+
+func searchPage(ids []string, next string) string {
+	data := []string{}
+	for _, id := range ids {
+		data = append(data, fmt.Sprintf(`{"id":"%s","text":"tweet %s"}`, id, id))
+	}
+	meta := fmt.Sprintf(`"result_count":%d`, len(ids))
+	if len(ids) > 0 {
+		meta += fmt.Sprintf(`,"newest_id":"%s","oldest_id":"%s"`, ids[0], ids[len(ids)-1])
+	}
+	if len(next) > 0 {
+		meta += fmt.Sprintf(`,"next_token":"%s"`, next)
+	}
+	return fmt.Sprintf(`{"data":[%s],"meta":{%s}}`, strings.Join(data, ","), meta)
+}
+
+func TestTweetSearchPager(t *testing.T) {
+	client := searchPagesClient(map[string]string{
+		"":        searchPage([]string{"9", "8"}, "p2"),
+		"p2":      searchPage([]string{"7", "6"}, "p3"),
+		"p3":      searchPage([]string{"5"}, ""),
+		"since:9": searchPage([]string{"11", "10"}, ""),
+	})
+	store := &MemoryCursorStore{}
+	ctx := context.Background()
+	ids := func(pager *TweetSearchPager, pages int) []string {
+		got := []string{}
+		for i := 0; i < pages && pager.Next(ctx); i++ {
+			for _, tweet := range pager.Page().Raw.Tweets {
+				got = append(got, tweet.ID)
+			}
+		}
+		if err := pager.Err(); err != nil {
+			t.Fatalf("TweetSearchPager.Next() error = %v", err)
+		}
+		return got
+	}
+
+	pager := &TweetSearchPager{Client: client, Query: "golang", Job: "golang", Store: store}
+	if got := ids(pager, 2); strings.Join(got, ",") != "9,8,7,6" {
+		t.Errorf("TweetSearchPager first run = %v", got)
+	}
+
+	pager = &TweetSearchPager{Client: client, Query: "golang", Job: "golang", Store: store}
+	if got := ids(pager, 10); strings.Join(got, ",") != "7,6,5" {
+		t.Errorf("TweetSearchPager resumed run = %v, want the unfinished page 7,6,5", got)
+	}
+	if cursor, _ := store.Load(ctx, "golang"); cursor.NextToken != "" || cursor.NewestID != "9" {
+		t.Errorf("TweetSearchPager cursor = %+v", cursor)
+	}
+
+	pager = &TweetSearchPager{Client: client, Query: "golang", Job: "golang", Store: store}
+	if got := ids(pager, 10); strings.Join(got, ",") != "11,10" {
+		t.Errorf("TweetSearchPager newer run = %v, want 11,10", got)
+	}
+	if cursor, _ := store.Load(ctx, "golang"); cursor.NewestID != "11" {
+		t.Errorf("TweetSearchPager cursor = %+v, want newest 11", cursor)
+	}
+
+	pager = &TweetSearchPager{Client: client, Query: "golang", Job: "committed", Store: store}
+	if got := ids(pager, 2); strings.Join(got, ",") != "9,8,7,6" {
+		t.Errorf("TweetSearchPager first run = %v", got)
+	}
+	if err := pager.Commit(ctx); err != nil {
+		t.Fatalf("TweetSearchPager.Commit() error = %v", err)
+	}
+	pager = &TweetSearchPager{Client: client, Query: "golang", Job: "committed", Store: store}
+	if got := ids(pager, 10); strings.Join(got, ",") != "5" {
+		t.Errorf("TweetSearchPager committed run = %v, want 5", got)
+	}
+
+	if (&TweetSearchPager{Client: client, Store: store}).Next(ctx) {
+		t.Errorf("TweetSearchPager.Next() should require a job with a store")
+	}
+}
+
+func TestTweetSearchPager_Prefetch(t *testing.T) {
+	pages := map[string]string{
+		"":   searchPage([]string{"9", "8"}, "p2"),
+		"p2": searchPage([]string{"7", "6"}, "p3"),
+		"p3": searchPage([]string{"5"}, ""),
+	}
+	requested := make(chan string, len(pages))
+	client := &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			token := req.URL.Query().Get("next_token")
+			requested <- token
+			header := http.Header{}
+			if token == "p2" {
+				header.Set(rateRemaining, "0")
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(pages[token])),
+			}
+		}),
+	}
+	ctx := context.Background()
+	pager := &TweetSearchPager{Client: client, Query: "golang", Prefetch: true}
+
+	if !pager.Next(ctx) {
+		t.Fatalf("TweetSearchPager.Next() error = %v", pager.Err())
+	}
+	<-requested
+	if token := <-requested; token != "p2" {
+		t.Errorf("TweetSearchPager prefetch = %s, want p2", token)
+	}
+	if !pager.Next(ctx) || pager.Page().Raw.Tweets[0].ID != "7" {
+		t.Fatalf("TweetSearchPager.Next() should return the prefetched page")
+	}
+	select {
+	case token := <-requested:
+		t.Errorf("TweetSearchPager prefetch = %s, there are no requests remaining", token)
+	default:
+	}
+	if !pager.Next(ctx) || pager.Page().Raw.Tweets[0].ID != "5" {
+		t.Fatalf("TweetSearchPager.Next() should return the last page")
+	}
+	if pager.Next(ctx) || pager.Err() != nil {
+		t.Errorf("TweetSearchPager.Next() should be done, error = %v", pager.Err())
+	}
+}
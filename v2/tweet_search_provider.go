@@ -0,0 +1,108 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// TweetSearchProvider is a source of tweet search results.  Application code that searches through a provider can
+// switch between the recent search, the full archive search or another source without changing its call sites.
+type TweetSearchProvider interface {
+	SearchTweets(ctx context.Context, query string, opts TweetSearchOpts) (*TweetSearchPage, error)
+}
+
+// TweetSearchProviderFunc is a function that can be used as a search provider
+type TweetSearchProviderFunc func(ctx context.Context, query string, opts TweetSearchOpts) (*TweetSearchPage, error)
+
+// SearchTweets will call the function
+func (f TweetSearchProviderFunc) SearchTweets(ctx context.Context, query string, opts TweetSearchOpts) (*TweetSearchPage, error) {
+	return f(ctx, query, opts)
+}
+
+// TweetRecentSearchProvider searches the tweets of the last seven days with the recent search
+type TweetRecentSearchProvider struct {
+	Client *Client
+}
+
+// SearchTweets will search with the recent search
+func (p *TweetRecentSearchProvider) SearchTweets(ctx context.Context, query string, opts TweetSearchOpts) (*TweetSearchPage, error) {
+	resp, err := p.Client.TweetRecentSearch(ctx, query, TweetRecentSearchOpts(opts))
+	if err != nil {
+		return nil, err
+	}
+	page := &TweetSearchPage{
+		Raw:       resp.Raw,
+		RateLimit: resp.RateLimit,
+	}
+	if resp.Meta != nil {
+		meta := TweetSearchMeta(*resp.Meta)
+		page.Meta = &meta
+	}
+	return page, nil
+}
+
+// TweetFullArchiveSearchProvider searches all of the tweets with the full archive search
+type TweetFullArchiveSearchProvider struct {
+	Client *Client
+}
+
+// SearchTweets will search with the full archive search
+func (p *TweetFullArchiveSearchProvider) SearchTweets(ctx context.Context, query string, opts TweetSearchOpts) (*TweetSearchPage, error) {
+	resp, err := p.Client.TweetSearch(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &TweetSearchPage{
+		Raw:       resp.Raw,
+		Meta:      resp.Meta,
+		RateLimit: resp.RateLimit,
+	}, nil
+}
+
+// TweetSearchFallback will search with each provider in order, moving to the next provider when the search is rate
+// limited, over the request budget or the circuit breaker is open.  ShouldFallback will optionally decide which errors
+// move to the next provider.  The error of the last provider is returned when all of them fail.
+type TweetSearchFallback struct {
+	Providers      []TweetSearchProvider
+	ShouldFallback func(err error) bool
+}
+
+// SearchTweets will search with the first provider that does not fail
+func (f *TweetSearchFallback) SearchTweets(ctx context.Context, query string, opts TweetSearchOpts) (*TweetSearchPage, error) {
+	if len(f.Providers) == 0 {
+		return nil, fmt.Errorf("tweet search fallback: a provider is required: %w", ErrParameter)
+	}
+	shouldFallback := f.ShouldFallback
+	if shouldFallback == nil {
+		shouldFallback = searchFallbackError
+	}
+	var err error
+	for _, provider := range f.Providers {
+		var page *TweetSearchPage
+		page, err = provider.SearchTweets(ctx, query, opts)
+		if err == nil {
+			return page, nil
+		}
+		if !shouldFallback(err) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+func searchFallbackError(err error) bool {
+	return errors.Is(err, ErrRateLimited) || errors.Is(err, ErrBudgetExceeded) || errors.Is(err, ErrCircuitOpen)
+}
+
+// SearchTweets will search with the client's search provider, which defaults to the recent search
+func (c *Client) SearchTweets(ctx context.Context, query string, opts TweetSearchOpts) (*TweetSearchPage, error) {
+	return c.searchProvider().SearchTweets(ctx, query, opts)
+}
+
+func (c *Client) searchProvider() TweetSearchProvider {
+	if c.SearchProvider != nil {
+		return c.SearchProvider
+	}
+	return &TweetRecentSearchProvider{Client: c}
+}
@@ -0,0 +1,106 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestTweetSearchFallback(t *testing.T) {
+	calls := []string{}
+	provider := func(name string, err error) TweetSearchProvider {
+		return TweetSearchProviderFunc(func(ctx context.Context, query string, opts TweetSearchOpts) (*TweetSearchPage, error) {
+			calls = append(calls, name)
+			if err != nil {
+				return nil, err
+			}
+			return &TweetSearchPage{Raw: &TweetRaw{Tweets: []*TweetObj{{ID: name}}}}, nil
+		})
+	}
+	notFound := errors.New("not found")
+	tests := []struct {
+		name      string
+		providers []TweetSearchProvider
+		wantID    string
+		wantErr   error
+		wantCalls string
+	}{
+		{
+			name:      "first provider",
+			providers: []TweetSearchProvider{provider("archive", nil), provider("recent", nil)},
+			wantID:    "archive",
+			wantCalls: "archive",
+		},
+		{
+			name:      "rate limited",
+			providers: []TweetSearchProvider{provider("archive", ErrRateLimited), provider("recent", nil)},
+			wantID:    "recent",
+			wantCalls: "archive,recent",
+		},
+		{
+			name:      "over budget",
+			providers: []TweetSearchProvider{provider("archive", ErrBudgetExceeded), provider("recent", ErrRateLimited)},
+			wantErr:   ErrRateLimited,
+			wantCalls: "archive,recent",
+		},
+		{
+			name:      "no fallback",
+			providers: []TweetSearchProvider{provider("archive", notFound), provider("recent", nil)},
+			wantErr:   notFound,
+			wantCalls: "archive",
+		},
+		{
+			name:    "no providers",
+			wantErr: ErrParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = []string{}
+			f := &TweetSearchFallback{Providers: tt.providers}
+			page, err := f.SearchTweets(context.Background(), "golang", TweetSearchOpts{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("TweetSearchFallback.SearchTweets() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && page.Raw.Tweets[0].ID != tt.wantID {
+				t.Errorf("TweetSearchFallback.SearchTweets() = %s, want %s", page.Raw.Tweets[0].ID, tt.wantID)
+			}
+			if got := strings.Join(calls, ","); got != tt.wantCalls {
+				t.Errorf("TweetSearchFallback.SearchTweets() calls = %s, want %s", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestClient_SearchTweets(t *testing.T) {
+	paths := []string{}
+	client := searchPagesClient(map[string]string{
+		"": searchPage([]string{"9", "8"}, ""),
+	})
+	transport := client.Client.Transport
+	client.Client = mockHTTPClient(func(req *http.Request) *http.Response {
+		paths = append(paths, req.URL.Path)
+		resp, _ := transport.RoundTrip(req)
+		return resp
+	})
+
+	page, err := client.SearchTweets(context.Background(), "golang", TweetSearchOpts{})
+	if err != nil {
+		t.Fatalf("Client.SearchTweets() error = %v", err)
+	}
+	if len(page.Raw.Tweets) != 2 || page.Meta.NewestID != "9" {
+		t.Errorf("Client.SearchTweets() = %+v", page.Meta)
+	}
+
+	client.SearchProvider = &TweetFullArchiveSearchProvider{Client: client}
+	pager := &TweetSearchPager{Client: client, Query: "golang"}
+	if !pager.Next(context.Background()) {
+		t.Fatalf("TweetSearchPager.Next() error = %v", pager.Err())
+	}
+	want := "/" + string(tweetRecentSearchEndpoint) + ",/" + string(tweetSearchEndpoint)
+	if got := strings.Join(paths, ","); got != want {
+		t.Errorf("searched paths = %s, want %s", got, want)
+	}
+}
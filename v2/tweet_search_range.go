@@ -0,0 +1,203 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	tweetSearchRangeWindow    = 24 * time.Hour
+	tweetSearchRangeMinWindow = time.Minute
+	tweetSnowflakeEpoch       = 1288834974657
+)
+
+// TweetSearchRange will search a long time range by splitting it into windows, searching the windows newest first
+// and stitching the pages together, so the tweets are delivered newest first like a single search.  The range is not
+// safe for concurrent use.
+//
+//	search := &twitter.TweetSearchRange{
+//		Client:      client,
+//		Query:       "golang",
+//		FullArchive: true,
+//		Opts: twitter.TweetSearchOpts{
+//			StartTime: time.Now().AddDate(0, -1, 0),
+//		},
+//	}
+//	for search.Next(ctx) {
+//		page := search.Page()
+//	}
+//	if err := search.Err(); err != nil {
+//		...
+//	}
+//
+// When a window reaches the max window results and there are still more pages, the rest of the window is split in
+// half and the halves are searched instead.
+type TweetSearchRange struct {
+	Client *Client
+	Query  string
+	// Opts are the search options, the start time is required and a zero end time is now.  The pagination token and
+	// the window times are set by the range.
+	Opts TweetSearchOpts
+	// FullArchive will use the full archive search instead of the recent search
+	FullArchive bool
+	// Provider is the source of the pages, it defaults to the full archive search or the client's search provider
+	Provider TweetSearchProvider
+	// Window is the longest time span of a search, defaults to one day
+	Window time.Duration
+	// MaxWindowResults is the number of tweets after which the rest of a window is split, zero is no limit
+	MaxWindowResults int
+	// Dedupe will remove the tweets that have already been yielded from the pages
+	Dedupe *TweetDedupe
+
+	started bool
+	windows []tweetSearchWindow
+	pager   *TweetSearchPager
+	window  tweetSearchWindow
+	results int
+	page    *TweetSearchPage
+	err     error
+}
+
+type tweetSearchWindow struct {
+	start   time.Time
+	end     time.Time
+	untilID string
+}
+
+// Next will fetch the next page and returns false when there are no more pages or there is an error
+func (r *TweetSearchRange) Next(ctx context.Context) bool {
+	if r.err != nil {
+		return false
+	}
+	if !r.started {
+		if err := r.start(); err != nil {
+			r.err = err
+			return false
+		}
+		r.started = true
+	}
+
+	for {
+		if r.pager == nil {
+			if len(r.windows) == 0 {
+				return false
+			}
+			r.window = r.windows[0]
+			r.windows = r.windows[1:]
+			r.results = 0
+			opts := r.Opts
+			opts.StartTime = r.window.start
+			opts.EndTime = r.window.end
+			opts.NextToken = ""
+			if len(r.window.untilID) > 0 {
+				opts.UntilID = r.window.untilID
+			}
+			r.pager = &TweetSearchPager{
+				Client:      r.Client,
+				Query:       r.Query,
+				Opts:        opts,
+				FullArchive: r.FullArchive,
+				Provider:    r.Provider,
+				Dedupe:      r.Dedupe,
+			}
+		}
+
+		if !r.pager.Next(ctx) {
+			if err := r.pager.Err(); err != nil {
+				r.err = fmt.Errorf("tweet search range %s - %s: %w", r.window.start.Format(time.RFC3339), r.window.end.Format(time.RFC3339), err)
+				return false
+			}
+			r.pager = nil
+			continue
+		}
+		r.page = r.pager.Page()
+		if r.page.Meta != nil {
+			r.results += r.page.Meta.ResultCount
+		}
+		if len(r.pager.Cursor().NextToken) > 0 && r.MaxWindowResults > 0 && r.results >= r.MaxWindowResults {
+			r.split()
+		}
+		return true
+	}
+}
+
+// Page is the current page
+func (r *TweetSearchRange) Page() *TweetSearchPage {
+	return r.page
+}
+
+// Err is the error that stopped the search
+func (r *TweetSearchRange) Err() error {
+	return r.err
+}
+
+func (r *TweetSearchRange) start() error {
+	if r.Opts.StartTime.IsZero() {
+		return fmt.Errorf("tweet search range: a start time is required: %w", ErrParameter)
+	}
+	window := r.Window
+	if window <= 0 {
+		window = tweetSearchRangeWindow
+	}
+	end := r.Opts.EndTime
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if !r.Opts.StartTime.Before(end) {
+		return fmt.Errorf("tweet search range: the start time must be before the end time: %w", ErrParameter)
+	}
+
+	r.windows = []tweetSearchWindow{}
+	for windowEnd := end; windowEnd.After(r.Opts.StartTime); windowEnd = windowEnd.Add(-window) {
+		windowStart := windowEnd.Add(-window)
+		if windowStart.Before(r.Opts.StartTime) {
+			windowStart = r.Opts.StartTime
+		}
+		r.windows = append(r.windows, tweetSearchWindow{
+			start: windowStart,
+			end:   windowEnd,
+		})
+	}
+	// a zero end time lets the search api use its own latest time
+	if r.Opts.EndTime.IsZero() {
+		r.windows[0].end = time.Time{}
+	}
+	return nil
+}
+
+// split will replace the rest of the current window, older than the current page, with its two halves
+func (r *TweetSearchRange) split() {
+	if r.page.Meta == nil {
+		return
+	}
+	oldestID := r.page.Meta.OldestID
+	oldest, ok := tweetIDTime(oldestID)
+	if !ok || oldest.Sub(r.window.start) < 2*tweetSearchRangeMinWindow {
+		return
+	}
+	middle := r.window.start.Add(oldest.Sub(r.window.start) / 2).Truncate(time.Second)
+	halves := []tweetSearchWindow{
+		{
+			start:   middle,
+			end:     oldest.Add(time.Second),
+			untilID: oldestID,
+		},
+		{
+			start: r.window.start,
+			end:   middle,
+		},
+	}
+	r.windows = append(halves, r.windows...)
+	r.pager = nil
+}
+
+// tweetIDTime is the time encoded in a tweet id
+func tweetIDTime(id string) (time.Time, bool) {
+	value, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || value <= 0 {
+		return time.Time{}, false
+	}
+	return time.UnixMilli((value >> 22) + tweetSnowflakeEpoch), true
+}
@@ -0,0 +1,118 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func tweetIDAt(t time.Time, seq int64) string {
+	return strconv.FormatInt(((t.UnixMilli()-tweetSnowflakeEpoch)<<22)+seq, 10)
+}
+
+func searchRangeClient(pages map[string]string) *Client {
+	return &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			q := req.URL.Query()
+			key := fmt.Sprintf("%s/%s", q.Get("start_time"), q.Get("end_time"))
+			if until := q.Get("until_id"); len(until) > 0 {
+				key += "<" + until
+			}
+			if next := q.Get("next_token"); len(next) > 0 {
+				key += "@" + next
+			}
+			body, has := pages[key]
+			if !has {
+				log.Panicf("the page is not correct %s", key)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+}
+
+func TestTweetSearchRange(t *testing.T) {
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) string {
+		return start.AddDate(0, 0, d).Format(time.RFC3339)
+	}
+	client := searchRangeClient(map[string]string{
+		day(2) + "/" + day(3):         searchPage([]string{"9", "8"}, "p2"),
+		day(2) + "/" + day(3) + "@p2": searchPage([]string{"7"}, ""),
+		day(1) + "/" + day(2):         searchPage([]string{}, ""),
+		day(0) + "/" + day(1):         searchPage([]string{"6", "5"}, ""),
+	})
+	search := &TweetSearchRange{
+		Client: client,
+		Query:  "golang",
+		Opts: TweetSearchOpts{
+			StartTime: start,
+			EndTime:   start.AddDate(0, 0, 3),
+		},
+	}
+	got := []string{}
+	for search.Next(context.Background()) {
+		for _, tweet := range search.Page().Raw.Tweets {
+			got = append(got, tweet.ID)
+		}
+	}
+	if err := search.Err(); err != nil {
+		t.Fatalf("TweetSearchRange.Next() error = %v", err)
+	}
+	if strings.Join(got, ",") != "9,8,7,6,5" {
+		t.Errorf("TweetSearchRange = %v, want 9,8,7,6,5", got)
+	}
+
+	if (&TweetSearchRange{Client: client, Query: "golang"}).Next(context.Background()) {
+		t.Errorf("TweetSearchRange.Next() should require a start time")
+	}
+}
+
+func TestTweetSearchRange_Split(t *testing.T) {
+	start := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(4 * time.Hour)
+	newest := tweetIDAt(start.Add(3*time.Hour), 1)
+	oldest := tweetIDAt(start.Add(2*time.Hour), 1)
+	format := func(t time.Time) string {
+		return t.Format(time.RFC3339)
+	}
+	client := searchRangeClient(map[string]string{
+		format(start) + "/" + format(end): searchPage([]string{newest, oldest}, "p2"),
+		format(start.Add(time.Hour)) + "/" + format(start.Add(2*time.Hour+time.Second)) + "<" + oldest: searchPage([]string{"7"}, ""),
+		format(start) + "/" + format(start.Add(time.Hour)):                                             searchPage([]string{"6"}, ""),
+	})
+	search := &TweetSearchRange{
+		Client:           client,
+		Query:            "golang",
+		Window:           4 * time.Hour,
+		MaxWindowResults: 2,
+		Opts: TweetSearchOpts{
+			StartTime: start,
+			EndTime:   end,
+		},
+	}
+	got := []string{}
+	for search.Next(context.Background()) {
+		for _, tweet := range search.Page().Raw.Tweets {
+			got = append(got, tweet.ID)
+		}
+	}
+	if err := search.Err(); err != nil {
+		t.Fatalf("TweetSearchRange.Next() error = %v", err)
+	}
+	want := strings.Join([]string{newest, oldest, "7", "6"}, ",")
+	if strings.Join(got, ",") != want {
+		t.Errorf("TweetSearchRange = %v, want %s", got, want)
+	}
+}
@@ -0,0 +1,160 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const tweetSearchWatcherInterval = time.Minute
+
+// TweetSearchWatcher will poll the recent search for new tweets, a cheap stream for callers without filtered stream
+// access.  The since id is carried forward from the newest tweet of each poll, and the tweets of the previous poll are
+// not delivered again.
+//
+//	watcher := &twitter.TweetSearchWatcher{Client: client, Query: "golang"}
+//	for tweet := range watcher.Watch(ctx) {
+//		...
+//	}
+//	if err := watcher.Err(); err != nil {
+//		...
+//	}
+type TweetSearchWatcher struct {
+	Client *Client
+	Query  string
+	// Interval is the time between polls, defaults to one minute
+	Interval time.Duration
+	// Opts are the options of the search request, the since id and next token are set by the watcher
+	Opts TweetRecentSearchOpts
+	// SinceID is the newest tweet that has been delivered
+	SinceID string
+	// Backfill will deliver the existing tweets when there is no since id, otherwise the first poll only records
+	// the newest tweet
+	Backfill bool
+	// Buffer is the size of the tweet channel
+	Buffer int
+	// Dedupe will suppress the tweets that have already been delivered, beyond the previous poll
+	Dedupe *TweetDedupe
+
+	seen map[string]bool
+	err  error
+}
+
+// Watch will poll until the context is done or a poll fails, delivering the new tweets oldest first.  The channel is
+// closed when the watcher stops, and then Err has the reason.  The watcher can only be watched once at a time.
+func (w *TweetSearchWatcher) Watch(ctx context.Context) <-chan *TweetDictionary {
+	tweets := make(chan *TweetDictionary, w.Buffer)
+	w.err = nil
+	go func() {
+		defer close(tweets)
+		w.err = w.run(ctx, tweets)
+	}()
+	return tweets
+}
+
+// Err is the reason the watcher stopped, it is valid after the channel is closed
+func (w *TweetSearchWatcher) Err() error {
+	return w.err
+}
+
+func (w *TweetSearchWatcher) run(ctx context.Context, tweets chan<- *TweetDictionary) error {
+	interval := w.Interval
+	if interval <= 0 {
+		interval = tweetSearchWatcherInterval
+	}
+
+	if len(w.SinceID) == 0 && !w.Backfill {
+		if err := w.prime(ctx); err != nil {
+			return err
+		}
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		polled, err := w.Poll(ctx)
+		if err != nil {
+			return err
+		}
+		for _, tweet := range polled {
+			select {
+			case tweets <- tweet:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		timer.Reset(interval)
+	}
+}
+
+// Poll will return the tweets newer than the since id, oldest first, and advance the since id
+func (w *TweetSearchWatcher) Poll(ctx context.Context) ([]*TweetDictionary, error) {
+	opts := TweetSearchOpts(w.Opts)
+	opts.SinceID = w.SinceID
+	opts.NextToken = ""
+	pager := &TweetSearchPager{
+		Client: w.Client,
+		Query:  w.Query,
+		Opts:   opts,
+		Dedupe: w.Dedupe,
+	}
+
+	pages := [][]*TweetDictionary{}
+	for pager.Next(ctx) {
+		raw := pager.Page().Raw
+		page := []*TweetDictionary{}
+		if raw != nil {
+			dictionaries := raw.TweetDictionaries()
+			for _, tweet := range raw.Tweets {
+				if tweet != nil {
+					page = append(page, dictionaries[tweet.ID])
+				}
+			}
+		}
+		pages = append(pages, page)
+	}
+	if err := pager.Err(); err != nil {
+		return nil, fmt.Errorf("tweet search watcher: %w", err)
+	}
+
+	seen := map[string]bool{}
+	polled := []*TweetDictionary{}
+	for i := len(pages) - 1; i >= 0; i-- {
+		for j := len(pages[i]) - 1; j >= 0; j-- {
+			tweet := pages[i][j]
+			if w.seen[tweet.Tweet.ID] || seen[tweet.Tweet.ID] {
+				continue
+			}
+			seen[tweet.Tweet.ID] = true
+			polled = append(polled, tweet)
+		}
+	}
+	if newestID := pager.Cursor().NewestID; len(newestID) > 0 {
+		w.SinceID = newestID
+	}
+	if len(seen) > 0 {
+		w.seen = seen
+	}
+	return polled, nil
+}
+
+// prime will record the newest tweet without delivering it
+func (w *TweetSearchWatcher) prime(ctx context.Context) error {
+	opts := w.Opts
+	opts.MaxResults = tweetRecentSearchMinResults
+	opts.NextToken = ""
+	resp, err := w.Client.TweetRecentSearch(ctx, w.Query, opts)
+	if err != nil {
+		return fmt.Errorf("tweet search watcher: %w", err)
+	}
+	if resp.Meta != nil {
+		w.SinceID = resp.Meta.NewestID
+	}
+	return nil
+}
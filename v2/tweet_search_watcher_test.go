@@ -0,0 +1,58 @@
+package twitter
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTweetSearchWatcher_Poll(t *testing.T) {
+	client := searchPagesClient(map[string]string{
+		"since:5": searchPage([]string{"9", "8"}, "p2"),
+		"p2":      searchPage([]string{"7", "6"}, ""),
+		"since:9": searchPage([]string{"10", "9"}, ""),
+	})
+	watcher := &TweetSearchWatcher{Client: client, Query: "golang", SinceID: "5"}
+	ids := func() string {
+		polled, err := watcher.Poll(context.Background())
+		if err != nil {
+			t.Fatalf("TweetSearchWatcher.Poll() error = %v", err)
+		}
+		got := []string{}
+		for _, tweet := range polled {
+			got = append(got, tweet.Tweet.ID)
+		}
+		return strings.Join(got, ",")
+	}
+	if got := ids(); got != "6,7,8,9" {
+		t.Errorf("TweetSearchWatcher.Poll() = %s, want 6,7,8,9", got)
+	}
+	if watcher.SinceID != "9" {
+		t.Errorf("TweetSearchWatcher.Poll() since id = %s, want 9", watcher.SinceID)
+	}
+	if got := ids(); got != "10" {
+		t.Errorf("TweetSearchWatcher.Poll() = %s, the previous poll should not be delivered again", got)
+	}
+}
+
+func TestTweetSearchWatcher_Watch(t *testing.T) {
+	client := searchPagesClient(map[string]string{
+		"":        searchPage([]string{"5"}, ""),
+		"since:5": searchPage([]string{"6"}, ""),
+		"since:6": searchPage([]string{}, ""),
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	watcher := &TweetSearchWatcher{Client: client, Query: "golang", Interval: time.Millisecond}
+	tweets := watcher.Watch(ctx)
+	if tweet := <-tweets; tweet.Tweet.ID != "6" {
+		t.Errorf("TweetSearchWatcher.Watch() = %s, want 6", tweet.Tweet.ID)
+	}
+	cancel()
+	for range tweets {
+	}
+	if err := watcher.Err(); err != context.Canceled {
+		t.Errorf("TweetSearchWatcher.Err() = %v, want canceled", err)
+	}
+}
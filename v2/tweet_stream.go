@@ -3,6 +3,7 @@ package twitter
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -30,8 +32,14 @@ const (
 	// ErrorMessageType is the error system message type
 	ErrorMessageType SystemMessageType = "error"
 
-	tweetStart  = "data"
-	keepAliveTO = 21 * time.Second
+	tweetStart   = "data"
+	keepAliveTO  = 21 * time.Second
+	streamBuffer = 10
+	// streamMaxMessage is the largest message the stream will scan, a tweet with its expansions can be over the
+	// scanner's default 64KB
+	streamMaxMessage = 16 << 20
+
+	streamDrainInterval = 10 * time.Millisecond
 
 	// TweetErrorType represents the tweet stream errors
 	TweetErrorType StreamErrorType = "tweet"
@@ -39,6 +47,8 @@ const (
 	SystemErrorType StreamErrorType = "system"
 	// DisconnectErrorType represents the disconnection errors
 	DisconnectErrorType StreamErrorType = "disconnect"
+	// ComplianceErrorType represents the compliance stream errors
+	ComplianceErrorType StreamErrorType = "compliance"
 
 	disconnectionErrorsKey = "errors"
 	disconnectionTitleKey  = "title"
@@ -48,11 +58,18 @@ const (
 	systemMsgStream   streamType = 2
 	disconnectionErrs streamType = 3
 	disconnectionErr  streamType = 4
+	complianceStream  streamType = 5
 )
 
-// TweetSampleStreamOpts are the options for sample tweet stream
+// TweetSampleStreamOpts are the options for sample tweet stream.  BackfillMinutes will recover up to five minutes of
+// the tweets missed while disconnected, it requires the access level that allows backfill, see StreamBackfillMinutes.
+// StallTimeout is the time without data or a keep alive before the stream is stalled, it defaults to 21 seconds.
+// Buffer and Overflow are the size of the message channels and what is done when one is full, see TweetStreamOpts.
 type TweetSampleStreamOpts struct {
 	BackfillMinutes int
+	StallTimeout    time.Duration
+	Buffer          int
+	Overflow        StreamOverflowPolicy
 	Expansions      []Expansion
 	MediaFields     []MediaField
 	PlaceFields     []PlaceField
@@ -89,9 +106,15 @@ func (t TweetSampleStreamOpts) addQuery(req *http.Request) {
 	}
 }
 
-// TweetSearchStreamOpts are the options for the search stream
+// TweetSearchStreamOpts are the options for the search stream.  BackfillMinutes will recover up to five minutes of the
+// tweets missed while disconnected, it requires the access level that allows backfill, see StreamBackfillMinutes.
+// StallTimeout is the time without data or a keep alive before the stream is stalled, it defaults to 21 seconds.
+// Buffer and Overflow are the size of the message channels and what is done when one is full, see TweetStreamOpts.
 type TweetSearchStreamOpts struct {
 	BackfillMinutes int
+	StallTimeout    time.Duration
+	Buffer          int
+	Overflow        StreamOverflowPolicy
 	Expansions      []Expansion
 	MediaFields     []MediaField
 	PlaceFields     []PlaceField
@@ -128,6 +151,37 @@ func (t TweetSearchStreamOpts) addQuery(req *http.Request) {
 	}
 }
 
+// StreamBackfillMinutes returns the backfill minutes that cover the time since the stream was disconnected, rounded up
+// and capped at the five minutes twitter allows.  A zero disconnected time has no backfill.
+func StreamBackfillMinutes(disconnected time.Time) int {
+	if disconnected.IsZero() {
+		return 0
+	}
+	missed := time.Since(disconnected)
+	if missed <= 0 {
+		return 0
+	}
+	minutes := int((missed + time.Minute - 1) / time.Minute)
+	if minutes > streamMaxBackfillMinutes {
+		return streamMaxBackfillMinutes
+	}
+	return minutes
+}
+
+// StreamOverflowPolicy is what the stream does with a message when its channel is full
+type StreamOverflowPolicy int
+
+const (
+	// StreamOverflowDropNewest will drop the new message, the default
+	StreamOverflowDropNewest StreamOverflowPolicy = iota
+	// StreamOverflowBlock will wait for the consumer, which stops reading the stream until there is room
+	StreamOverflowBlock
+	// StreamOverflowDropOldest will drop the oldest message in the channel to make room for the new message
+	StreamOverflowDropOldest
+	// StreamOverflowError will send an error that matches ErrStreamOverflow and close the stream
+	StreamOverflowError
+)
+
 // StreamError is the error from the streaming
 type StreamError struct {
 	Type StreamErrorType
@@ -209,9 +263,21 @@ func (d disconnection) toConnection() *Connection {
 	}
 }
 
-// TweetMessage is the tweet stream message
+// TweetMessage is the tweet stream message.  The matching rules are the filtered stream rules that the tweet matched.
 type TweetMessage struct {
-	Raw *TweetRaw
+	Raw           *TweetRaw
+	MatchingRules []*TweetStreamMatchingRule
+}
+
+// TweetStreamMatchingRule is a filtered stream rule that a tweet matched
+type TweetStreamMatchingRule struct {
+	ID  TweetSearchStreamRuleID `json:"id"`
+	Tag string                  `json:"tag"`
+}
+
+type tweetStreamMessage struct {
+	tweetraw
+	MatchingRules []*TweetStreamMatchingRule `json:"matching_rules"`
 }
 
 // SystemMessage is the system stream message
@@ -225,23 +291,70 @@ type TweetStream struct {
 	tweets        chan *TweetMessage
 	system        chan map[SystemMessageType]SystemMessage
 	disconnection chan *DisconnectionError
+	compliance    chan *StreamComplianceEvent
+	done          chan struct{}
 	close         chan bool
+	closeOnce     sync.Once
+	drain         chan struct{}
+	drainOnce     sync.Once
 	err           chan error
 	alive         bool
+	stallTimeout  time.Duration
+	overflow      StreamOverflowPolicy
+	overflowed    bool
+	dropped       int64
+	consumed      func(posts int)
 	mutex         sync.RWMutex
 	RateLimit     *RateLimit
 }
 
+// TweetStreamOpts are the options of the stream handler.  StallTimeout is the time without data or a keep alive
+// before the stream is stalled, it defaults to 21 seconds.  Buffer is the size of the message channels, it defaults to
+// ten.  Overflow is what is done with a message when its channel is full.
+type TweetStreamOpts struct {
+	StallTimeout time.Duration
+	Buffer       int
+	Overflow     StreamOverflowPolicy
+	// consumed is called for each tweet, the client counts them against its tweet cap
+	consumed func(posts int)
+}
+
 // StartTweetStream will start the tweet streaming
 func StartTweetStream(stream io.ReadCloser) *TweetStream {
+	return StartTweetStreamWithOpts(stream, TweetStreamOpts{})
+}
+
+// StartTweetStreamWithStallTimeout will start the tweet streaming.  When nothing, not even the keep alive, arrives
+// within the stall timeout, an error that matches ErrStreamStalled is sent and the stream is closed so it can be
+// reconnected.  A stream that ends sends its read error, or io.EOF, and is closed without waiting for the timeout.
+func StartTweetStreamWithStallTimeout(stream io.ReadCloser, stallTimeout time.Duration) *TweetStream {
+	return StartTweetStreamWithOpts(stream, TweetStreamOpts{StallTimeout: stallTimeout})
+}
+
+// StartTweetStreamWithOpts will start the tweet streaming with the stall timeout, buffer and overflow policy
+func StartTweetStreamWithOpts(stream io.ReadCloser, opts TweetStreamOpts) *TweetStream {
+	stallTimeout := opts.StallTimeout
+	if stallTimeout <= 0 {
+		stallTimeout = keepAliveTO
+	}
+	buffer := opts.Buffer
+	if buffer <= 0 {
+		buffer = streamBuffer
+	}
 	ts := &TweetStream{
-		tweets:        make(chan *TweetMessage, 10),
-		system:        make(chan map[SystemMessageType]SystemMessage, 10),
-		disconnection: make(chan *DisconnectionError, 10),
+		tweets:        make(chan *TweetMessage, buffer),
+		system:        make(chan map[SystemMessageType]SystemMessage, buffer),
+		disconnection: make(chan *DisconnectionError, buffer),
+		compliance:    make(chan *StreamComplianceEvent, buffer),
+		done:          make(chan struct{}),
 		close:         make(chan bool),
-		err:           make(chan error),
+		drain:         make(chan struct{}),
+		err:           make(chan error, streamBuffer),
 		mutex:         sync.RWMutex{},
 		alive:         true,
+		stallTimeout:  stallTimeout,
+		overflow:      opts.Overflow,
+		consumed:      opts.consumed,
 	}
 
 	go ts.handle(stream)
@@ -263,80 +376,208 @@ func (ts *TweetStream) Connection() bool {
 }
 
 func (ts *TweetStream) handle(stream io.ReadCloser) {
-	defer stream.Close()
+	defer close(ts.done)
 	defer close(ts.tweets)
 	defer close(ts.system)
-	defer close(ts.close)
+	defer close(ts.disconnection)
+	defer close(ts.compliance)
 	defer close(ts.err)
 
-	scanner := bufio.NewScanner(stream)
-	scanner.Split(streamSeparator)
-	timer := time.NewTimer(keepAliveTO)
+	lines := make(chan []byte)
+	ended := make(chan error, 1)
+	done := make(chan struct{})
+	// closing the stream will unblock the scanner's read
+	defer stream.Close()
+	defer close(done)
+	go scanStream(stream, lines, ended, done)
+
+	timer := time.NewTimer(ts.stallTimeout)
+	defer timer.Stop()
 	for {
+		var msg []byte
 		select {
 		case <-ts.close:
 			return
+		case <-ts.drain:
+			// stop reading so only the buffered messages are left for the consumer
+			stream.Close()
+			ts.heartbeat(false)
+			ts.waitDrained()
+			return
 		case <-timer.C:
 			ts.heartbeat(false)
-		default:
-		}
-
-		if !scanner.Scan() {
-			continue
+			sErr := &StreamError{
+				Type: DisconnectErrorType,
+				Msg:  fmt.Sprintf("nothing received in %s", ts.stallTimeout),
+				Err:  ErrStreamStalled,
+			}
+			select {
+			case ts.err <- sErr:
+			default:
+			}
+			return
+		case line, ok := <-lines:
+			if !ok {
+				ts.heartbeat(false)
+				sErr := &StreamError{
+					Type: DisconnectErrorType,
+					Msg:  "the stream has ended",
+					Err:  <-ended,
+				}
+				select {
+				case ts.err <- sErr:
+				default:
+				}
+				return
+			}
+			msg = line
 		}
 
-		timer.Stop()
-		timer.Reset(keepAliveTO)
 		ts.heartbeat(true)
-
-		msg := scanner.Bytes()
-
-		if len(msg) == 0 {
-			continue
+		if len(msg) > 0 {
+			ts.handleMessage(msg)
 		}
-
-		reader, err := normalizeStream(msg)
-		if err != nil {
+		if ts.overflowed {
+			sErr := &StreamError{
+				Type: DisconnectErrorType,
+				Msg:  "the consumer is behind",
+				Err:  ErrStreamOverflow,
+			}
 			select {
-			case ts.err <- fmt.Errorf("stream error: normalize error %w", err):
+			case ts.err <- sErr:
 			default:
 			}
-			continue
+			return
 		}
 
-		sType, err := decodeStreamType(reader)
-		if err != nil {
+		// the timer is reset after the message is delivered, so a blocked delivery is not a stall
+		if !timer.Stop() {
 			select {
-			case ts.err <- fmt.Errorf("stream error: unmarshal error %w", err):
+			case <-timer.C:
 			default:
 			}
-			continue
 		}
-		if _, err := reader.Seek(0, io.SeekStart); err != nil {
-			select {
-			case ts.err <- fmt.Errorf("stream error: seek error %w", err):
-			default:
-			}
-			continue
+		timer.Reset(ts.stallTimeout)
+	}
+}
+
+func (ts *TweetStream) handleMessage(msg []byte) {
+	reader, err := normalizeStream(msg)
+	if err != nil {
+		select {
+		case ts.err <- fmt.Errorf("stream error: normalize error %w", err):
+		default:
+		}
+		return
+	}
+
+	sType, err := decodeStreamType(reader)
+	if err != nil {
+		select {
+		case ts.err <- fmt.Errorf("stream error: unmarshal error %w", err):
+		default:
+		}
+		return
+	}
+	if _, err := reader.Seek(0, io.SeekStart); err != nil {
+		select {
+		case ts.err <- fmt.Errorf("stream error: seek error %w", err):
+		default:
+		}
+		return
+	}
+	decoder := json.NewDecoder(reader)
+
+	switch sType {
+	case tweetStream:
+		ts.handleTweet(decoder)
+	case systemMsgStream:
+		ts.handleSystemMessage(decoder)
+	case disconnectionErrs:
+		ts.handleDisconnectErrors(decoder)
+	case disconnectionErr:
+		ts.handleDisconnectError(decoder)
+	case complianceStream:
+		ts.handleCompliance(decoder)
+	default:
+	}
+}
+
+// waitDrained will wait for the consumer to receive the buffered messages, the messages left when the stream is closed
+// are dropped
+func (ts *TweetStream) waitDrained() {
+	ticker := time.NewTicker(streamDrainInterval)
+	defer ticker.Stop()
+	for ts.buffered() > 0 {
+		select {
+		case <-ts.close:
+			atomic.AddInt64(&ts.dropped, int64(streamDiscard(ts.tweets)+streamDiscard(ts.system)+
+				streamDiscard(ts.disconnection)+streamDiscard(ts.compliance)))
+			return
+		case <-ticker.C:
 		}
-		decoder := json.NewDecoder(reader)
-
-		switch sType {
-		case tweetStream:
-			ts.handleTweet(decoder)
-		case systemMsgStream:
-			ts.handleSystemMessage(decoder)
-		case disconnectionErrs:
-			ts.handleDisconnectErrors(decoder)
-		case disconnectionErr:
-			ts.handleDisconnectError(decoder)
+	}
+}
+
+func (ts *TweetStream) buffered() int {
+	return len(ts.tweets) + len(ts.system) + len(ts.disconnection) + len(ts.compliance)
+}
+
+// streamDiscard will remove the messages in the channel, returning the number removed
+func streamDiscard[T any](ch chan T) int {
+	discarded := 0
+	for {
+		select {
+		case <-ch:
+			discarded++
 		default:
+			return discarded
 		}
 	}
 }
 
+// Dropped returns the number of messages dropped because their channel was full
+func (ts *TweetStream) Dropped() int64 {
+	return atomic.LoadInt64(&ts.dropped)
+}
+
+// streamDeliver will send the message on the channel following the stream's overflow policy
+func streamDeliver[T any](ts *TweetStream, ch chan T, msg T) {
+	if ts.overflow == StreamOverflowBlock {
+		select {
+		case ch <- msg:
+		case <-ts.close:
+		}
+		return
+	}
+
+	select {
+	case ch <- msg:
+		return
+	default:
+	}
+	switch ts.overflow {
+	case StreamOverflowDropOldest:
+		select {
+		case <-ch:
+			atomic.AddInt64(&ts.dropped, 1)
+		default:
+		}
+		select {
+		case ch <- msg:
+		default:
+			atomic.AddInt64(&ts.dropped, 1)
+		}
+	case StreamOverflowError:
+		atomic.AddInt64(&ts.dropped, 1)
+		ts.overflowed = true
+	default:
+		atomic.AddInt64(&ts.dropped, 1)
+	}
+}
+
 func (ts *TweetStream) handleTweet(decoder *json.Decoder) {
-	single := &tweetraw{}
+	single := &tweetStreamMessage{}
 	if err := decoder.Decode(single); err != nil {
 		sErr := &StreamError{
 			Type: TweetErrorType,
@@ -349,6 +590,9 @@ func (ts *TweetStream) handleTweet(decoder *json.Decoder) {
 		}
 		return
 	}
+	if ts.consumed != nil && single.Tweet != nil {
+		ts.consumed(1)
+	}
 	raw := &TweetRaw{}
 	raw.Tweets = make([]*TweetObj, 1)
 	raw.Tweets[0] = single.Tweet
@@ -356,13 +600,11 @@ func (ts *TweetStream) handleTweet(decoder *json.Decoder) {
 	raw.Errors = single.Errors
 
 	tweetMsg := &TweetMessage{
-		Raw: raw,
+		Raw:           raw,
+		MatchingRules: single.MatchingRules,
 	}
 
-	select {
-	case ts.tweets <- tweetMsg:
-	default:
-	}
+	streamDeliver(ts, ts.tweets, tweetMsg)
 }
 
 func (ts *TweetStream) handleSystemMessage(decoder *json.Decoder) {
@@ -379,10 +621,7 @@ func (ts *TweetStream) handleSystemMessage(decoder *json.Decoder) {
 		}
 		return
 	}
-	select {
-	case ts.system <- sysMsg:
-	default:
-	}
+	streamDeliver(ts, ts.system, sysMsg)
 
 }
 
@@ -416,10 +655,7 @@ func (ts *TweetStream) handleDisconnectErrors(decoder *json.Decoder) {
 		}
 	}
 
-	select {
-	case ts.disconnection <- ds:
-	default:
-	}
+	streamDeliver(ts, ts.disconnection, ds)
 }
 
 func (ts *TweetStream) handleDisconnectError(decoder *json.Decoder) {
@@ -448,13 +684,27 @@ func (ts *TweetStream) handleDisconnectError(decoder *json.Decoder) {
 		ds.Connections = append(ds.Connections, d.toConnection())
 	}
 
-	select {
-	case ts.disconnection <- ds:
-	default:
-	}
+	streamDeliver(ts, ts.disconnection, ds)
 
 }
 
+func (ts *TweetStream) handleCompliance(decoder *json.Decoder) {
+	event, err := decodeStreamCompliance(decoder)
+	if err != nil {
+		sErr := &StreamError{
+			Type: ComplianceErrorType,
+			Msg:  "unmarshal compliance stream",
+			Err:  err,
+		}
+		select {
+		case ts.err <- sErr:
+		default:
+		}
+		return
+	}
+	streamDeliver(ts, ts.compliance, event)
+}
+
 // Tweets will return the channel to receive tweet stream messages
 func (ts *TweetStream) Tweets() <-chan *TweetMessage {
 	return ts.tweets
@@ -465,19 +715,79 @@ func (ts *TweetStream) SystemMessages() <-chan map[SystemMessageType]SystemMessa
 	return ts.system
 }
 
+// DisconnectionErrors will return the channel to receive disconnect error messages
+func (ts *TweetStream) DisconnectionErrors() <-chan *DisconnectionError {
+	return ts.disconnection
+}
+
 // DisconnectionError will return the channel to receive disconnect error messages
+//
+// Deprecated: use DisconnectionErrors
 func (ts *TweetStream) DisconnectionError() <-chan *DisconnectionError {
 	return ts.disconnection
 }
 
+// Compliance will return the channel to receive the compliance notices, such as deleted tweets, withheld tweets and
+// protected users, which should be applied to any stored content
+func (ts *TweetStream) Compliance() <-chan *StreamComplianceEvent {
+	return ts.compliance
+}
+
+// Done will return a channel that is closed once the stream has stopped and all of the other channels are closed
+func (ts *TweetStream) Done() <-chan struct{} {
+	return ts.done
+}
+
 // Err will return the channel to receive any stream errors
 func (ts *TweetStream) Err() <-chan error {
 	return ts.err
 }
 
-// Close will close the stream and all channels
+// Shutdown will stop reading the stream and wait for the consumer to receive the buffered messages before the
+// channels are closed.  When the context is done first, the messages left are dropped and the context's error is
+// returned.  The number of messages dropped by the stream, including the overflow, is returned once the channels are
+// closed.
+func (ts *TweetStream) Shutdown(ctx context.Context) (int64, error) {
+	ts.drainOnce.Do(func() {
+		close(ts.drain)
+	})
+	select {
+	case <-ts.done:
+		return ts.Dropped(), nil
+	case <-ctx.Done():
+		ts.Close()
+		<-ts.done
+		return ts.Dropped(), ctx.Err()
+	}
+}
+
+// Close will close the stream and all channels, it is safe to call more than once
 func (ts *TweetStream) Close() {
-	ts.close <- true
+	ts.closeOnce.Do(func() {
+		close(ts.close)
+	})
+}
+
+// scanStream will send each message, and each keep alive as an empty message, until the stream ends.  The scanner's
+// error, or io.EOF when the stream was closed by twitter, is sent to ended before the lines are closed.
+func scanStream(stream io.Reader, lines chan<- []byte, ended chan<- error, done <-chan struct{}) {
+	defer close(lines)
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), streamMaxMessage)
+	scanner.Split(streamSeparator)
+	for scanner.Scan() {
+		line := append([]byte(nil), scanner.Bytes()...)
+		select {
+		case lines <- line:
+		case <-done:
+			return
+		}
+	}
+	err := scanner.Err()
+	if err == nil {
+		err = io.EOF
+	}
+	ended <- err
 }
 
 func streamSeparator(data []byte, atEOF bool) (int, []byte, error) {
@@ -498,6 +808,9 @@ func decodeStreamType(reader io.Reader) (streamType, error) {
 	if err := json.NewDecoder(reader).Decode(&mm); err != nil {
 		return decodeErrStream, fmt.Errorf("decode stream type: %w", err)
 	}
+	if _, ok := streamComplianceType(mm); ok {
+		return complianceStream, nil
+	}
 	for k := range mm {
 		switch k {
 		case tweetStart:
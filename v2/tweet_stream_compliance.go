@@ -0,0 +1,116 @@
+package twitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// StreamComplianceEventType is the type of compliance notice sent on the stream
+type StreamComplianceEventType string
+
+const (
+	// StreamComplianceDelete is a deleted tweet
+	StreamComplianceDelete StreamComplianceEventType = "delete"
+	// StreamComplianceWithheld is a tweet withheld in countries
+	StreamComplianceWithheld StreamComplianceEventType = "withheld"
+	// StreamComplianceScrubGeo is a tweet that must have its geo information removed
+	StreamComplianceScrubGeo StreamComplianceEventType = "scrub_geo"
+	// StreamComplianceDrop is a tweet that must not be displayed
+	StreamComplianceDrop StreamComplianceEventType = "drop"
+	// StreamComplianceUndrop is a dropped tweet that can be displayed again
+	StreamComplianceUndrop StreamComplianceEventType = "undrop"
+	// StreamComplianceUserProtect is a user that protected their tweets
+	StreamComplianceUserProtect StreamComplianceEventType = "user_protect"
+	// StreamComplianceUserUnprotect is a user that made their tweets public
+	StreamComplianceUserUnprotect StreamComplianceEventType = "user_unprotect"
+	// StreamComplianceUserDelete is a deleted user
+	StreamComplianceUserDelete StreamComplianceEventType = "user_delete"
+	// StreamComplianceUserUndelete is a deleted user that was restored
+	StreamComplianceUserUndelete StreamComplianceEventType = "user_undelete"
+	// StreamComplianceUserSuspend is a suspended user
+	StreamComplianceUserSuspend StreamComplianceEventType = "user_suspend"
+	// StreamComplianceUserUnsuspend is a suspended user that was restored
+	StreamComplianceUserUnsuspend StreamComplianceEventType = "user_unsuspend"
+	// StreamComplianceUserWithheld is a user withheld in countries
+	StreamComplianceUserWithheld StreamComplianceEventType = "user_withheld"
+)
+
+var streamComplianceEventTypes = map[StreamComplianceEventType]bool{
+	StreamComplianceDelete:        true,
+	StreamComplianceWithheld:      true,
+	StreamComplianceScrubGeo:      true,
+	StreamComplianceDrop:          true,
+	StreamComplianceUndrop:        true,
+	StreamComplianceUserProtect:   true,
+	StreamComplianceUserUnprotect: true,
+	StreamComplianceUserDelete:    true,
+	StreamComplianceUserUndelete:  true,
+	StreamComplianceUserSuspend:   true,
+	StreamComplianceUserUnsuspend: true,
+	StreamComplianceUserWithheld:  true,
+}
+
+// StreamComplianceEvent is a compliance notice sent on the stream.  A tweet notice has the tweet and a user notice has
+// the user, the withheld notices also have the countries.
+type StreamComplianceEvent struct {
+	Type                StreamComplianceEventType `json:"type"`
+	Tweet               *StreamComplianceTweet    `json:"tweet,omitempty"`
+	User                *StreamComplianceUser     `json:"user,omitempty"`
+	WithheldInCountries []string                  `json:"withheld_in_countries,omitempty"`
+	EventAt             time.Time                 `json:"event_at"`
+}
+
+// StreamComplianceTweet is the tweet of the compliance notice
+type StreamComplianceTweet struct {
+	ID       string `json:"id"`
+	AuthorID string `json:"author_id"`
+}
+
+// StreamComplianceUser is the user of the compliance notice
+type StreamComplianceUser struct {
+	ID string `json:"id"`
+}
+
+// streamComplianceType returns the compliance type of the message's keys, the notice can be in the data object or at
+// the top of the message.  A data object with an id is a tweet, which can have its own withheld field.
+func streamComplianceType(message map[string]interface{}) (StreamComplianceEventType, bool) {
+	if data, ok := message[tweetStart].(map[string]interface{}); ok {
+		if _, has := data["id"]; has {
+			return "", false
+		}
+		message = data
+	}
+	for k := range message {
+		if eventType := StreamComplianceEventType(k); streamComplianceEventTypes[eventType] {
+			return eventType, true
+		}
+	}
+	return "", false
+}
+
+func decodeStreamCompliance(decoder *json.Decoder) (*StreamComplianceEvent, error) {
+	message := map[string]json.RawMessage{}
+	if err := decoder.Decode(&message); err != nil {
+		return nil, err
+	}
+	if data, has := message[tweetStart]; has {
+		message = map[string]json.RawMessage{}
+		if err := json.Unmarshal(data, &message); err != nil {
+			return nil, err
+		}
+	}
+	for k, notice := range message {
+		eventType := StreamComplianceEventType(k)
+		if !streamComplianceEventTypes[eventType] {
+			continue
+		}
+		event := &StreamComplianceEvent{}
+		if err := json.Unmarshal(notice, event); err != nil {
+			return nil, err
+		}
+		event.Type = eventType
+		return event, nil
+	}
+	return nil, fmt.Errorf("compliance notice not found")
+}
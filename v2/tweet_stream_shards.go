@@ -0,0 +1,423 @@
+package twitter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	streamShardBackoff          = time.Second
+	streamShardMaxBackoff       = time.Minute
+	streamShardReconnectSpacing = time.Second
+)
+
+// StreamShard is one of the connections of a sharded stream.  The filtered stream rules are kept for each app, so the
+// client of each shard should have the credentials of a different app.  MaxRules is the number of rules the shard's
+// app can have, zero is no limit.
+type StreamShard struct {
+	Name     string
+	Client   *Client
+	MaxRules int
+}
+
+// ShardedTweetMessage is a tweet stream message with the name of the shard that received it
+type ShardedTweetMessage struct {
+	*TweetMessage
+	Shard string
+}
+
+// StreamShardHealth is the health of one shard.  Connections is the number of times the shard has connected and
+// Tweets is the number of tweets it has received.  Stopped is true when the shard will not reconnect, like when its
+// credentials are not authorized.
+type StreamShardHealth struct {
+	Name          string
+	Rules         int
+	Connected     bool
+	Stopped       bool
+	Connections   int
+	Tweets        int64
+	LastTweet     time.Time
+	Disconnected  time.Time
+	LastError     error
+	LastErrorTime time.Time
+}
+
+// ShardedTweetStream will partition the filtered stream rules across the stream connections of several apps and merge
+// their tweets into one channel.
+//
+//	sharded := &twitter.ShardedTweetStream{Shards: shards, Rules: rules}
+//	for msg := range sharded.Stream(ctx) {
+//		...
+//	}
+//	if err := sharded.Err(); err != nil {
+//		...
+//	}
+//
+// The rules are spread across the shards and each shard's rules are synced before it connects, rules that are not in
+// the shard's partition are deleted once the rules that moved have been added to their new shard.  A disconnected shard
+// reconnects with a backoff that doubles from Backoff up to MaxBackoff, and ReconnectSpacing is the least time between
+// any two connects, so the shards do not reconnect at the same time.  With Backfill, a reconnect will ask for the
+// tweets missed while the shard was disconnected.
+type ShardedTweetStream struct {
+	Shards []StreamShard
+	Rules  []TweetSearchStreamRule
+	// Opts are the options of each stream connection, the backfill minutes are set by the stream
+	Opts TweetSearchStreamOpts
+	// Backfill will recover the tweets missed while a shard was disconnected, it requires the access level that allows it
+	Backfill bool
+	// Backoff is the first wait before a failed connect is tried again, defaults to one second
+	Backoff time.Duration
+	// MaxBackoff is the longest wait before a connect, defaults to one minute
+	MaxBackoff time.Duration
+	// ReconnectSpacing is the least time between the connects of the shards, defaults to one second
+	ReconnectSpacing time.Duration
+	// Buffer is the size of the merged channel
+	Buffer int
+
+	mutex       sync.Mutex
+	health      map[string]*StreamShardHealth
+	lastConnect time.Time
+	err         error
+}
+
+// Partition will spread the rules across the shards.  Each rule is given to the shard that ranks highest for the
+// rule's value, and has room for it, so a rule stays on its shard when the other rules or the shards change.  An error
+// is returned if the shards do not have room for all of the rules.
+func (s *ShardedTweetStream) Partition() (map[string][]TweetSearchStreamRule, error) {
+	if len(s.Shards) == 0 {
+		return nil, fmt.Errorf("sharded tweet stream: a shard is required: %w", ErrParameter)
+	}
+	partition := map[string][]TweetSearchStreamRule{}
+	for _, shard := range s.Shards {
+		if len(shard.Name) == 0 || shard.Client == nil {
+			return nil, fmt.Errorf("sharded tweet stream: each shard requires a name and a client: %w", ErrParameter)
+		}
+		if _, has := partition[shard.Name]; has {
+			return nil, fmt.Errorf("sharded tweet stream: shard [%s] is not unique: %w", shard.Name, ErrParameter)
+		}
+		partition[shard.Name] = []TweetSearchStreamRule{}
+	}
+
+	rules := append([]TweetSearchStreamRule{}, s.Rules...)
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Value == rules[j].Value {
+			return rules[i].Tag < rules[j].Tag
+		}
+		return rules[i].Value < rules[j].Value
+	})
+	for _, rule := range rules {
+		name := ""
+		var rank uint64
+		for _, shard := range s.Shards {
+			if shard.MaxRules > 0 && len(partition[shard.Name]) >= shard.MaxRules {
+				continue
+			}
+			if shardRank := streamShardRank(shard.Name, rule.Value); len(name) == 0 || shardRank > rank {
+				name = shard.Name
+				rank = shardRank
+			}
+		}
+		if len(name) == 0 {
+			return nil, fmt.Errorf("sharded tweet stream: the shards do not have room for %d rules: %w", len(rules), ErrParameter)
+		}
+		partition[name] = append(partition[name], rule)
+	}
+	return partition, nil
+}
+
+// streamShardRank is the rendezvous hash of the shard and the rule value
+func streamShardRank(shard, value string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(shard))
+	h.Write([]byte{0})
+	h.Write([]byte(value))
+	return h.Sum64()
+}
+
+// SyncRules will partition the rules and update the rules of each shard's app, adding the missing rules and deleting
+// the rules that are not in the shard's partition.  The rules are added to all of the shards before any are deleted,
+// so a rule that moved to another shard is matched throughout the sync.
+func (s *ShardedTweetStream) SyncRules(ctx context.Context) error {
+	partition, err := s.Partition()
+	if err != nil {
+		return err
+	}
+	syncs := make([]*streamShardSync, len(s.Shards))
+	for i, shard := range s.Shards {
+		if syncs[i], err = planStreamShardRules(ctx, shard, partition[shard.Name]); err != nil {
+			return err
+		}
+	}
+	for _, sync := range syncs {
+		if err := sync.addRules(ctx); err != nil {
+			return err
+		}
+	}
+	for _, sync := range syncs {
+		if err := sync.deleteRules(ctx); err != nil {
+			return err
+		}
+		s.update(sync.shard.Name, func(h *StreamShardHealth) {
+			h.Rules = len(partition[sync.shard.Name])
+		})
+	}
+	return nil
+}
+
+// streamShardSync has the rules to add to and delete from a shard's app
+type streamShardSync struct {
+	shard  StreamShard
+	add    []TweetSearchStreamRule
+	remove []TweetSearchStreamRuleID
+}
+
+func planStreamShardRules(ctx context.Context, shard StreamShard, rules []TweetSearchStreamRule) (*streamShardSync, error) {
+	current, err := shard.Client.TweetSearchStreamRules(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("sharded tweet stream %s rules: %w", shard.Name, err)
+	}
+	sync := &streamShardSync{
+		shard: shard,
+	}
+	want := map[TweetSearchStreamRule]bool{}
+	for _, rule := range rules {
+		want[rule] = true
+	}
+	for _, rule := range current.Rules {
+		if rule == nil {
+			continue
+		}
+		if want[rule.TweetSearchStreamRule] {
+			delete(want, rule.TweetSearchStreamRule)
+			continue
+		}
+		sync.remove = append(sync.remove, rule.ID)
+	}
+	for _, rule := range rules {
+		if want[rule] {
+			sync.add = append(sync.add, rule)
+			delete(want, rule)
+		}
+	}
+	return sync, nil
+}
+
+func (s *streamShardSync) addRules(ctx context.Context) error {
+	if len(s.add) == 0 {
+		return nil
+	}
+	if _, err := s.shard.Client.TweetSearchStreamAddRule(ctx, s.add, false); err != nil {
+		return fmt.Errorf("sharded tweet stream %s add rules: %w", s.shard.Name, err)
+	}
+	return nil
+}
+
+func (s *streamShardSync) deleteRules(ctx context.Context) error {
+	if len(s.remove) == 0 {
+		return nil
+	}
+	if _, err := s.shard.Client.TweetSearchStreamDeleteRuleByID(ctx, s.remove, false); err != nil {
+		return fmt.Errorf("sharded tweet stream %s delete rules: %w", s.shard.Name, err)
+	}
+	return nil
+}
+
+// Stream will sync the rules and connect the shards, sending their tweets until the context is done or all of the
+// shards have stopped.  The channel is closed when the stream stops, and then Err has the reason.
+func (s *ShardedTweetStream) Stream(ctx context.Context) <-chan *ShardedTweetMessage {
+	messages := make(chan *ShardedTweetMessage, s.Buffer)
+	s.mutex.Lock()
+	s.err = nil
+	s.health = map[string]*StreamShardHealth{}
+	s.lastConnect = time.Time{}
+	s.mutex.Unlock()
+	go func() {
+		defer close(messages)
+		err := s.run(ctx, messages)
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.err = err
+	}()
+	return messages
+}
+
+// Err is the reason the stream stopped, it is valid after the channel is closed
+func (s *ShardedTweetStream) Err() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.err
+}
+
+// Health returns the health of each shard
+func (s *ShardedTweetStream) Health() []StreamShardHealth {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	health := make([]StreamShardHealth, 0, len(s.Shards))
+	for _, shard := range s.Shards {
+		h := StreamShardHealth{
+			Name: shard.Name,
+		}
+		if current, has := s.health[shard.Name]; has {
+			h = *current
+		}
+		health = append(health, h)
+	}
+	return health
+}
+
+func (s *ShardedTweetStream) update(name string, update func(h *StreamShardHealth)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.health == nil {
+		s.health = map[string]*StreamShardHealth{}
+	}
+	h, has := s.health[name]
+	if !has {
+		h = &StreamShardHealth{
+			Name: name,
+		}
+		s.health[name] = h
+	}
+	update(h)
+}
+
+func (s *ShardedTweetStream) run(ctx context.Context, messages chan<- *ShardedTweetMessage) error {
+	if err := s.SyncRules(ctx); err != nil {
+		return err
+	}
+	wg := sync.WaitGroup{}
+	for _, shard := range s.Shards {
+		wg.Add(1)
+		go func(shard StreamShard) {
+			defer wg.Done()
+			s.shard(ctx, shard, messages)
+		}(shard)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return errors.New("sharded tweet stream: all of the shards have stopped")
+}
+
+// shard will connect the shard and send its tweets, reconnecting until the context is done or the shard can not be
+// authorized
+func (s *ShardedTweetStream) shard(ctx context.Context, shard StreamShard, messages chan<- *ShardedTweetMessage) {
+	backoff := s.backoff()
+	var disconnected time.Time
+	for {
+		if err := s.space(ctx); err != nil {
+			return
+		}
+		opts := s.Opts
+		if s.Backfill {
+			opts.BackfillMinutes = StreamBackfillMinutes(disconnected)
+		}
+		stream, err := shard.Client.TweetSearchStream(ctx, opts)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			stop := errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrForbidden)
+			s.update(shard.Name, func(h *StreamShardHealth) {
+				h.LastError = err
+				h.LastErrorTime = time.Now()
+				h.Stopped = stop
+			})
+			if stop || sleep(ctx, backoff) != nil {
+				return
+			}
+			if backoff *= 2; backoff > s.maxBackoff() {
+				backoff = s.maxBackoff()
+			}
+			continue
+		}
+
+		backoff = s.backoff()
+		s.update(shard.Name, func(h *StreamShardHealth) {
+			h.Connected = true
+			h.Connections++
+		})
+		s.receive(ctx, shard, stream, messages)
+		disconnected = time.Now()
+		s.update(shard.Name, func(h *StreamShardHealth) {
+			h.Connected = false
+			h.Disconnected = disconnected
+		})
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// receive will send the stream's tweets until the stream is done
+func (s *ShardedTweetStream) receive(ctx context.Context, shard StreamShard, stream *TweetStream, messages chan<- *ShardedTweetMessage) {
+	defer stream.Close()
+	tweets := stream.Tweets()
+	errs := stream.Err()
+	for tweets != nil || errs != nil {
+		select {
+		case <-ctx.Done():
+			return
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			s.update(shard.Name, func(h *StreamShardHealth) {
+				h.LastError = err
+				h.LastErrorTime = time.Now()
+			})
+		case msg, ok := <-tweets:
+			if !ok {
+				tweets = nil
+				continue
+			}
+			s.update(shard.Name, func(h *StreamShardHealth) {
+				h.Tweets++
+				h.LastTweet = time.Now()
+			})
+			select {
+			case messages <- &ShardedTweetMessage{TweetMessage: msg, Shard: shard.Name}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// space will wait until the reconnect spacing has passed since the last connect of any shard
+func (s *ShardedTweetStream) space(ctx context.Context) error {
+	spacing := s.ReconnectSpacing
+	if spacing <= 0 {
+		spacing = streamShardReconnectSpacing
+	}
+	s.mutex.Lock()
+	next := s.lastConnect.Add(spacing)
+	now := time.Now()
+	if next.Before(now) {
+		next = now
+	}
+	s.lastConnect = next
+	s.mutex.Unlock()
+	return sleep(ctx, time.Until(next))
+}
+
+func (s *ShardedTweetStream) backoff() time.Duration {
+	if s.Backoff > 0 {
+		return s.Backoff
+	}
+	return streamShardBackoff
+}
+
+func (s *ShardedTweetStream) maxBackoff() time.Duration {
+	if s.MaxBackoff > 0 {
+		return s.MaxBackoff
+	}
+	return streamShardMaxBackoff
+}
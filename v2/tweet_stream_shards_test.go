@@ -0,0 +1,239 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type streamShardApp struct {
+	mutex    sync.Mutex
+	name     string
+	rules    map[string]TweetSearchStreamRule
+	connects int
+	// record is called with each rule added and deleted
+	record func(op string)
+}
+
+func (a *streamShardApp) client() *Client {
+	return &Client{
+		Authorizer: &mockAuth{},
+		Host:       "https://www.test.com",
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			a.mutex.Lock()
+			defer a.mutex.Unlock()
+			body := ""
+			status := http.StatusOK
+			switch {
+			case req.URL.Path == tweetSearchStreamRulesEndpoint.url("") && req.Method == http.MethodGet:
+				data := []string{}
+				for id, rule := range a.rules {
+					data = append(data, fmt.Sprintf(`{"id":"%s","value":"%s","tag":"%s"}`, id, rule.Value, rule.Tag))
+				}
+				body = fmt.Sprintf(`{"data":[%s],"meta":{"sent":"2022-06-01T10:00:00.000Z"}}`, strings.Join(data, ","))
+			case req.URL.Path == tweetSearchStreamRulesEndpoint.url("") && req.Method == http.MethodPost:
+				request := struct {
+					Add    []TweetSearchStreamRule `json:"add"`
+					Delete struct {
+						IDs []string `json:"ids"`
+					} `json:"delete"`
+				}{}
+				if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+					log.Panicf("the rules request is not correct %v", err)
+				}
+				for _, rule := range request.Add {
+					a.rules[fmt.Sprintf("%s-%d", a.name, len(a.rules)+10)] = rule
+					if a.record != nil {
+						a.record(fmt.Sprintf("%s add %s", a.name, rule.Value))
+					}
+				}
+				if len(request.Add) > 0 {
+					status = http.StatusCreated
+				}
+				for _, id := range request.Delete.IDs {
+					if a.record != nil {
+						a.record(fmt.Sprintf("%s delete %s", a.name, a.rules[id].Value))
+					}
+					delete(a.rules, id)
+				}
+				body = `{"meta":{"sent":"2022-06-01T10:00:00.000Z","summary":{}}}`
+			case req.URL.Path == tweetSearchStreamEndpoint.url(""):
+				a.connects++
+				if a.connects == 1 {
+					body = fmt.Sprintf("{\"data\":{\"id\":\"%s-1\",\"text\":\"hello\"}}\r\n{\"data\":{\"id\":\"%s-2\",\"text\":\"world\"}}\r\n", a.name, a.name)
+				}
+			default:
+				log.Panicf("the request is not correct %s %s", req.Method, req.URL.Path)
+			}
+			return &http.Response{
+				StatusCode: status,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+}
+
+func (a *streamShardApp) ruleValues() []string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	values := []string{}
+	for _, rule := range a.rules {
+		values = append(values, rule.Value)
+	}
+	return values
+}
+
+func TestShardedTweetStream_Partition(t *testing.T) {
+	sharded := &ShardedTweetStream{
+		Shards: []StreamShard{
+			{Name: "a", Client: &Client{}, MaxRules: 1},
+			{Name: "b", Client: &Client{}},
+		},
+		Rules: []TweetSearchStreamRule{{Value: "rust"}, {Value: "golang"}, {Value: "python"}},
+	}
+	got, err := sharded.Partition()
+	if err != nil {
+		t.Fatalf("ShardedTweetStream.Partition() error = %v", err)
+	}
+	want := map[string][]TweetSearchStreamRule{
+		"a": {{Value: "golang"}},
+		"b": {{Value: "python"}, {Value: "rust"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ShardedTweetStream.Partition() = %v, want %v", got, want)
+	}
+
+	sharded.Shards[1].MaxRules = 1
+	if _, err := sharded.Partition(); !errors.Is(err, ErrParameter) {
+		t.Errorf("ShardedTweetStream.Partition() error = %v, want parameter error", err)
+	}
+}
+
+func TestShardedTweetStream_PartitionStable(t *testing.T) {
+	sharded := &ShardedTweetStream{
+		Shards: []StreamShard{
+			{Name: "a", Client: &Client{}},
+			{Name: "b", Client: &Client{}},
+			{Name: "c", Client: &Client{}},
+		},
+	}
+	values := []string{"golang", "gopher", "python", "rust", "java", "kotlin", "swift", "ruby", "haskell", "scala"}
+	for _, value := range values {
+		sharded.Rules = append(sharded.Rules, TweetSearchStreamRule{Value: value})
+	}
+	shards := func() map[string]string {
+		partition, err := sharded.Partition()
+		if err != nil {
+			t.Fatalf("ShardedTweetStream.Partition() error = %v", err)
+		}
+		shards := map[string]string{}
+		for name, rules := range partition {
+			for _, rule := range rules {
+				shards[rule.Value] = name
+			}
+		}
+		return shards
+	}
+	before := shards()
+
+	// the rules that are left, and the rules that are added, do not move the other rules
+	sharded.Rules = append(sharded.Rules[2:], TweetSearchStreamRule{Value: "elixir"}, TweetSearchStreamRule{Value: "zig"})
+	after := shards()
+	for _, value := range values[2:] {
+		if before[value] != after[value] {
+			t.Errorf("ShardedTweetStream.Partition() moved %s from %s to %s", value, before[value], after[value])
+		}
+	}
+}
+
+func TestShardedTweetStream_SyncRules(t *testing.T) {
+	mutex := sync.Mutex{}
+	ops := []string{}
+	record := func(op string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		ops = append(ops, op)
+	}
+	// rust belongs on shard b, it moves from a
+	a := &streamShardApp{name: "a", rules: map[string]TweetSearchStreamRule{"a-1": {Value: "golang"}, "a-2": {Value: "rust"}}, record: record}
+	b := &streamShardApp{name: "b", rules: map[string]TweetSearchStreamRule{}, record: record}
+	sharded := &ShardedTweetStream{
+		Shards: []StreamShard{
+			{Name: "a", Client: a.client()},
+			{Name: "b", Client: b.client()},
+		},
+		Rules: []TweetSearchStreamRule{{Value: "golang"}, {Value: "rust"}},
+	}
+	if err := sharded.SyncRules(context.Background()); err != nil {
+		t.Fatalf("ShardedTweetStream.SyncRules() error = %v", err)
+	}
+	if want := []string{"b add rust", "a delete rust"}; !reflect.DeepEqual(ops, want) {
+		t.Errorf("ShardedTweetStream.SyncRules() = %v, want the moved rule added before it is deleted %v", ops, want)
+	}
+}
+
+func TestShardedTweetStream_Stream(t *testing.T) {
+	a := &streamShardApp{name: "a", rules: map[string]TweetSearchStreamRule{"a-1": {Value: "golang"}, "a-2": {Value: "stale"}}}
+	b := &streamShardApp{name: "b", rules: map[string]TweetSearchStreamRule{}}
+	sharded := &ShardedTweetStream{
+		Shards: []StreamShard{
+			{Name: "a", Client: a.client()},
+			{Name: "b", Client: b.client()},
+		},
+		Rules:            []TweetSearchStreamRule{{Value: "golang"}, {Value: "rust"}},
+		Opts:             TweetSearchStreamOpts{StallTimeout: 20 * time.Millisecond},
+		Backoff:          time.Millisecond,
+		ReconnectSpacing: 5 * time.Millisecond,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	messages := sharded.Stream(ctx)
+	got := map[string]string{}
+	for len(got) < 4 {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				t.Fatalf("ShardedTweetStream.Stream() error = %v", sharded.Err())
+			}
+			got[msg.Raw.Tweets[0].ID] = msg.Shard
+		case <-time.After(time.Second):
+			t.Fatalf("ShardedTweetStream.Stream() only received %v", got)
+		}
+	}
+	want := map[string]string{"a-1": "a", "a-2": "a", "b-1": "b", "b-2": "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ShardedTweetStream.Stream() = %v, want %v", got, want)
+	}
+	if values := a.ruleValues(); !reflect.DeepEqual(values, []string{"golang"}) {
+		t.Errorf("ShardedTweetStream.Stream() shard a rules = %v", values)
+	}
+	if values := b.ruleValues(); !reflect.DeepEqual(values, []string{"rust"}) {
+		t.Errorf("ShardedTweetStream.Stream() shard b rules = %v", values)
+	}
+
+	// the streams end after the tweets, so the shards reconnect
+	time.Sleep(100 * time.Millisecond)
+	for _, health := range sharded.Health() {
+		if health.Rules != 1 || health.Tweets != 2 || health.Connections < 2 || !errors.Is(health.LastError, io.EOF) {
+			t.Errorf("ShardedTweetStream.Health() = %+v", health)
+		}
+	}
+
+	cancel()
+	for range messages {
+	}
+	if err := sharded.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("ShardedTweetStream.Err() = %v, want canceled", err)
+	}
+}
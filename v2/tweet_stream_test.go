@@ -1,15 +1,34 @@
 package twitter
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"reflect"
 	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 )
 
+// waitStreamEnd will wait for the stream to end, the messages are left in its closed channels.  The end of the stream
+// is the only error expected.
+func waitStreamEnd(t *testing.T, stream *TweetStream) {
+	select {
+	case <-stream.Done():
+	case <-time.After(5 * time.Second):
+		stream.Close()
+		<-stream.Done()
+		t.Errorf("TweetStream should end with the stream")
+	}
+	for err := range stream.Err() {
+		if !errors.Is(err, io.EOF) {
+			t.Errorf("TweetStream error %v", err)
+		}
+	}
+}
+
 func Test_StartTweetStreamMessage(t *testing.T) {
 	type args struct {
 		stream io.ReadCloser
@@ -70,22 +89,10 @@ func Test_StartTweetStreamMessage(t *testing.T) {
 			stream := StartTweetStream(tt.args.stream)
 
 			got := []*TweetMessage{}
-			timer := time.NewTimer(time.Second * 5)
-
-			func() {
-				defer stream.Close()
-				for {
-					select {
-					case msg := <-stream.Tweets():
-						got = append(got, msg)
-					case <-timer.C:
-						return
-					case err := <-stream.Err():
-						t.Errorf("StartTweetStreamMessage error %v", err)
-						return
-					}
-				}
-			}()
+			waitStreamEnd(t, stream)
+			for msg := range stream.Tweets() {
+				got = append(got, msg)
+			}
 
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("StartTweetStreamMessage = %v, want %v", got, tt.want)
@@ -151,22 +158,10 @@ func Test_StartTweetStreamSystem(t *testing.T) {
 			stream := StartTweetStream(tt.args.stream)
 
 			got := []map[SystemMessageType]SystemMessage{}
-			timer := time.NewTimer(time.Second * 5)
-
-			func() {
-				defer stream.Close()
-				for {
-					select {
-					case msg := <-stream.SystemMessages():
-						got = append(got, msg)
-					case <-timer.C:
-						return
-					case err := <-stream.Err():
-						t.Errorf("StartTweetStreamMessage error %v", err)
-						return
-					}
-				}
-			}()
+			waitStreamEnd(t, stream)
+			for msg := range stream.SystemMessages() {
+				got = append(got, msg)
+			}
 
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("StartTweetStreamMessage = %v, want %v", got, tt.want)
@@ -225,22 +220,10 @@ func Test_StartTweetStreamDisconnect(t *testing.T) {
 			stream := StartTweetStream(tt.args.stream)
 
 			got := []*DisconnectionError{}
-			timer := time.NewTimer(time.Second * 5)
-
-			func() {
-				defer stream.Close()
-				for {
-					select {
-					case msg := <-stream.DisconnectionError():
-						got = append(got, msg)
-					case <-timer.C:
-						return
-					case err := <-stream.Err():
-						t.Errorf("Test_StartTweetStreamDisconnect error %v", err)
-						return
-					}
-				}
-			}()
+			waitStreamEnd(t, stream)
+			for msg := range stream.DisconnectionErrors() {
+				got = append(got, msg)
+			}
 
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("Test_StartTweetStreamDisconnect = %v, want %v", got, tt.want)
@@ -368,26 +351,16 @@ func Test_StartTweetStream(t *testing.T) {
 			gotTweet := []*TweetMessage{}
 			gotDisconnect := []*DisconnectionError{}
 
-			timer := time.NewTimer(time.Second * 5)
-
-			func() {
-				defer stream.Close()
-				for {
-					select {
-					case sysMsg := <-stream.SystemMessages():
-						gotSystem = append(gotSystem, sysMsg)
-					case tweetMsg := <-stream.Tweets():
-						gotTweet = append(gotTweet, tweetMsg)
-					case disconnectMsg := <-stream.DisconnectionError():
-						gotDisconnect = append(gotDisconnect, disconnectMsg)
-					case <-timer.C:
-						return
-					case err := <-stream.Err():
-						t.Errorf("StartTweetStreamMessage error %v", err)
-						return
-					}
-				}
-			}()
+			waitStreamEnd(t, stream)
+			for sysMsg := range stream.SystemMessages() {
+				gotSystem = append(gotSystem, sysMsg)
+			}
+			for tweetMsg := range stream.Tweets() {
+				gotTweet = append(gotTweet, tweetMsg)
+			}
+			for disconnectMsg := range stream.DisconnectionErrors() {
+				gotDisconnect = append(gotDisconnect, disconnectMsg)
+			}
 
 			if !reflect.DeepEqual(gotSystem, tt.wantSystem) {
 				t.Errorf("StartTweetStreamMessage system= %v, want %v", gotSystem, tt.wantSystem)
@@ -576,3 +549,255 @@ func TestStreamError_Wrap(t *testing.T) {
 	}
 
 }
+
+func Test_StartTweetStreamEnded(t *testing.T) {
+	text := strings.Repeat("a", 100*1024)
+	stream := StartTweetStreamWithStallTimeout(io.NopCloser(strings.NewReader(`{"data":{"id":"1","text":"`+text+`"}}`+"\r\n")), time.Minute)
+	select {
+	case <-stream.Done():
+	case <-time.After(time.Second):
+		t.Fatalf("StartTweetStreamEnded the stream should end without waiting for the stall timeout")
+	}
+	if msg, ok := <-stream.Tweets(); !ok || msg.Raw.Tweets[0].Text != text {
+		t.Errorf("StartTweetStreamEnded the message over 64KB should be received")
+	}
+	if err := <-stream.Err(); !errors.Is(err, io.EOF) {
+		t.Errorf("StartTweetStreamEnded error = %v, want %v", err, io.EOF)
+	}
+
+	readErr := errors.New("connection reset")
+	stream = StartTweetStreamWithStallTimeout(io.NopCloser(io.MultiReader(strings.NewReader("\r\n"), iotest.ErrReader(readErr))), time.Minute)
+	select {
+	case <-stream.Done():
+	case <-time.After(time.Second):
+		t.Fatalf("StartTweetStreamEnded the stream should end with the read error")
+	}
+	if err := <-stream.Err(); !errors.Is(err, readErr) {
+		t.Errorf("StartTweetStreamEnded error = %v, want %v", err, readErr)
+	}
+}
+
+func Test_StartTweetStreamStalled(t *testing.T) {
+	reader, writer := io.Pipe()
+	defer writer.Close()
+	stream := StartTweetStreamWithStallTimeout(reader, 50*time.Millisecond)
+	defer stream.Close()
+
+	for i := 0; i < 3; i++ {
+		if _, err := writer.Write([]byte("\r\n")); err != nil {
+			t.Fatalf("keep alive error %v", err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if !stream.Connection() {
+		t.Errorf("StartTweetStreamStalled the keep alive should keep the connection")
+	}
+
+	select {
+	case err := <-stream.Err():
+		if !errors.Is(err, ErrStreamStalled) {
+			t.Errorf("StartTweetStreamStalled error = %v, want stalled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("StartTweetStreamStalled the stream should be stalled")
+	}
+	if stream.Connection() {
+		t.Errorf("StartTweetStreamStalled the connection should not be alive")
+	}
+	if _, ok := <-stream.Tweets(); ok {
+		t.Errorf("StartTweetStreamStalled the tweets channel should be closed")
+	}
+}
+
+func Test_StartTweetStreamMatchingRules(t *testing.T) {
+	body := `{"data":{"id":"1","text":"hello"},"matching_rules":[{"id":"10","tag":"golang"},{"id":"11","tag":"gophers"}]}` + "\r\n"
+	stream := StartTweetStream(io.NopCloser(strings.NewReader(body)))
+
+	msg := <-stream.Tweets()
+	want := []*TweetStreamMatchingRule{
+		{ID: "10", Tag: "golang"},
+		{ID: "11", Tag: "gophers"},
+	}
+	if !reflect.DeepEqual(msg.MatchingRules, want) {
+		t.Errorf("StartTweetStreamMatchingRules = %v, want %v", msg.MatchingRules, want)
+	}
+
+	stream.Close()
+	select {
+	case <-stream.Done():
+	case <-time.After(time.Second):
+		t.Fatalf("StartTweetStreamMatchingRules the stream should be done")
+	}
+	if _, ok := <-stream.DisconnectionErrors(); ok {
+		t.Errorf("StartTweetStreamMatchingRules the disconnection channel should be closed")
+	}
+	stream.Close()
+}
+
+func TestStreamBackfillMinutes(t *testing.T) {
+	tests := []struct {
+		name         string
+		disconnected time.Time
+		want         int
+	}{
+		{
+			name: "not disconnected",
+			want: 0,
+		},
+		{
+			name:         "rounded up",
+			disconnected: time.Now().Add(-90 * time.Second),
+			want:         2,
+		},
+		{
+			name:         "capped",
+			disconnected: time.Now().Add(-time.Hour),
+			want:         5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StreamBackfillMinutes(tt.disconnected); got != tt.want {
+				t.Errorf("StreamBackfillMinutes() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_StartTweetStreamOverflow(t *testing.T) {
+	body := ""
+	for _, id := range []string{"1", "2", "3"} {
+		body += fmt.Sprintf(`{"data":{"id":"%s","text":"hello"}}`, id) + "\r\n"
+	}
+	tweetIDs := func(stream *TweetStream) []string {
+		ids := []string{}
+		for msg := range stream.Tweets() {
+			ids = append(ids, msg.Raw.Tweets[0].ID)
+		}
+		return ids
+	}
+	opts := TweetStreamOpts{StallTimeout: 50 * time.Millisecond, Buffer: 1}
+
+	t.Run("drop newest", func(t *testing.T) {
+		stream := StartTweetStreamWithOpts(io.NopCloser(strings.NewReader(body)), opts)
+		<-stream.Done()
+		if got := tweetIDs(stream); !reflect.DeepEqual(got, []string{"1"}) || stream.Dropped() != 2 {
+			t.Errorf("StartTweetStreamOverflow = %v dropped %d, want [1] dropped 2", got, stream.Dropped())
+		}
+	})
+	t.Run("drop oldest", func(t *testing.T) {
+		opts := opts
+		opts.Overflow = StreamOverflowDropOldest
+		stream := StartTweetStreamWithOpts(io.NopCloser(strings.NewReader(body)), opts)
+		<-stream.Done()
+		if got := tweetIDs(stream); !reflect.DeepEqual(got, []string{"3"}) || stream.Dropped() != 2 {
+			t.Errorf("StartTweetStreamOverflow = %v dropped %d, want [3] dropped 2", got, stream.Dropped())
+		}
+	})
+	t.Run("block", func(t *testing.T) {
+		opts := opts
+		opts.Overflow = StreamOverflowBlock
+		stream := StartTweetStreamWithOpts(io.NopCloser(strings.NewReader(body)), opts)
+		if got := tweetIDs(stream); !reflect.DeepEqual(got, []string{"1", "2", "3"}) || stream.Dropped() != 0 {
+			t.Errorf("StartTweetStreamOverflow = %v dropped %d, want all", got, stream.Dropped())
+		}
+	})
+	t.Run("error", func(t *testing.T) {
+		opts := opts
+		opts.Overflow = StreamOverflowError
+		stream := StartTweetStreamWithOpts(io.NopCloser(strings.NewReader(body)), opts)
+		if err := <-stream.Err(); !errors.Is(err, ErrStreamOverflow) {
+			t.Errorf("StartTweetStreamOverflow error = %v, want overflow", err)
+		}
+		<-stream.Done()
+	})
+}
+
+func Test_StartTweetStreamCompliance(t *testing.T) {
+	body := `{"data":{"delete":{"tweet":{"id":"1","author_id":"10"},"event_at":"2021-07-06T18:40:40.000Z"}}}` + "\r\n"
+	body += `{"data":{"id":"2","text":"hello","withheld":{"copyright":true,"country_codes":["DE"]}}}` + "\r\n"
+	body += `{"data":{"withheld":{"tweet":{"id":"3","author_id":"10"},"withheld_in_countries":["DE","FR"],"event_at":"2021-07-06T18:40:41.000Z"}}}` + "\r\n"
+	body += `{"user_protect":{"user":{"id":"10"},"event_at":"2021-07-06T18:40:42.000Z"}}` + "\r\n"
+	stream := StartTweetStreamWithOpts(io.NopCloser(strings.NewReader(body)), TweetStreamOpts{StallTimeout: 50 * time.Millisecond})
+	<-stream.Done()
+
+	events := []*StreamComplianceEvent{}
+	for event := range stream.Compliance() {
+		events = append(events, event)
+	}
+	want := []*StreamComplianceEvent{
+		{
+			Type:    StreamComplianceDelete,
+			Tweet:   &StreamComplianceTweet{ID: "1", AuthorID: "10"},
+			EventAt: time.Date(2021, time.July, 6, 18, 40, 40, 0, time.UTC),
+		},
+		{
+			Type:                StreamComplianceWithheld,
+			Tweet:               &StreamComplianceTweet{ID: "3", AuthorID: "10"},
+			WithheldInCountries: []string{"DE", "FR"},
+			EventAt:             time.Date(2021, time.July, 6, 18, 40, 41, 0, time.UTC),
+		},
+		{
+			Type:    StreamComplianceUserProtect,
+			User:    &StreamComplianceUser{ID: "10"},
+			EventAt: time.Date(2021, time.July, 6, 18, 40, 42, 0, time.UTC),
+		},
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("StartTweetStreamCompliance = %v, want %v", events, want)
+	}
+
+	tweets := []string{}
+	for msg := range stream.Tweets() {
+		tweets = append(tweets, msg.Raw.Tweets[0].ID)
+	}
+	if !reflect.DeepEqual(tweets, []string{"2"}) {
+		t.Errorf("StartTweetStreamCompliance tweets = %v, want [2]", tweets)
+	}
+}
+
+func Test_TweetStreamShutdown(t *testing.T) {
+	start := func(t *testing.T) (*TweetStream, *io.PipeWriter) {
+		reader, writer := io.Pipe()
+		stream := StartTweetStream(reader)
+		for _, id := range []string{"1", "2", "3"} {
+			if _, err := fmt.Fprintf(writer, `{"data":{"id":"%s","text":"hello"}}`+"\r\n", id); err != nil {
+				t.Fatalf("TweetStreamShutdown write error %v", err)
+			}
+		}
+		for stream.buffered() < 3 {
+			time.Sleep(time.Millisecond)
+		}
+		return stream, writer
+	}
+
+	t.Run("drained", func(t *testing.T) {
+		stream, writer := start(t)
+		defer writer.Close()
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			for range stream.Tweets() {
+			}
+		}()
+		dropped, err := stream.Shutdown(context.Background())
+		if err != nil || dropped != 0 {
+			t.Errorf("TweetStream.Shutdown() = %d, %v, want 0, nil", dropped, err)
+		}
+		if stream.Connection() {
+			t.Errorf("TweetStream.Shutdown() the connection should not be alive")
+		}
+	})
+	t.Run("deadline", func(t *testing.T) {
+		stream, writer := start(t)
+		defer writer.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		dropped, err := stream.Shutdown(ctx)
+		if !errors.Is(err, context.DeadlineExceeded) || dropped != 3 {
+			t.Errorf("TweetStream.Shutdown() = %d, %v, want 3, deadline", dropped, err)
+		}
+		if _, ok := <-stream.Tweets(); ok {
+			t.Errorf("TweetStream.Shutdown() the tweets channel should be closed and empty")
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package twitter
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	tweetUsageMinDays = 1
+	tweetUsageMaxDays = 90
+)
+
+// UsageField are the usage field options
+type UsageField string
+
+const (
+	// UsageFieldCapResetDay is the day of the month the project's cap resets
+	UsageFieldCapResetDay UsageField = "cap_reset_day"
+	// UsageFieldDailyClientAppUsage is the daily usage of each app
+	UsageFieldDailyClientAppUsage UsageField = "daily_client_app_usage"
+	// UsageFieldDailyProjectUsage is the daily usage of the project
+	UsageFieldDailyProjectUsage UsageField = "daily_project_usage"
+	// UsageFieldProjectCap is the project's monthly cap
+	UsageFieldProjectCap UsageField = "project_cap"
+	// UsageFieldProjectID is the project id
+	UsageFieldProjectID UsageField = "project_id"
+	// UsageFieldProjectUsage is the project's usage in the current cap period
+	UsageFieldProjectUsage UsageField = "project_usage"
+)
+
+func usageFieldStringArray(arr []UsageField) []string {
+	strs := make([]string, len(arr))
+	for i, field := range arr {
+		strs[i] = string(field)
+	}
+	return strs
+}
+
+// TweetUsageOpts are the post usage options.  Days is the number of days of daily usage, between 1 and 90.
+type TweetUsageOpts struct {
+	Days        int
+	UsageFields []UsageField
+}
+
+func (t TweetUsageOpts) addQuery(req *http.Request) {
+	q := req.URL.Query()
+	if t.Days > 0 {
+		q.Add("days", strconv.Itoa(t.Days))
+	}
+	if len(t.UsageFields) > 0 {
+		q.Add("usage.fields", strings.Join(usageFieldStringArray(t.UsageFields), ","))
+	}
+	if len(q) > 0 {
+		req.URL.RawQuery = q.Encode()
+	}
+}
+
+// DailyUsageObj is the number of posts consumed on a day
+type DailyUsageObj struct {
+	Date  string      `json:"date"`
+	Usage json.Number `json:"usage"`
+}
+
+// DailyProjectUsageObj is the daily usage of a project
+type DailyProjectUsageObj struct {
+	ProjectID string           `json:"project_id"`
+	Usage     []*DailyUsageObj `json:"usage"`
+}
+
+// DailyClientAppUsageObj is the daily usage of an app
+type DailyClientAppUsageObj struct {
+	ClientAppID      string           `json:"client_app_id"`
+	Usage            []*DailyUsageObj `json:"usage"`
+	UsageResultCount int              `json:"usage_result_count"`
+}
+
+// TweetUsageObj is the post consumption of the project.  The counts are numbers sent as strings.
+type TweetUsageObj struct {
+	CapResetDay         int                       `json:"cap_reset_day"`
+	ProjectID           string                    `json:"project_id"`
+	ProjectCap          json.Number               `json:"project_cap"`
+	ProjectUsage        json.Number               `json:"project_usage"`
+	DailyProjectUsage   *DailyProjectUsageObj     `json:"daily_project_usage,omitempty"`
+	DailyClientAppUsage []*DailyClientAppUsageObj `json:"daily_client_app_usage,omitempty"`
+}
+
+// TweetUsageResponse is the response from the post usage
+type TweetUsageResponse = Response[*TweetUsageObj, NoMeta]
@@ -0,0 +1,1321 @@
+package twittertest
+
+import (
+	"context"
+	"net/http"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+// Client has the methods of the twitter client, so code can depend on it and be tested with the fake
+type Client interface {
+	AccountActivitySubscribed(ctx context.Context, env string) (*twitter.AccountActivitySubscribedResponse, error)
+	AccountActivitySubscriptions(ctx context.Context, env string) (*twitter.AccountActivitySubscriptionsResponse, error)
+	AccountActivityWebhooks(ctx context.Context, env string) (*twitter.AccountActivityWebhooksResponse, error)
+	AddAccountActivitySubscription(ctx context.Context, env string) (*twitter.AccountActivityResponse, error)
+	AddListMember(ctx context.Context, listID string, userID string) (*twitter.ListAddMemberResponse, error)
+	AddTweetBookmark(ctx context.Context, userID string, tweetID string) (*twitter.AddTweetBookmarkResponse, error)
+	AuthUserLookup(ctx context.Context, opts twitter.UserLookupOpts) (*twitter.UserLookupResponse, error)
+	CommunityLookup(ctx context.Context, id string, opts twitter.CommunityLookupOpts) (*twitter.CommunityLookupResponse, error)
+	CommunitySearch(ctx context.Context, query string, opts twitter.CommunitySearchOpts) (*twitter.CommunitySearchResponse, error)
+	ComplianceBatchJob(ctx context.Context, id string) (*twitter.ComplianceBatchJobResponse, error)
+	ComplianceBatchJobLookup(ctx context.Context, jobType twitter.ComplianceBatchJobType, opts twitter.ComplianceBatchJobLookupOpts) (*twitter.ComplianceBatchJobLookupResponse, error)
+	CreateAccountActivityWebhook(ctx context.Context, env string, webhookURL string) (*twitter.AccountActivityWebhookResponse, error)
+	CreateComplianceBatchJob(ctx context.Context, jobType twitter.ComplianceBatchJobType, opts twitter.CreateComplianceBatchJobOpts) (*twitter.CreateComplianceBatchJobResponse, error)
+	CreateDMConversation(ctx context.Context, conversation twitter.CreateDMConversationRequest) (*twitter.CreateDMEventResponse, error)
+	CreateList(ctx context.Context, list twitter.ListMetaData) (*twitter.ListCreateResponse, error)
+	CreateNote(ctx context.Context, note twitter.CreateNoteRequest) (*twitter.CreateNoteResponse, error)
+	CreateTweet(ctx context.Context, tweet twitter.CreateTweetRequest) (*twitter.CreateTweetResponse, error)
+	CreateTweetAsync(ctx context.Context, tweet twitter.CreateTweetRequest) (*twitter.CreateTweetAsyncResponse, error)
+	DMConversationEventsLookup(ctx context.Context, conversationID string, opts twitter.DMEventsLookupOpts) (*twitter.DMEventsLookupResponse, error)
+	DMEventsLookup(ctx context.Context, opts twitter.DMEventsLookupOpts) (*twitter.DMEventsLookupResponse, error)
+	DMParticipantEventsLookup(ctx context.Context, participantID string, opts twitter.DMEventsLookupOpts) (*twitter.DMEventsLookupResponse, error)
+	DeleteAccountActivitySubscription(ctx context.Context, env string, userID string) (*twitter.AccountActivityResponse, error)
+	DeleteAccountActivityWebhook(ctx context.Context, env string, webhookID string) (*twitter.AccountActivityResponse, error)
+	DeleteList(ctx context.Context, listID string) (*twitter.ListDeleteResponse, error)
+	DeleteTweet(ctx context.Context, id string) (*twitter.DeleteTweetResponse, error)
+	DeleteUserBlocks(ctx context.Context, userID string, targetUserID string) (*twitter.UserDeleteBlocksResponse, error)
+	DeleteUserFollows(ctx context.Context, userID string, targetUserID string) (*twitter.UserDeleteFollowsResponse, error)
+	DeleteUserLikes(ctx context.Context, userID string, tweetID string) (*twitter.DeleteUserLikesResponse, error)
+	DeleteUserMutes(ctx context.Context, userID string, targetUserID string) (*twitter.UserDeleteMutesResponse, error)
+	DeleteUserRetweet(ctx context.Context, userID string, tweetID string) (*twitter.DeleteUserRetweetResponse, error)
+	Doctor(ctx context.Context) (*twitter.DoctorReport, error)
+	EvaluateNote(ctx context.Context, evaluate twitter.EvaluateNoteRequest) (*twitter.EvaluateNoteResponse, error)
+	GeoPlaceLookup(ctx context.Context, placeID string) (*twitter.GeoPlaceResponse, error)
+	GeoSearch(ctx context.Context, opts twitter.GeoSearchOpts) (*twitter.GeoSearchResponse, error)
+	ListLookup(ctx context.Context, listID string, opts twitter.ListLookupOpts) (*twitter.ListLookupResponse, error)
+	ListTweetLookup(ctx context.Context, listID string, opts twitter.ListTweetLookupOpts) (*twitter.ListTweetLookupResponse, error)
+	ListUserFollowers(ctx context.Context, listID string, opts twitter.ListUserFollowersOpts) (*twitter.ListUserFollowersResponse, error)
+	ListUserMembers(ctx context.Context, listID string, opts twitter.ListUserMembersOpts) (*twitter.ListUserMembersResponse, error)
+	NotesEligiblePosts(ctx context.Context, opts twitter.NotesEligiblePostsOpts) (*twitter.NotesEligiblePostsResponse, error)
+	NotesWritten(ctx context.Context, opts twitter.NotesWrittenOpts) (*twitter.NotesWrittenResponse, error)
+	ParseCreateTweetAsyncResponse(resp *http.Response) (*twitter.CreateTweetResponse, error)
+	ParseTweetLookupAsyncResponse(ids []string, resp *http.Response) (*twitter.TweetLookupResponse, error)
+	ParseTweetRecentSearchAsyncResponse(resp *http.Response) (*twitter.TweetRecentSearchResponse, error)
+	ParseUserLikesAsyncResponse(resp *http.Response) (*twitter.UserLikesResponse, error)
+	ParseUserNameLookupAsyncResponse(usernames []string, resp *http.Response) (*twitter.UserLookupResponse, error)
+	ParseUserTweetTimelineAsyncResponse(resp *http.Response) (*twitter.UserTweetTimelineResponse, error)
+	PersonalizedTrends(ctx context.Context, opts twitter.PersonalizedTrendsOpts) (*twitter.PersonalizedTrendsResponse, error)
+	PostThread(ctx context.Context, tweets []twitter.CreateTweetRequest) (*twitter.PostThreadResponse, error)
+	QuoteTweet(ctx context.Context, targetID, text string, opts twitter.QuoteTweetOpts) (*twitter.CreateTweetResponse, error)
+	QuoteTweetsLookup(ctx context.Context, tweetID string, opts twitter.QuoteTweetsLookupOpts) (*twitter.QuoteTweetsLookupResponse, error)
+	RateLimits() []twitter.EndpointRateLimit
+	RefreshPoll(ctx context.Context, tweetID string) (*twitter.TweetPollResponse, error)
+	RemoveListMember(ctx context.Context, listID string, userID string) (*twitter.ListRemoveMemberResponse, error)
+	RemoveTweetBookmark(ctx context.Context, userID string, tweetID string) (*twitter.RemoveTweetBookmarkResponse, error)
+	ResolveEntities(ctx context.Context, inputs []string, opts twitter.ResolveEntitiesOpts) (*twitter.ResolveEntitiesResponse, error)
+	RetagStreamRules(ctx context.Context, oldTag string, newTag string) (*twitter.RetagStreamRulesResponse, error)
+	SearchTweets(ctx context.Context, query string, opts twitter.TweetSearchOpts) (*twitter.TweetSearchPage, error)
+	SeedTweetCap(ctx context.Context) (twitter.TweetCapUsage, error)
+	SendDMToConversation(ctx context.Context, conversationID string, message twitter.CreateDMMessage) (*twitter.CreateDMEventResponse, error)
+	SendDMToParticipant(ctx context.Context, participantID string, message twitter.CreateDMMessage) (*twitter.CreateDMEventResponse, error)
+	SpaceBuyersLookup(ctx context.Context, spaceID string, opts twitter.SpaceBuyersLookupOpts) (*twitter.SpaceBuyersLookupResponse, error)
+	SpaceTweetsLookup(ctx context.Context, spaceID string, opts twitter.SpaceTweetsLookupOpts) (*twitter.SpaceTweetsLookupResponse, error)
+	SpacesByCreatorLookup(ctx context.Context, userIDs []string, opts twitter.SpacesByCreatorLookupOpts) (*twitter.SpacesByCreatorLookupResponse, error)
+	SpacesLookup(ctx context.Context, ids []string, opts twitter.SpacesLookupOpts) (*twitter.SpacesLookupResponse, error)
+	SpacesSearch(ctx context.Context, query string, opts twitter.SpacesSearchOpts) (*twitter.SpacesSearchResponse, error)
+	SyncListMembers(ctx context.Context, listID string, userIDs []string) (*twitter.SyncListMembersResponse, error)
+	TrendsByWOEID(ctx context.Context, woeid int, opts twitter.TrendsByWOEIDOpts) (*twitter.TrendsByWOEIDResponse, error)
+	TriggerAccountActivityCRC(ctx context.Context, env string, webhookID string) (*twitter.AccountActivityResponse, error)
+	TweetAllCounts(ctx context.Context, query string, opts twitter.TweetAllCountsOpts) (*twitter.TweetAllCountsResponse, error)
+	TweetBookmarkFolderLookup(ctx context.Context, userID, folderID string, opts twitter.TweetBookmarksLookupOpts) (*twitter.TweetBookmarksLookupResponse, error)
+	TweetBookmarkFolders(ctx context.Context, userID string, opts twitter.TweetBookmarkFoldersOpts) (*twitter.TweetBookmarkFoldersResponse, error)
+	TweetBookmarksLookup(ctx context.Context, userID string, opts twitter.TweetBookmarksLookupOpts) (*twitter.TweetBookmarksLookupResponse, error)
+	TweetEditHistory(ctx context.Context, id string, opts twitter.TweetLookupOpts) (*twitter.TweetEditHistoryResponse, error)
+	TweetHideReplies(ctx context.Context, id string, hide bool) (*twitter.TweetHideReplyResponse, error)
+	TweetLikesLookup(ctx context.Context, tweetID string, opts twitter.TweetLikesLookupOpts) (*twitter.TweetLikesLookupResponse, error)
+	TweetLookup(ctx context.Context, ids []string, opts twitter.TweetLookupOpts) (*twitter.TweetLookupResponse, error)
+	TweetLookupAsync(ctx context.Context, ids []string, opts twitter.TweetLookupOpts) (*twitter.TweetLookupAsyncResponse, error)
+	TweetMetricsLookup(ctx context.Context, ids []string, opts twitter.TweetLookupOpts) (*twitter.TweetLookupResponse, error)
+	TweetRecentCounts(ctx context.Context, query string, opts twitter.TweetRecentCountsOpts) (*twitter.TweetRecentCountsResponse, error)
+	TweetRecentSearch(ctx context.Context, query string, opts twitter.TweetRecentSearchOpts) (*twitter.TweetRecentSearchResponse, error)
+	TweetRecentSearchAsync(ctx context.Context, query string, opts twitter.TweetRecentSearchOpts) (*twitter.TweetRecentSearchAsyncResponse, error)
+	TweetSampleStream(ctx context.Context, opts twitter.TweetSampleStreamOpts) (*twitter.TweetStream, error)
+	TweetSearch(ctx context.Context, query string, opts twitter.TweetSearchOpts) (*twitter.TweetSearchResponse, error)
+	TweetSearchStream(ctx context.Context, opts twitter.TweetSearchStreamOpts) (*twitter.TweetStream, error)
+	TweetSearchStreamAddRule(ctx context.Context, rules []twitter.TweetSearchStreamRule, dryRun bool) (*twitter.TweetSearchStreamAddRuleResponse, error)
+	TweetSearchStreamDeleteRuleByID(ctx context.Context, ruleIDs []twitter.TweetSearchStreamRuleID, dryRun bool) (*twitter.TweetSearchStreamDeleteRuleResponse, error)
+	TweetSearchStreamDeleteRuleByValue(ctx context.Context, ruleValues []string, dryRun bool) (*twitter.TweetSearchStreamDeleteRuleResponse, error)
+	TweetSearchStreamDeleteRulesByTag(ctx context.Context, tags []string, dryRun bool) (*twitter.TweetSearchStreamDeleteRuleResponse, error)
+	TweetSearchStreamRules(ctx context.Context, ruleIDs []twitter.TweetSearchStreamRuleID) (*twitter.TweetSearchStreamRulesResponse, error)
+	TweetUsage(ctx context.Context, opts twitter.TweetUsageOpts) (*twitter.TweetUsageResponse, error)
+	UpdateList(ctx context.Context, listID string, update twitter.ListMetaData) (*twitter.ListUpdateResponse, error)
+	UserBlocks(ctx context.Context, userID string, targetUserID string) (*twitter.UserBlocksResponse, error)
+	UserBlocksLookup(ctx context.Context, userID string, opts twitter.UserBlocksLookupOpts) (*twitter.UserBlocksLookupResponse, error)
+	UserFollowList(ctx context.Context, userID string, listID string) (*twitter.UserFollowListResponse, error)
+	UserFollowedLists(ctx context.Context, userID string, opts twitter.UserFollowedListsOpts) (*twitter.UserFollowedListsResponse, error)
+	UserFollowersLookup(ctx context.Context, id string, opts twitter.UserFollowersLookupOpts) (*twitter.UserFollowersLookupResponse, error)
+	UserFollowingLookup(ctx context.Context, id string, opts twitter.UserFollowingLookupOpts) (*twitter.UserFollowingLookupResponse, error)
+	UserFollows(ctx context.Context, userID string, targetUserID string) (*twitter.UserFollowsResponse, error)
+	UserLikes(ctx context.Context, userID string, tweetID string) (*twitter.UserLikesResponse, error)
+	UserLikesAsync(ctx context.Context, userID string, tweetID string) (*twitter.UserLikesAsyncResponse, error)
+	UserLikesLookup(ctx context.Context, userID string, opts twitter.UserLikesLookupOpts) (*twitter.UserLikesLookupResponse, error)
+	UserListLookup(ctx context.Context, userID string, opts twitter.UserListLookupOpts) (*twitter.UserListLookupResponse, error)
+	UserListMemberships(ctx context.Context, userID string, opts twitter.UserListMembershipsOpts) (*twitter.UserListMembershipsResponse, error)
+	UserLookup(ctx context.Context, ids []string, opts twitter.UserLookupOpts) (*twitter.UserLookupResponse, error)
+	UserMentionTimeline(ctx context.Context, userID string, opts twitter.UserMentionTimelineOpts) (*twitter.UserMentionTimelineResponse, error)
+	UserMutes(ctx context.Context, userID string, targetUserID string) (*twitter.UserMutesResponse, error)
+	UserMutesLookup(ctx context.Context, userID string, opts twitter.UserMutesLookupOpts) (*twitter.UserMutesLookupResponse, error)
+	UserNameLookup(ctx context.Context, usernames []string, opts twitter.UserLookupOpts) (*twitter.UserLookupResponse, error)
+	UserNameLookupAsync(ctx context.Context, usernames []string, opts twitter.UserLookupOpts) (*twitter.UserNameLookupAsyncResponse, error)
+	UserPinList(ctx context.Context, userID string, listID string) (*twitter.UserPinListResponse, error)
+	UserPinnedLists(ctx context.Context, userID string, opts twitter.UserPinnedListsOpts) (*twitter.UserPinnedListsResponse, error)
+	UserRetweet(ctx context.Context, userID string, tweetID string) (*twitter.UserRetweetResponse, error)
+	UserRetweetLookup(ctx context.Context, tweetID string, opts twitter.UserRetweetLookupOpts) (*twitter.UserRetweetLookupResponse, error)
+	UserTweetReverseChronologicalTimeline(ctx context.Context, userID string, opts twitter.UserTweetReverseChronologicalTimelineOpts) (*twitter.UserTweetReverseChronologicalTimelineResponse, error)
+	UserTweetTimeline(ctx context.Context, userID string, opts twitter.UserTweetTimelineOpts) (*twitter.UserTweetTimelineResponse, error)
+	UserTweetTimelineAsync(ctx context.Context, userID string, opts twitter.UserTweetTimelineOpts) (*twitter.UserTweetTimelineAsyncResponse, error)
+	UserUnfollowList(ctx context.Context, userID string, listID string) (*twitter.UserUnfollowListResponse, error)
+	UserUnpinList(ctx context.Context, userID string, listID string) (*twitter.UserUnpinListResponse, error)
+}
+
+var (
+	_ Client = (*twitter.Client)(nil)
+	_ Client = (*Fake)(nil)
+)
+
+// Fake is a client with programmable responses.  Each method calls its func, like TweetRecentSearchFunc, and returns
+// a *NotProgrammedError if the func is not set.  The calls are recorded in the order they are made.
+type Fake struct {
+	AccountActivitySubscribedFunc             func(ctx context.Context, env string) (*twitter.AccountActivitySubscribedResponse, error)
+	AccountActivitySubscriptionsFunc          func(ctx context.Context, env string) (*twitter.AccountActivitySubscriptionsResponse, error)
+	AccountActivityWebhooksFunc               func(ctx context.Context, env string) (*twitter.AccountActivityWebhooksResponse, error)
+	AddAccountActivitySubscriptionFunc        func(ctx context.Context, env string) (*twitter.AccountActivityResponse, error)
+	AddListMemberFunc                         func(ctx context.Context, listID string, userID string) (*twitter.ListAddMemberResponse, error)
+	AddTweetBookmarkFunc                      func(ctx context.Context, userID string, tweetID string) (*twitter.AddTweetBookmarkResponse, error)
+	AuthUserLookupFunc                        func(ctx context.Context, opts twitter.UserLookupOpts) (*twitter.UserLookupResponse, error)
+	CommunityLookupFunc                       func(ctx context.Context, id string, opts twitter.CommunityLookupOpts) (*twitter.CommunityLookupResponse, error)
+	CommunitySearchFunc                       func(ctx context.Context, query string, opts twitter.CommunitySearchOpts) (*twitter.CommunitySearchResponse, error)
+	ComplianceBatchJobFunc                    func(ctx context.Context, id string) (*twitter.ComplianceBatchJobResponse, error)
+	ComplianceBatchJobLookupFunc              func(ctx context.Context, jobType twitter.ComplianceBatchJobType, opts twitter.ComplianceBatchJobLookupOpts) (*twitter.ComplianceBatchJobLookupResponse, error)
+	CreateAccountActivityWebhookFunc          func(ctx context.Context, env string, webhookURL string) (*twitter.AccountActivityWebhookResponse, error)
+	CreateComplianceBatchJobFunc              func(ctx context.Context, jobType twitter.ComplianceBatchJobType, opts twitter.CreateComplianceBatchJobOpts) (*twitter.CreateComplianceBatchJobResponse, error)
+	CreateDMConversationFunc                  func(ctx context.Context, conversation twitter.CreateDMConversationRequest) (*twitter.CreateDMEventResponse, error)
+	CreateListFunc                            func(ctx context.Context, list twitter.ListMetaData) (*twitter.ListCreateResponse, error)
+	CreateNoteFunc                            func(ctx context.Context, note twitter.CreateNoteRequest) (*twitter.CreateNoteResponse, error)
+	CreateTweetFunc                           func(ctx context.Context, tweet twitter.CreateTweetRequest) (*twitter.CreateTweetResponse, error)
+	CreateTweetAsyncFunc                      func(ctx context.Context, tweet twitter.CreateTweetRequest) (*twitter.CreateTweetAsyncResponse, error)
+	DMConversationEventsLookupFunc            func(ctx context.Context, conversationID string, opts twitter.DMEventsLookupOpts) (*twitter.DMEventsLookupResponse, error)
+	DMEventsLookupFunc                        func(ctx context.Context, opts twitter.DMEventsLookupOpts) (*twitter.DMEventsLookupResponse, error)
+	DMParticipantEventsLookupFunc             func(ctx context.Context, participantID string, opts twitter.DMEventsLookupOpts) (*twitter.DMEventsLookupResponse, error)
+	DeleteAccountActivitySubscriptionFunc     func(ctx context.Context, env string, userID string) (*twitter.AccountActivityResponse, error)
+	DeleteAccountActivityWebhookFunc          func(ctx context.Context, env string, webhookID string) (*twitter.AccountActivityResponse, error)
+	DeleteListFunc                            func(ctx context.Context, listID string) (*twitter.ListDeleteResponse, error)
+	DeleteTweetFunc                           func(ctx context.Context, id string) (*twitter.DeleteTweetResponse, error)
+	DeleteUserBlocksFunc                      func(ctx context.Context, userID string, targetUserID string) (*twitter.UserDeleteBlocksResponse, error)
+	DeleteUserFollowsFunc                     func(ctx context.Context, userID string, targetUserID string) (*twitter.UserDeleteFollowsResponse, error)
+	DeleteUserLikesFunc                       func(ctx context.Context, userID string, tweetID string) (*twitter.DeleteUserLikesResponse, error)
+	DeleteUserMutesFunc                       func(ctx context.Context, userID string, targetUserID string) (*twitter.UserDeleteMutesResponse, error)
+	DeleteUserRetweetFunc                     func(ctx context.Context, userID string, tweetID string) (*twitter.DeleteUserRetweetResponse, error)
+	DoctorFunc                                func(ctx context.Context) (*twitter.DoctorReport, error)
+	EvaluateNoteFunc                          func(ctx context.Context, evaluate twitter.EvaluateNoteRequest) (*twitter.EvaluateNoteResponse, error)
+	GeoPlaceLookupFunc                        func(ctx context.Context, placeID string) (*twitter.GeoPlaceResponse, error)
+	GeoSearchFunc                             func(ctx context.Context, opts twitter.GeoSearchOpts) (*twitter.GeoSearchResponse, error)
+	ListLookupFunc                            func(ctx context.Context, listID string, opts twitter.ListLookupOpts) (*twitter.ListLookupResponse, error)
+	ListTweetLookupFunc                       func(ctx context.Context, listID string, opts twitter.ListTweetLookupOpts) (*twitter.ListTweetLookupResponse, error)
+	ListUserFollowersFunc                     func(ctx context.Context, listID string, opts twitter.ListUserFollowersOpts) (*twitter.ListUserFollowersResponse, error)
+	ListUserMembersFunc                       func(ctx context.Context, listID string, opts twitter.ListUserMembersOpts) (*twitter.ListUserMembersResponse, error)
+	NotesEligiblePostsFunc                    func(ctx context.Context, opts twitter.NotesEligiblePostsOpts) (*twitter.NotesEligiblePostsResponse, error)
+	NotesWrittenFunc                          func(ctx context.Context, opts twitter.NotesWrittenOpts) (*twitter.NotesWrittenResponse, error)
+	ParseCreateTweetAsyncResponseFunc         func(resp *http.Response) (*twitter.CreateTweetResponse, error)
+	ParseTweetLookupAsyncResponseFunc         func(ids []string, resp *http.Response) (*twitter.TweetLookupResponse, error)
+	ParseTweetRecentSearchAsyncResponseFunc   func(resp *http.Response) (*twitter.TweetRecentSearchResponse, error)
+	ParseUserLikesAsyncResponseFunc           func(resp *http.Response) (*twitter.UserLikesResponse, error)
+	ParseUserNameLookupAsyncResponseFunc      func(usernames []string, resp *http.Response) (*twitter.UserLookupResponse, error)
+	ParseUserTweetTimelineAsyncResponseFunc   func(resp *http.Response) (*twitter.UserTweetTimelineResponse, error)
+	PersonalizedTrendsFunc                    func(ctx context.Context, opts twitter.PersonalizedTrendsOpts) (*twitter.PersonalizedTrendsResponse, error)
+	PostThreadFunc                            func(ctx context.Context, tweets []twitter.CreateTweetRequest) (*twitter.PostThreadResponse, error)
+	QuoteTweetFunc                            func(ctx context.Context, targetID, text string, opts twitter.QuoteTweetOpts) (*twitter.CreateTweetResponse, error)
+	QuoteTweetsLookupFunc                     func(ctx context.Context, tweetID string, opts twitter.QuoteTweetsLookupOpts) (*twitter.QuoteTweetsLookupResponse, error)
+	RateLimitsFunc                            func() []twitter.EndpointRateLimit
+	RefreshPollFunc                           func(ctx context.Context, tweetID string) (*twitter.TweetPollResponse, error)
+	RemoveListMemberFunc                      func(ctx context.Context, listID string, userID string) (*twitter.ListRemoveMemberResponse, error)
+	RemoveTweetBookmarkFunc                   func(ctx context.Context, userID string, tweetID string) (*twitter.RemoveTweetBookmarkResponse, error)
+	ResolveEntitiesFunc                       func(ctx context.Context, inputs []string, opts twitter.ResolveEntitiesOpts) (*twitter.ResolveEntitiesResponse, error)
+	RetagStreamRulesFunc                      func(ctx context.Context, oldTag string, newTag string) (*twitter.RetagStreamRulesResponse, error)
+	SearchTweetsFunc                          func(ctx context.Context, query string, opts twitter.TweetSearchOpts) (*twitter.TweetSearchPage, error)
+	SeedTweetCapFunc                          func(ctx context.Context) (twitter.TweetCapUsage, error)
+	SendDMToConversationFunc                  func(ctx context.Context, conversationID string, message twitter.CreateDMMessage) (*twitter.CreateDMEventResponse, error)
+	SendDMToParticipantFunc                   func(ctx context.Context, participantID string, message twitter.CreateDMMessage) (*twitter.CreateDMEventResponse, error)
+	SpaceBuyersLookupFunc                     func(ctx context.Context, spaceID string, opts twitter.SpaceBuyersLookupOpts) (*twitter.SpaceBuyersLookupResponse, error)
+	SpaceTweetsLookupFunc                     func(ctx context.Context, spaceID string, opts twitter.SpaceTweetsLookupOpts) (*twitter.SpaceTweetsLookupResponse, error)
+	SpacesByCreatorLookupFunc                 func(ctx context.Context, userIDs []string, opts twitter.SpacesByCreatorLookupOpts) (*twitter.SpacesByCreatorLookupResponse, error)
+	SpacesLookupFunc                          func(ctx context.Context, ids []string, opts twitter.SpacesLookupOpts) (*twitter.SpacesLookupResponse, error)
+	SpacesSearchFunc                          func(ctx context.Context, query string, opts twitter.SpacesSearchOpts) (*twitter.SpacesSearchResponse, error)
+	SyncListMembersFunc                       func(ctx context.Context, listID string, userIDs []string) (*twitter.SyncListMembersResponse, error)
+	TrendsByWOEIDFunc                         func(ctx context.Context, woeid int, opts twitter.TrendsByWOEIDOpts) (*twitter.TrendsByWOEIDResponse, error)
+	TriggerAccountActivityCRCFunc             func(ctx context.Context, env string, webhookID string) (*twitter.AccountActivityResponse, error)
+	TweetAllCountsFunc                        func(ctx context.Context, query string, opts twitter.TweetAllCountsOpts) (*twitter.TweetAllCountsResponse, error)
+	TweetBookmarkFolderLookupFunc             func(ctx context.Context, userID, folderID string, opts twitter.TweetBookmarksLookupOpts) (*twitter.TweetBookmarksLookupResponse, error)
+	TweetBookmarkFoldersFunc                  func(ctx context.Context, userID string, opts twitter.TweetBookmarkFoldersOpts) (*twitter.TweetBookmarkFoldersResponse, error)
+	TweetBookmarksLookupFunc                  func(ctx context.Context, userID string, opts twitter.TweetBookmarksLookupOpts) (*twitter.TweetBookmarksLookupResponse, error)
+	TweetEditHistoryFunc                      func(ctx context.Context, id string, opts twitter.TweetLookupOpts) (*twitter.TweetEditHistoryResponse, error)
+	TweetHideRepliesFunc                      func(ctx context.Context, id string, hide bool) (*twitter.TweetHideReplyResponse, error)
+	TweetLikesLookupFunc                      func(ctx context.Context, tweetID string, opts twitter.TweetLikesLookupOpts) (*twitter.TweetLikesLookupResponse, error)
+	TweetLookupFunc                           func(ctx context.Context, ids []string, opts twitter.TweetLookupOpts) (*twitter.TweetLookupResponse, error)
+	TweetLookupAsyncFunc                      func(ctx context.Context, ids []string, opts twitter.TweetLookupOpts) (*twitter.TweetLookupAsyncResponse, error)
+	TweetMetricsLookupFunc                    func(ctx context.Context, ids []string, opts twitter.TweetLookupOpts) (*twitter.TweetLookupResponse, error)
+	TweetRecentCountsFunc                     func(ctx context.Context, query string, opts twitter.TweetRecentCountsOpts) (*twitter.TweetRecentCountsResponse, error)
+	TweetRecentSearchFunc                     func(ctx context.Context, query string, opts twitter.TweetRecentSearchOpts) (*twitter.TweetRecentSearchResponse, error)
+	TweetRecentSearchAsyncFunc                func(ctx context.Context, query string, opts twitter.TweetRecentSearchOpts) (*twitter.TweetRecentSearchAsyncResponse, error)
+	TweetSampleStreamFunc                     func(ctx context.Context, opts twitter.TweetSampleStreamOpts) (*twitter.TweetStream, error)
+	TweetSearchFunc                           func(ctx context.Context, query string, opts twitter.TweetSearchOpts) (*twitter.TweetSearchResponse, error)
+	TweetSearchStreamFunc                     func(ctx context.Context, opts twitter.TweetSearchStreamOpts) (*twitter.TweetStream, error)
+	TweetSearchStreamAddRuleFunc              func(ctx context.Context, rules []twitter.TweetSearchStreamRule, dryRun bool) (*twitter.TweetSearchStreamAddRuleResponse, error)
+	TweetSearchStreamDeleteRuleByIDFunc       func(ctx context.Context, ruleIDs []twitter.TweetSearchStreamRuleID, dryRun bool) (*twitter.TweetSearchStreamDeleteRuleResponse, error)
+	TweetSearchStreamDeleteRuleByValueFunc    func(ctx context.Context, ruleValues []string, dryRun bool) (*twitter.TweetSearchStreamDeleteRuleResponse, error)
+	TweetSearchStreamDeleteRulesByTagFunc     func(ctx context.Context, tags []string, dryRun bool) (*twitter.TweetSearchStreamDeleteRuleResponse, error)
+	TweetSearchStreamRulesFunc                func(ctx context.Context, ruleIDs []twitter.TweetSearchStreamRuleID) (*twitter.TweetSearchStreamRulesResponse, error)
+	TweetUsageFunc                            func(ctx context.Context, opts twitter.TweetUsageOpts) (*twitter.TweetUsageResponse, error)
+	UpdateListFunc                            func(ctx context.Context, listID string, update twitter.ListMetaData) (*twitter.ListUpdateResponse, error)
+	UserBlocksFunc                            func(ctx context.Context, userID string, targetUserID string) (*twitter.UserBlocksResponse, error)
+	UserBlocksLookupFunc                      func(ctx context.Context, userID string, opts twitter.UserBlocksLookupOpts) (*twitter.UserBlocksLookupResponse, error)
+	UserFollowListFunc                        func(ctx context.Context, userID string, listID string) (*twitter.UserFollowListResponse, error)
+	UserFollowedListsFunc                     func(ctx context.Context, userID string, opts twitter.UserFollowedListsOpts) (*twitter.UserFollowedListsResponse, error)
+	UserFollowersLookupFunc                   func(ctx context.Context, id string, opts twitter.UserFollowersLookupOpts) (*twitter.UserFollowersLookupResponse, error)
+	UserFollowingLookupFunc                   func(ctx context.Context, id string, opts twitter.UserFollowingLookupOpts) (*twitter.UserFollowingLookupResponse, error)
+	UserFollowsFunc                           func(ctx context.Context, userID string, targetUserID string) (*twitter.UserFollowsResponse, error)
+	UserLikesFunc                             func(ctx context.Context, userID string, tweetID string) (*twitter.UserLikesResponse, error)
+	UserLikesAsyncFunc                        func(ctx context.Context, userID string, tweetID string) (*twitter.UserLikesAsyncResponse, error)
+	UserLikesLookupFunc                       func(ctx context.Context, userID string, opts twitter.UserLikesLookupOpts) (*twitter.UserLikesLookupResponse, error)
+	UserListLookupFunc                        func(ctx context.Context, userID string, opts twitter.UserListLookupOpts) (*twitter.UserListLookupResponse, error)
+	UserListMembershipsFunc                   func(ctx context.Context, userID string, opts twitter.UserListMembershipsOpts) (*twitter.UserListMembershipsResponse, error)
+	UserLookupFunc                            func(ctx context.Context, ids []string, opts twitter.UserLookupOpts) (*twitter.UserLookupResponse, error)
+	UserMentionTimelineFunc                   func(ctx context.Context, userID string, opts twitter.UserMentionTimelineOpts) (*twitter.UserMentionTimelineResponse, error)
+	UserMutesFunc                             func(ctx context.Context, userID string, targetUserID string) (*twitter.UserMutesResponse, error)
+	UserMutesLookupFunc                       func(ctx context.Context, userID string, opts twitter.UserMutesLookupOpts) (*twitter.UserMutesLookupResponse, error)
+	UserNameLookupFunc                        func(ctx context.Context, usernames []string, opts twitter.UserLookupOpts) (*twitter.UserLookupResponse, error)
+	UserNameLookupAsyncFunc                   func(ctx context.Context, usernames []string, opts twitter.UserLookupOpts) (*twitter.UserNameLookupAsyncResponse, error)
+	UserPinListFunc                           func(ctx context.Context, userID string, listID string) (*twitter.UserPinListResponse, error)
+	UserPinnedListsFunc                       func(ctx context.Context, userID string, opts twitter.UserPinnedListsOpts) (*twitter.UserPinnedListsResponse, error)
+	UserRetweetFunc                           func(ctx context.Context, userID string, tweetID string) (*twitter.UserRetweetResponse, error)
+	UserRetweetLookupFunc                     func(ctx context.Context, tweetID string, opts twitter.UserRetweetLookupOpts) (*twitter.UserRetweetLookupResponse, error)
+	UserTweetReverseChronologicalTimelineFunc func(ctx context.Context, userID string, opts twitter.UserTweetReverseChronologicalTimelineOpts) (*twitter.UserTweetReverseChronologicalTimelineResponse, error)
+	UserTweetTimelineFunc                     func(ctx context.Context, userID string, opts twitter.UserTweetTimelineOpts) (*twitter.UserTweetTimelineResponse, error)
+	UserTweetTimelineAsyncFunc                func(ctx context.Context, userID string, opts twitter.UserTweetTimelineOpts) (*twitter.UserTweetTimelineAsyncResponse, error)
+	UserUnfollowListFunc                      func(ctx context.Context, userID string, listID string) (*twitter.UserUnfollowListResponse, error)
+	UserUnpinListFunc                         func(ctx context.Context, userID string, listID string) (*twitter.UserUnpinListResponse, error)
+	calls                                     calls
+}
+
+// AccountActivitySubscribed calls AccountActivitySubscribedFunc
+func (f *Fake) AccountActivitySubscribed(ctx context.Context, env string) (*twitter.AccountActivitySubscribedResponse, error) {
+	f.calls.record("AccountActivitySubscribed", ctx, env)
+	if f.AccountActivitySubscribedFunc == nil {
+		return nil, notProgrammed("AccountActivitySubscribed")
+	}
+	return f.AccountActivitySubscribedFunc(ctx, env)
+}
+
+// AccountActivitySubscriptions calls AccountActivitySubscriptionsFunc
+func (f *Fake) AccountActivitySubscriptions(ctx context.Context, env string) (*twitter.AccountActivitySubscriptionsResponse, error) {
+	f.calls.record("AccountActivitySubscriptions", ctx, env)
+	if f.AccountActivitySubscriptionsFunc == nil {
+		return nil, notProgrammed("AccountActivitySubscriptions")
+	}
+	return f.AccountActivitySubscriptionsFunc(ctx, env)
+}
+
+// AccountActivityWebhooks calls AccountActivityWebhooksFunc
+func (f *Fake) AccountActivityWebhooks(ctx context.Context, env string) (*twitter.AccountActivityWebhooksResponse, error) {
+	f.calls.record("AccountActivityWebhooks", ctx, env)
+	if f.AccountActivityWebhooksFunc == nil {
+		return nil, notProgrammed("AccountActivityWebhooks")
+	}
+	return f.AccountActivityWebhooksFunc(ctx, env)
+}
+
+// AddAccountActivitySubscription calls AddAccountActivitySubscriptionFunc
+func (f *Fake) AddAccountActivitySubscription(ctx context.Context, env string) (*twitter.AccountActivityResponse, error) {
+	f.calls.record("AddAccountActivitySubscription", ctx, env)
+	if f.AddAccountActivitySubscriptionFunc == nil {
+		return nil, notProgrammed("AddAccountActivitySubscription")
+	}
+	return f.AddAccountActivitySubscriptionFunc(ctx, env)
+}
+
+// AddListMember calls AddListMemberFunc
+func (f *Fake) AddListMember(ctx context.Context, listID string, userID string) (*twitter.ListAddMemberResponse, error) {
+	f.calls.record("AddListMember", ctx, listID, userID)
+	if f.AddListMemberFunc == nil {
+		return nil, notProgrammed("AddListMember")
+	}
+	return f.AddListMemberFunc(ctx, listID, userID)
+}
+
+// AddTweetBookmark calls AddTweetBookmarkFunc
+func (f *Fake) AddTweetBookmark(ctx context.Context, userID string, tweetID string) (*twitter.AddTweetBookmarkResponse, error) {
+	f.calls.record("AddTweetBookmark", ctx, userID, tweetID)
+	if f.AddTweetBookmarkFunc == nil {
+		return nil, notProgrammed("AddTweetBookmark")
+	}
+	return f.AddTweetBookmarkFunc(ctx, userID, tweetID)
+}
+
+// AuthUserLookup calls AuthUserLookupFunc
+func (f *Fake) AuthUserLookup(ctx context.Context, opts twitter.UserLookupOpts) (*twitter.UserLookupResponse, error) {
+	f.calls.record("AuthUserLookup", ctx, opts)
+	if f.AuthUserLookupFunc == nil {
+		return nil, notProgrammed("AuthUserLookup")
+	}
+	return f.AuthUserLookupFunc(ctx, opts)
+}
+
+// CommunityLookup calls CommunityLookupFunc
+func (f *Fake) CommunityLookup(ctx context.Context, id string, opts twitter.CommunityLookupOpts) (*twitter.CommunityLookupResponse, error) {
+	f.calls.record("CommunityLookup", ctx, id, opts)
+	if f.CommunityLookupFunc == nil {
+		return nil, notProgrammed("CommunityLookup")
+	}
+	return f.CommunityLookupFunc(ctx, id, opts)
+}
+
+// CommunitySearch calls CommunitySearchFunc
+func (f *Fake) CommunitySearch(ctx context.Context, query string, opts twitter.CommunitySearchOpts) (*twitter.CommunitySearchResponse, error) {
+	f.calls.record("CommunitySearch", ctx, query, opts)
+	if f.CommunitySearchFunc == nil {
+		return nil, notProgrammed("CommunitySearch")
+	}
+	return f.CommunitySearchFunc(ctx, query, opts)
+}
+
+// ComplianceBatchJob calls ComplianceBatchJobFunc
+func (f *Fake) ComplianceBatchJob(ctx context.Context, id string) (*twitter.ComplianceBatchJobResponse, error) {
+	f.calls.record("ComplianceBatchJob", ctx, id)
+	if f.ComplianceBatchJobFunc == nil {
+		return nil, notProgrammed("ComplianceBatchJob")
+	}
+	return f.ComplianceBatchJobFunc(ctx, id)
+}
+
+// ComplianceBatchJobLookup calls ComplianceBatchJobLookupFunc
+func (f *Fake) ComplianceBatchJobLookup(ctx context.Context, jobType twitter.ComplianceBatchJobType, opts twitter.ComplianceBatchJobLookupOpts) (*twitter.ComplianceBatchJobLookupResponse, error) {
+	f.calls.record("ComplianceBatchJobLookup", ctx, jobType, opts)
+	if f.ComplianceBatchJobLookupFunc == nil {
+		return nil, notProgrammed("ComplianceBatchJobLookup")
+	}
+	return f.ComplianceBatchJobLookupFunc(ctx, jobType, opts)
+}
+
+// CreateAccountActivityWebhook calls CreateAccountActivityWebhookFunc
+func (f *Fake) CreateAccountActivityWebhook(ctx context.Context, env string, webhookURL string) (*twitter.AccountActivityWebhookResponse, error) {
+	f.calls.record("CreateAccountActivityWebhook", ctx, env, webhookURL)
+	if f.CreateAccountActivityWebhookFunc == nil {
+		return nil, notProgrammed("CreateAccountActivityWebhook")
+	}
+	return f.CreateAccountActivityWebhookFunc(ctx, env, webhookURL)
+}
+
+// CreateComplianceBatchJob calls CreateComplianceBatchJobFunc
+func (f *Fake) CreateComplianceBatchJob(ctx context.Context, jobType twitter.ComplianceBatchJobType, opts twitter.CreateComplianceBatchJobOpts) (*twitter.CreateComplianceBatchJobResponse, error) {
+	f.calls.record("CreateComplianceBatchJob", ctx, jobType, opts)
+	if f.CreateComplianceBatchJobFunc == nil {
+		return nil, notProgrammed("CreateComplianceBatchJob")
+	}
+	return f.CreateComplianceBatchJobFunc(ctx, jobType, opts)
+}
+
+// CreateDMConversation calls CreateDMConversationFunc
+func (f *Fake) CreateDMConversation(ctx context.Context, conversation twitter.CreateDMConversationRequest) (*twitter.CreateDMEventResponse, error) {
+	f.calls.record("CreateDMConversation", ctx, conversation)
+	if f.CreateDMConversationFunc == nil {
+		return nil, notProgrammed("CreateDMConversation")
+	}
+	return f.CreateDMConversationFunc(ctx, conversation)
+}
+
+// CreateList calls CreateListFunc
+func (f *Fake) CreateList(ctx context.Context, list twitter.ListMetaData) (*twitter.ListCreateResponse, error) {
+	f.calls.record("CreateList", ctx, list)
+	if f.CreateListFunc == nil {
+		return nil, notProgrammed("CreateList")
+	}
+	return f.CreateListFunc(ctx, list)
+}
+
+// CreateNote calls CreateNoteFunc
+func (f *Fake) CreateNote(ctx context.Context, note twitter.CreateNoteRequest) (*twitter.CreateNoteResponse, error) {
+	f.calls.record("CreateNote", ctx, note)
+	if f.CreateNoteFunc == nil {
+		return nil, notProgrammed("CreateNote")
+	}
+	return f.CreateNoteFunc(ctx, note)
+}
+
+// CreateTweet calls CreateTweetFunc
+func (f *Fake) CreateTweet(ctx context.Context, tweet twitter.CreateTweetRequest) (*twitter.CreateTweetResponse, error) {
+	f.calls.record("CreateTweet", ctx, tweet)
+	if f.CreateTweetFunc == nil {
+		return nil, notProgrammed("CreateTweet")
+	}
+	return f.CreateTweetFunc(ctx, tweet)
+}
+
+// CreateTweetAsync calls CreateTweetAsyncFunc
+func (f *Fake) CreateTweetAsync(ctx context.Context, tweet twitter.CreateTweetRequest) (*twitter.CreateTweetAsyncResponse, error) {
+	f.calls.record("CreateTweetAsync", ctx, tweet)
+	if f.CreateTweetAsyncFunc == nil {
+		return nil, notProgrammed("CreateTweetAsync")
+	}
+	return f.CreateTweetAsyncFunc(ctx, tweet)
+}
+
+// DMConversationEventsLookup calls DMConversationEventsLookupFunc
+func (f *Fake) DMConversationEventsLookup(ctx context.Context, conversationID string, opts twitter.DMEventsLookupOpts) (*twitter.DMEventsLookupResponse, error) {
+	f.calls.record("DMConversationEventsLookup", ctx, conversationID, opts)
+	if f.DMConversationEventsLookupFunc == nil {
+		return nil, notProgrammed("DMConversationEventsLookup")
+	}
+	return f.DMConversationEventsLookupFunc(ctx, conversationID, opts)
+}
+
+// DMEventsLookup calls DMEventsLookupFunc
+func (f *Fake) DMEventsLookup(ctx context.Context, opts twitter.DMEventsLookupOpts) (*twitter.DMEventsLookupResponse, error) {
+	f.calls.record("DMEventsLookup", ctx, opts)
+	if f.DMEventsLookupFunc == nil {
+		return nil, notProgrammed("DMEventsLookup")
+	}
+	return f.DMEventsLookupFunc(ctx, opts)
+}
+
+// DMParticipantEventsLookup calls DMParticipantEventsLookupFunc
+func (f *Fake) DMParticipantEventsLookup(ctx context.Context, participantID string, opts twitter.DMEventsLookupOpts) (*twitter.DMEventsLookupResponse, error) {
+	f.calls.record("DMParticipantEventsLookup", ctx, participantID, opts)
+	if f.DMParticipantEventsLookupFunc == nil {
+		return nil, notProgrammed("DMParticipantEventsLookup")
+	}
+	return f.DMParticipantEventsLookupFunc(ctx, participantID, opts)
+}
+
+// DeleteAccountActivitySubscription calls DeleteAccountActivitySubscriptionFunc
+func (f *Fake) DeleteAccountActivitySubscription(ctx context.Context, env string, userID string) (*twitter.AccountActivityResponse, error) {
+	f.calls.record("DeleteAccountActivitySubscription", ctx, env, userID)
+	if f.DeleteAccountActivitySubscriptionFunc == nil {
+		return nil, notProgrammed("DeleteAccountActivitySubscription")
+	}
+	return f.DeleteAccountActivitySubscriptionFunc(ctx, env, userID)
+}
+
+// DeleteAccountActivityWebhook calls DeleteAccountActivityWebhookFunc
+func (f *Fake) DeleteAccountActivityWebhook(ctx context.Context, env string, webhookID string) (*twitter.AccountActivityResponse, error) {
+	f.calls.record("DeleteAccountActivityWebhook", ctx, env, webhookID)
+	if f.DeleteAccountActivityWebhookFunc == nil {
+		return nil, notProgrammed("DeleteAccountActivityWebhook")
+	}
+	return f.DeleteAccountActivityWebhookFunc(ctx, env, webhookID)
+}
+
+// DeleteList calls DeleteListFunc
+func (f *Fake) DeleteList(ctx context.Context, listID string) (*twitter.ListDeleteResponse, error) {
+	f.calls.record("DeleteList", ctx, listID)
+	if f.DeleteListFunc == nil {
+		return nil, notProgrammed("DeleteList")
+	}
+	return f.DeleteListFunc(ctx, listID)
+}
+
+// DeleteTweet calls DeleteTweetFunc
+func (f *Fake) DeleteTweet(ctx context.Context, id string) (*twitter.DeleteTweetResponse, error) {
+	f.calls.record("DeleteTweet", ctx, id)
+	if f.DeleteTweetFunc == nil {
+		return nil, notProgrammed("DeleteTweet")
+	}
+	return f.DeleteTweetFunc(ctx, id)
+}
+
+// DeleteUserBlocks calls DeleteUserBlocksFunc
+func (f *Fake) DeleteUserBlocks(ctx context.Context, userID string, targetUserID string) (*twitter.UserDeleteBlocksResponse, error) {
+	f.calls.record("DeleteUserBlocks", ctx, userID, targetUserID)
+	if f.DeleteUserBlocksFunc == nil {
+		return nil, notProgrammed("DeleteUserBlocks")
+	}
+	return f.DeleteUserBlocksFunc(ctx, userID, targetUserID)
+}
+
+// DeleteUserFollows calls DeleteUserFollowsFunc
+func (f *Fake) DeleteUserFollows(ctx context.Context, userID string, targetUserID string) (*twitter.UserDeleteFollowsResponse, error) {
+	f.calls.record("DeleteUserFollows", ctx, userID, targetUserID)
+	if f.DeleteUserFollowsFunc == nil {
+		return nil, notProgrammed("DeleteUserFollows")
+	}
+	return f.DeleteUserFollowsFunc(ctx, userID, targetUserID)
+}
+
+// DeleteUserLikes calls DeleteUserLikesFunc
+func (f *Fake) DeleteUserLikes(ctx context.Context, userID string, tweetID string) (*twitter.DeleteUserLikesResponse, error) {
+	f.calls.record("DeleteUserLikes", ctx, userID, tweetID)
+	if f.DeleteUserLikesFunc == nil {
+		return nil, notProgrammed("DeleteUserLikes")
+	}
+	return f.DeleteUserLikesFunc(ctx, userID, tweetID)
+}
+
+// DeleteUserMutes calls DeleteUserMutesFunc
+func (f *Fake) DeleteUserMutes(ctx context.Context, userID string, targetUserID string) (*twitter.UserDeleteMutesResponse, error) {
+	f.calls.record("DeleteUserMutes", ctx, userID, targetUserID)
+	if f.DeleteUserMutesFunc == nil {
+		return nil, notProgrammed("DeleteUserMutes")
+	}
+	return f.DeleteUserMutesFunc(ctx, userID, targetUserID)
+}
+
+// DeleteUserRetweet calls DeleteUserRetweetFunc
+func (f *Fake) DeleteUserRetweet(ctx context.Context, userID string, tweetID string) (*twitter.DeleteUserRetweetResponse, error) {
+	f.calls.record("DeleteUserRetweet", ctx, userID, tweetID)
+	if f.DeleteUserRetweetFunc == nil {
+		return nil, notProgrammed("DeleteUserRetweet")
+	}
+	return f.DeleteUserRetweetFunc(ctx, userID, tweetID)
+}
+
+// Doctor calls DoctorFunc
+func (f *Fake) Doctor(ctx context.Context) (*twitter.DoctorReport, error) {
+	f.calls.record("Doctor", ctx)
+	if f.DoctorFunc == nil {
+		return nil, notProgrammed("Doctor")
+	}
+	return f.DoctorFunc(ctx)
+}
+
+// EvaluateNote calls EvaluateNoteFunc
+func (f *Fake) EvaluateNote(ctx context.Context, evaluate twitter.EvaluateNoteRequest) (*twitter.EvaluateNoteResponse, error) {
+	f.calls.record("EvaluateNote", ctx, evaluate)
+	if f.EvaluateNoteFunc == nil {
+		return nil, notProgrammed("EvaluateNote")
+	}
+	return f.EvaluateNoteFunc(ctx, evaluate)
+}
+
+// GeoPlaceLookup calls GeoPlaceLookupFunc
+func (f *Fake) GeoPlaceLookup(ctx context.Context, placeID string) (*twitter.GeoPlaceResponse, error) {
+	f.calls.record("GeoPlaceLookup", ctx, placeID)
+	if f.GeoPlaceLookupFunc == nil {
+		return nil, notProgrammed("GeoPlaceLookup")
+	}
+	return f.GeoPlaceLookupFunc(ctx, placeID)
+}
+
+// GeoSearch calls GeoSearchFunc
+func (f *Fake) GeoSearch(ctx context.Context, opts twitter.GeoSearchOpts) (*twitter.GeoSearchResponse, error) {
+	f.calls.record("GeoSearch", ctx, opts)
+	if f.GeoSearchFunc == nil {
+		return nil, notProgrammed("GeoSearch")
+	}
+	return f.GeoSearchFunc(ctx, opts)
+}
+
+// ListLookup calls ListLookupFunc
+func (f *Fake) ListLookup(ctx context.Context, listID string, opts twitter.ListLookupOpts) (*twitter.ListLookupResponse, error) {
+	f.calls.record("ListLookup", ctx, listID, opts)
+	if f.ListLookupFunc == nil {
+		return nil, notProgrammed("ListLookup")
+	}
+	return f.ListLookupFunc(ctx, listID, opts)
+}
+
+// ListTweetLookup calls ListTweetLookupFunc
+func (f *Fake) ListTweetLookup(ctx context.Context, listID string, opts twitter.ListTweetLookupOpts) (*twitter.ListTweetLookupResponse, error) {
+	f.calls.record("ListTweetLookup", ctx, listID, opts)
+	if f.ListTweetLookupFunc == nil {
+		return nil, notProgrammed("ListTweetLookup")
+	}
+	return f.ListTweetLookupFunc(ctx, listID, opts)
+}
+
+// ListUserFollowers calls ListUserFollowersFunc
+func (f *Fake) ListUserFollowers(ctx context.Context, listID string, opts twitter.ListUserFollowersOpts) (*twitter.ListUserFollowersResponse, error) {
+	f.calls.record("ListUserFollowers", ctx, listID, opts)
+	if f.ListUserFollowersFunc == nil {
+		return nil, notProgrammed("ListUserFollowers")
+	}
+	return f.ListUserFollowersFunc(ctx, listID, opts)
+}
+
+// ListUserMembers calls ListUserMembersFunc
+func (f *Fake) ListUserMembers(ctx context.Context, listID string, opts twitter.ListUserMembersOpts) (*twitter.ListUserMembersResponse, error) {
+	f.calls.record("ListUserMembers", ctx, listID, opts)
+	if f.ListUserMembersFunc == nil {
+		return nil, notProgrammed("ListUserMembers")
+	}
+	return f.ListUserMembersFunc(ctx, listID, opts)
+}
+
+// NotesEligiblePosts calls NotesEligiblePostsFunc
+func (f *Fake) NotesEligiblePosts(ctx context.Context, opts twitter.NotesEligiblePostsOpts) (*twitter.NotesEligiblePostsResponse, error) {
+	f.calls.record("NotesEligiblePosts", ctx, opts)
+	if f.NotesEligiblePostsFunc == nil {
+		return nil, notProgrammed("NotesEligiblePosts")
+	}
+	return f.NotesEligiblePostsFunc(ctx, opts)
+}
+
+// NotesWritten calls NotesWrittenFunc
+func (f *Fake) NotesWritten(ctx context.Context, opts twitter.NotesWrittenOpts) (*twitter.NotesWrittenResponse, error) {
+	f.calls.record("NotesWritten", ctx, opts)
+	if f.NotesWrittenFunc == nil {
+		return nil, notProgrammed("NotesWritten")
+	}
+	return f.NotesWrittenFunc(ctx, opts)
+}
+
+// ParseCreateTweetAsyncResponse calls ParseCreateTweetAsyncResponseFunc
+func (f *Fake) ParseCreateTweetAsyncResponse(resp *http.Response) (*twitter.CreateTweetResponse, error) {
+	f.calls.record("ParseCreateTweetAsyncResponse", resp)
+	if f.ParseCreateTweetAsyncResponseFunc == nil {
+		return nil, notProgrammed("ParseCreateTweetAsyncResponse")
+	}
+	return f.ParseCreateTweetAsyncResponseFunc(resp)
+}
+
+// ParseTweetLookupAsyncResponse calls ParseTweetLookupAsyncResponseFunc
+func (f *Fake) ParseTweetLookupAsyncResponse(ids []string, resp *http.Response) (*twitter.TweetLookupResponse, error) {
+	f.calls.record("ParseTweetLookupAsyncResponse", ids, resp)
+	if f.ParseTweetLookupAsyncResponseFunc == nil {
+		return nil, notProgrammed("ParseTweetLookupAsyncResponse")
+	}
+	return f.ParseTweetLookupAsyncResponseFunc(ids, resp)
+}
+
+// ParseTweetRecentSearchAsyncResponse calls ParseTweetRecentSearchAsyncResponseFunc
+func (f *Fake) ParseTweetRecentSearchAsyncResponse(resp *http.Response) (*twitter.TweetRecentSearchResponse, error) {
+	f.calls.record("ParseTweetRecentSearchAsyncResponse", resp)
+	if f.ParseTweetRecentSearchAsyncResponseFunc == nil {
+		return nil, notProgrammed("ParseTweetRecentSearchAsyncResponse")
+	}
+	return f.ParseTweetRecentSearchAsyncResponseFunc(resp)
+}
+
+// ParseUserLikesAsyncResponse calls ParseUserLikesAsyncResponseFunc
+func (f *Fake) ParseUserLikesAsyncResponse(resp *http.Response) (*twitter.UserLikesResponse, error) {
+	f.calls.record("ParseUserLikesAsyncResponse", resp)
+	if f.ParseUserLikesAsyncResponseFunc == nil {
+		return nil, notProgrammed("ParseUserLikesAsyncResponse")
+	}
+	return f.ParseUserLikesAsyncResponseFunc(resp)
+}
+
+// ParseUserNameLookupAsyncResponse calls ParseUserNameLookupAsyncResponseFunc
+func (f *Fake) ParseUserNameLookupAsyncResponse(usernames []string, resp *http.Response) (*twitter.UserLookupResponse, error) {
+	f.calls.record("ParseUserNameLookupAsyncResponse", usernames, resp)
+	if f.ParseUserNameLookupAsyncResponseFunc == nil {
+		return nil, notProgrammed("ParseUserNameLookupAsyncResponse")
+	}
+	return f.ParseUserNameLookupAsyncResponseFunc(usernames, resp)
+}
+
+// ParseUserTweetTimelineAsyncResponse calls ParseUserTweetTimelineAsyncResponseFunc
+func (f *Fake) ParseUserTweetTimelineAsyncResponse(resp *http.Response) (*twitter.UserTweetTimelineResponse, error) {
+	f.calls.record("ParseUserTweetTimelineAsyncResponse", resp)
+	if f.ParseUserTweetTimelineAsyncResponseFunc == nil {
+		return nil, notProgrammed("ParseUserTweetTimelineAsyncResponse")
+	}
+	return f.ParseUserTweetTimelineAsyncResponseFunc(resp)
+}
+
+// PersonalizedTrends calls PersonalizedTrendsFunc
+func (f *Fake) PersonalizedTrends(ctx context.Context, opts twitter.PersonalizedTrendsOpts) (*twitter.PersonalizedTrendsResponse, error) {
+	f.calls.record("PersonalizedTrends", ctx, opts)
+	if f.PersonalizedTrendsFunc == nil {
+		return nil, notProgrammed("PersonalizedTrends")
+	}
+	return f.PersonalizedTrendsFunc(ctx, opts)
+}
+
+// PostThread calls PostThreadFunc
+func (f *Fake) PostThread(ctx context.Context, tweets []twitter.CreateTweetRequest) (*twitter.PostThreadResponse, error) {
+	f.calls.record("PostThread", ctx, tweets)
+	if f.PostThreadFunc == nil {
+		return nil, notProgrammed("PostThread")
+	}
+	return f.PostThreadFunc(ctx, tweets)
+}
+
+// QuoteTweet calls QuoteTweetFunc
+func (f *Fake) QuoteTweet(ctx context.Context, targetID, text string, opts twitter.QuoteTweetOpts) (*twitter.CreateTweetResponse, error) {
+	f.calls.record("QuoteTweet", ctx, targetID, text, opts)
+	if f.QuoteTweetFunc == nil {
+		return nil, notProgrammed("QuoteTweet")
+	}
+	return f.QuoteTweetFunc(ctx, targetID, text, opts)
+}
+
+// QuoteTweetsLookup calls QuoteTweetsLookupFunc
+func (f *Fake) QuoteTweetsLookup(ctx context.Context, tweetID string, opts twitter.QuoteTweetsLookupOpts) (*twitter.QuoteTweetsLookupResponse, error) {
+	f.calls.record("QuoteTweetsLookup", ctx, tweetID, opts)
+	if f.QuoteTweetsLookupFunc == nil {
+		return nil, notProgrammed("QuoteTweetsLookup")
+	}
+	return f.QuoteTweetsLookupFunc(ctx, tweetID, opts)
+}
+
+// RateLimits calls RateLimitsFunc
+func (f *Fake) RateLimits() []twitter.EndpointRateLimit {
+	f.calls.record("RateLimits")
+	if f.RateLimitsFunc == nil {
+		return nil
+	}
+	return f.RateLimitsFunc()
+}
+
+// RefreshPoll calls RefreshPollFunc
+func (f *Fake) RefreshPoll(ctx context.Context, tweetID string) (*twitter.TweetPollResponse, error) {
+	f.calls.record("RefreshPoll", ctx, tweetID)
+	if f.RefreshPollFunc == nil {
+		return nil, notProgrammed("RefreshPoll")
+	}
+	return f.RefreshPollFunc(ctx, tweetID)
+}
+
+// RemoveListMember calls RemoveListMemberFunc
+func (f *Fake) RemoveListMember(ctx context.Context, listID string, userID string) (*twitter.ListRemoveMemberResponse, error) {
+	f.calls.record("RemoveListMember", ctx, listID, userID)
+	if f.RemoveListMemberFunc == nil {
+		return nil, notProgrammed("RemoveListMember")
+	}
+	return f.RemoveListMemberFunc(ctx, listID, userID)
+}
+
+// RemoveTweetBookmark calls RemoveTweetBookmarkFunc
+func (f *Fake) RemoveTweetBookmark(ctx context.Context, userID string, tweetID string) (*twitter.RemoveTweetBookmarkResponse, error) {
+	f.calls.record("RemoveTweetBookmark", ctx, userID, tweetID)
+	if f.RemoveTweetBookmarkFunc == nil {
+		return nil, notProgrammed("RemoveTweetBookmark")
+	}
+	return f.RemoveTweetBookmarkFunc(ctx, userID, tweetID)
+}
+
+// ResolveEntities calls ResolveEntitiesFunc
+func (f *Fake) ResolveEntities(ctx context.Context, inputs []string, opts twitter.ResolveEntitiesOpts) (*twitter.ResolveEntitiesResponse, error) {
+	f.calls.record("ResolveEntities", ctx, inputs, opts)
+	if f.ResolveEntitiesFunc == nil {
+		return nil, notProgrammed("ResolveEntities")
+	}
+	return f.ResolveEntitiesFunc(ctx, inputs, opts)
+}
+
+// RetagStreamRules calls RetagStreamRulesFunc
+func (f *Fake) RetagStreamRules(ctx context.Context, oldTag string, newTag string) (*twitter.RetagStreamRulesResponse, error) {
+	f.calls.record("RetagStreamRules", ctx, oldTag, newTag)
+	if f.RetagStreamRulesFunc == nil {
+		return nil, notProgrammed("RetagStreamRules")
+	}
+	return f.RetagStreamRulesFunc(ctx, oldTag, newTag)
+}
+
+// SearchTweets calls SearchTweetsFunc
+func (f *Fake) SearchTweets(ctx context.Context, query string, opts twitter.TweetSearchOpts) (*twitter.TweetSearchPage, error) {
+	f.calls.record("SearchTweets", ctx, query, opts)
+	if f.SearchTweetsFunc == nil {
+		return nil, notProgrammed("SearchTweets")
+	}
+	return f.SearchTweetsFunc(ctx, query, opts)
+}
+
+// SeedTweetCap calls SeedTweetCapFunc
+func (f *Fake) SeedTweetCap(ctx context.Context) (twitter.TweetCapUsage, error) {
+	f.calls.record("SeedTweetCap", ctx)
+	if f.SeedTweetCapFunc == nil {
+		return twitter.TweetCapUsage{}, notProgrammed("SeedTweetCap")
+	}
+	return f.SeedTweetCapFunc(ctx)
+}
+
+// SendDMToConversation calls SendDMToConversationFunc
+func (f *Fake) SendDMToConversation(ctx context.Context, conversationID string, message twitter.CreateDMMessage) (*twitter.CreateDMEventResponse, error) {
+	f.calls.record("SendDMToConversation", ctx, conversationID, message)
+	if f.SendDMToConversationFunc == nil {
+		return nil, notProgrammed("SendDMToConversation")
+	}
+	return f.SendDMToConversationFunc(ctx, conversationID, message)
+}
+
+// SendDMToParticipant calls SendDMToParticipantFunc
+func (f *Fake) SendDMToParticipant(ctx context.Context, participantID string, message twitter.CreateDMMessage) (*twitter.CreateDMEventResponse, error) {
+	f.calls.record("SendDMToParticipant", ctx, participantID, message)
+	if f.SendDMToParticipantFunc == nil {
+		return nil, notProgrammed("SendDMToParticipant")
+	}
+	return f.SendDMToParticipantFunc(ctx, participantID, message)
+}
+
+// SpaceBuyersLookup calls SpaceBuyersLookupFunc
+func (f *Fake) SpaceBuyersLookup(ctx context.Context, spaceID string, opts twitter.SpaceBuyersLookupOpts) (*twitter.SpaceBuyersLookupResponse, error) {
+	f.calls.record("SpaceBuyersLookup", ctx, spaceID, opts)
+	if f.SpaceBuyersLookupFunc == nil {
+		return nil, notProgrammed("SpaceBuyersLookup")
+	}
+	return f.SpaceBuyersLookupFunc(ctx, spaceID, opts)
+}
+
+// SpaceTweetsLookup calls SpaceTweetsLookupFunc
+func (f *Fake) SpaceTweetsLookup(ctx context.Context, spaceID string, opts twitter.SpaceTweetsLookupOpts) (*twitter.SpaceTweetsLookupResponse, error) {
+	f.calls.record("SpaceTweetsLookup", ctx, spaceID, opts)
+	if f.SpaceTweetsLookupFunc == nil {
+		return nil, notProgrammed("SpaceTweetsLookup")
+	}
+	return f.SpaceTweetsLookupFunc(ctx, spaceID, opts)
+}
+
+// SpacesByCreatorLookup calls SpacesByCreatorLookupFunc
+func (f *Fake) SpacesByCreatorLookup(ctx context.Context, userIDs []string, opts twitter.SpacesByCreatorLookupOpts) (*twitter.SpacesByCreatorLookupResponse, error) {
+	f.calls.record("SpacesByCreatorLookup", ctx, userIDs, opts)
+	if f.SpacesByCreatorLookupFunc == nil {
+		return nil, notProgrammed("SpacesByCreatorLookup")
+	}
+	return f.SpacesByCreatorLookupFunc(ctx, userIDs, opts)
+}
+
+// SpacesLookup calls SpacesLookupFunc
+func (f *Fake) SpacesLookup(ctx context.Context, ids []string, opts twitter.SpacesLookupOpts) (*twitter.SpacesLookupResponse, error) {
+	f.calls.record("SpacesLookup", ctx, ids, opts)
+	if f.SpacesLookupFunc == nil {
+		return nil, notProgrammed("SpacesLookup")
+	}
+	return f.SpacesLookupFunc(ctx, ids, opts)
+}
+
+// SpacesSearch calls SpacesSearchFunc
+func (f *Fake) SpacesSearch(ctx context.Context, query string, opts twitter.SpacesSearchOpts) (*twitter.SpacesSearchResponse, error) {
+	f.calls.record("SpacesSearch", ctx, query, opts)
+	if f.SpacesSearchFunc == nil {
+		return nil, notProgrammed("SpacesSearch")
+	}
+	return f.SpacesSearchFunc(ctx, query, opts)
+}
+
+// SyncListMembers calls SyncListMembersFunc
+func (f *Fake) SyncListMembers(ctx context.Context, listID string, userIDs []string) (*twitter.SyncListMembersResponse, error) {
+	f.calls.record("SyncListMembers", ctx, listID, userIDs)
+	if f.SyncListMembersFunc == nil {
+		return nil, notProgrammed("SyncListMembers")
+	}
+	return f.SyncListMembersFunc(ctx, listID, userIDs)
+}
+
+// TrendsByWOEID calls TrendsByWOEIDFunc
+func (f *Fake) TrendsByWOEID(ctx context.Context, woeid int, opts twitter.TrendsByWOEIDOpts) (*twitter.TrendsByWOEIDResponse, error) {
+	f.calls.record("TrendsByWOEID", ctx, woeid, opts)
+	if f.TrendsByWOEIDFunc == nil {
+		return nil, notProgrammed("TrendsByWOEID")
+	}
+	return f.TrendsByWOEIDFunc(ctx, woeid, opts)
+}
+
+// TriggerAccountActivityCRC calls TriggerAccountActivityCRCFunc
+func (f *Fake) TriggerAccountActivityCRC(ctx context.Context, env string, webhookID string) (*twitter.AccountActivityResponse, error) {
+	f.calls.record("TriggerAccountActivityCRC", ctx, env, webhookID)
+	if f.TriggerAccountActivityCRCFunc == nil {
+		return nil, notProgrammed("TriggerAccountActivityCRC")
+	}
+	return f.TriggerAccountActivityCRCFunc(ctx, env, webhookID)
+}
+
+// TweetAllCounts calls TweetAllCountsFunc
+func (f *Fake) TweetAllCounts(ctx context.Context, query string, opts twitter.TweetAllCountsOpts) (*twitter.TweetAllCountsResponse, error) {
+	f.calls.record("TweetAllCounts", ctx, query, opts)
+	if f.TweetAllCountsFunc == nil {
+		return nil, notProgrammed("TweetAllCounts")
+	}
+	return f.TweetAllCountsFunc(ctx, query, opts)
+}
+
+// TweetBookmarkFolderLookup calls TweetBookmarkFolderLookupFunc
+func (f *Fake) TweetBookmarkFolderLookup(ctx context.Context, userID, folderID string, opts twitter.TweetBookmarksLookupOpts) (*twitter.TweetBookmarksLookupResponse, error) {
+	f.calls.record("TweetBookmarkFolderLookup", ctx, userID, folderID, opts)
+	if f.TweetBookmarkFolderLookupFunc == nil {
+		return nil, notProgrammed("TweetBookmarkFolderLookup")
+	}
+	return f.TweetBookmarkFolderLookupFunc(ctx, userID, folderID, opts)
+}
+
+// TweetBookmarkFolders calls TweetBookmarkFoldersFunc
+func (f *Fake) TweetBookmarkFolders(ctx context.Context, userID string, opts twitter.TweetBookmarkFoldersOpts) (*twitter.TweetBookmarkFoldersResponse, error) {
+	f.calls.record("TweetBookmarkFolders", ctx, userID, opts)
+	if f.TweetBookmarkFoldersFunc == nil {
+		return nil, notProgrammed("TweetBookmarkFolders")
+	}
+	return f.TweetBookmarkFoldersFunc(ctx, userID, opts)
+}
+
+// TweetBookmarksLookup calls TweetBookmarksLookupFunc
+func (f *Fake) TweetBookmarksLookup(ctx context.Context, userID string, opts twitter.TweetBookmarksLookupOpts) (*twitter.TweetBookmarksLookupResponse, error) {
+	f.calls.record("TweetBookmarksLookup", ctx, userID, opts)
+	if f.TweetBookmarksLookupFunc == nil {
+		return nil, notProgrammed("TweetBookmarksLookup")
+	}
+	return f.TweetBookmarksLookupFunc(ctx, userID, opts)
+}
+
+// TweetEditHistory calls TweetEditHistoryFunc
+func (f *Fake) TweetEditHistory(ctx context.Context, id string, opts twitter.TweetLookupOpts) (*twitter.TweetEditHistoryResponse, error) {
+	f.calls.record("TweetEditHistory", ctx, id, opts)
+	if f.TweetEditHistoryFunc == nil {
+		return nil, notProgrammed("TweetEditHistory")
+	}
+	return f.TweetEditHistoryFunc(ctx, id, opts)
+}
+
+// TweetHideReplies calls TweetHideRepliesFunc
+func (f *Fake) TweetHideReplies(ctx context.Context, id string, hide bool) (*twitter.TweetHideReplyResponse, error) {
+	f.calls.record("TweetHideReplies", ctx, id, hide)
+	if f.TweetHideRepliesFunc == nil {
+		return nil, notProgrammed("TweetHideReplies")
+	}
+	return f.TweetHideRepliesFunc(ctx, id, hide)
+}
+
+// TweetLikesLookup calls TweetLikesLookupFunc
+func (f *Fake) TweetLikesLookup(ctx context.Context, tweetID string, opts twitter.TweetLikesLookupOpts) (*twitter.TweetLikesLookupResponse, error) {
+	f.calls.record("TweetLikesLookup", ctx, tweetID, opts)
+	if f.TweetLikesLookupFunc == nil {
+		return nil, notProgrammed("TweetLikesLookup")
+	}
+	return f.TweetLikesLookupFunc(ctx, tweetID, opts)
+}
+
+// TweetLookup calls TweetLookupFunc
+func (f *Fake) TweetLookup(ctx context.Context, ids []string, opts twitter.TweetLookupOpts) (*twitter.TweetLookupResponse, error) {
+	f.calls.record("TweetLookup", ctx, ids, opts)
+	if f.TweetLookupFunc == nil {
+		return nil, notProgrammed("TweetLookup")
+	}
+	return f.TweetLookupFunc(ctx, ids, opts)
+}
+
+// TweetLookupAsync calls TweetLookupAsyncFunc
+func (f *Fake) TweetLookupAsync(ctx context.Context, ids []string, opts twitter.TweetLookupOpts) (*twitter.TweetLookupAsyncResponse, error) {
+	f.calls.record("TweetLookupAsync", ctx, ids, opts)
+	if f.TweetLookupAsyncFunc == nil {
+		return nil, notProgrammed("TweetLookupAsync")
+	}
+	return f.TweetLookupAsyncFunc(ctx, ids, opts)
+}
+
+// TweetMetricsLookup calls TweetMetricsLookupFunc
+func (f *Fake) TweetMetricsLookup(ctx context.Context, ids []string, opts twitter.TweetLookupOpts) (*twitter.TweetLookupResponse, error) {
+	f.calls.record("TweetMetricsLookup", ctx, ids, opts)
+	if f.TweetMetricsLookupFunc == nil {
+		return nil, notProgrammed("TweetMetricsLookup")
+	}
+	return f.TweetMetricsLookupFunc(ctx, ids, opts)
+}
+
+// TweetRecentCounts calls TweetRecentCountsFunc
+func (f *Fake) TweetRecentCounts(ctx context.Context, query string, opts twitter.TweetRecentCountsOpts) (*twitter.TweetRecentCountsResponse, error) {
+	f.calls.record("TweetRecentCounts", ctx, query, opts)
+	if f.TweetRecentCountsFunc == nil {
+		return nil, notProgrammed("TweetRecentCounts")
+	}
+	return f.TweetRecentCountsFunc(ctx, query, opts)
+}
+
+// TweetRecentSearch calls TweetRecentSearchFunc
+func (f *Fake) TweetRecentSearch(ctx context.Context, query string, opts twitter.TweetRecentSearchOpts) (*twitter.TweetRecentSearchResponse, error) {
+	f.calls.record("TweetRecentSearch", ctx, query, opts)
+	if f.TweetRecentSearchFunc == nil {
+		return nil, notProgrammed("TweetRecentSearch")
+	}
+	return f.TweetRecentSearchFunc(ctx, query, opts)
+}
+
+// TweetRecentSearchAsync calls TweetRecentSearchAsyncFunc
+func (f *Fake) TweetRecentSearchAsync(ctx context.Context, query string, opts twitter.TweetRecentSearchOpts) (*twitter.TweetRecentSearchAsyncResponse, error) {
+	f.calls.record("TweetRecentSearchAsync", ctx, query, opts)
+	if f.TweetRecentSearchAsyncFunc == nil {
+		return nil, notProgrammed("TweetRecentSearchAsync")
+	}
+	return f.TweetRecentSearchAsyncFunc(ctx, query, opts)
+}
+
+// TweetSampleStream calls TweetSampleStreamFunc
+func (f *Fake) TweetSampleStream(ctx context.Context, opts twitter.TweetSampleStreamOpts) (*twitter.TweetStream, error) {
+	f.calls.record("TweetSampleStream", ctx, opts)
+	if f.TweetSampleStreamFunc == nil {
+		return nil, notProgrammed("TweetSampleStream")
+	}
+	return f.TweetSampleStreamFunc(ctx, opts)
+}
+
+// TweetSearch calls TweetSearchFunc
+func (f *Fake) TweetSearch(ctx context.Context, query string, opts twitter.TweetSearchOpts) (*twitter.TweetSearchResponse, error) {
+	f.calls.record("TweetSearch", ctx, query, opts)
+	if f.TweetSearchFunc == nil {
+		return nil, notProgrammed("TweetSearch")
+	}
+	return f.TweetSearchFunc(ctx, query, opts)
+}
+
+// TweetSearchStream calls TweetSearchStreamFunc
+func (f *Fake) TweetSearchStream(ctx context.Context, opts twitter.TweetSearchStreamOpts) (*twitter.TweetStream, error) {
+	f.calls.record("TweetSearchStream", ctx, opts)
+	if f.TweetSearchStreamFunc == nil {
+		return nil, notProgrammed("TweetSearchStream")
+	}
+	return f.TweetSearchStreamFunc(ctx, opts)
+}
+
+// TweetSearchStreamAddRule calls TweetSearchStreamAddRuleFunc
+func (f *Fake) TweetSearchStreamAddRule(ctx context.Context, rules []twitter.TweetSearchStreamRule, dryRun bool) (*twitter.TweetSearchStreamAddRuleResponse, error) {
+	f.calls.record("TweetSearchStreamAddRule", ctx, rules, dryRun)
+	if f.TweetSearchStreamAddRuleFunc == nil {
+		return nil, notProgrammed("TweetSearchStreamAddRule")
+	}
+	return f.TweetSearchStreamAddRuleFunc(ctx, rules, dryRun)
+}
+
+// TweetSearchStreamDeleteRuleByID calls TweetSearchStreamDeleteRuleByIDFunc
+func (f *Fake) TweetSearchStreamDeleteRuleByID(ctx context.Context, ruleIDs []twitter.TweetSearchStreamRuleID, dryRun bool) (*twitter.TweetSearchStreamDeleteRuleResponse, error) {
+	f.calls.record("TweetSearchStreamDeleteRuleByID", ctx, ruleIDs, dryRun)
+	if f.TweetSearchStreamDeleteRuleByIDFunc == nil {
+		return nil, notProgrammed("TweetSearchStreamDeleteRuleByID")
+	}
+	return f.TweetSearchStreamDeleteRuleByIDFunc(ctx, ruleIDs, dryRun)
+}
+
+// TweetSearchStreamDeleteRuleByValue calls TweetSearchStreamDeleteRuleByValueFunc
+func (f *Fake) TweetSearchStreamDeleteRuleByValue(ctx context.Context, ruleValues []string, dryRun bool) (*twitter.TweetSearchStreamDeleteRuleResponse, error) {
+	f.calls.record("TweetSearchStreamDeleteRuleByValue", ctx, ruleValues, dryRun)
+	if f.TweetSearchStreamDeleteRuleByValueFunc == nil {
+		return nil, notProgrammed("TweetSearchStreamDeleteRuleByValue")
+	}
+	return f.TweetSearchStreamDeleteRuleByValueFunc(ctx, ruleValues, dryRun)
+}
+
+// TweetSearchStreamDeleteRulesByTag calls TweetSearchStreamDeleteRulesByTagFunc
+func (f *Fake) TweetSearchStreamDeleteRulesByTag(ctx context.Context, tags []string, dryRun bool) (*twitter.TweetSearchStreamDeleteRuleResponse, error) {
+	f.calls.record("TweetSearchStreamDeleteRulesByTag", ctx, tags, dryRun)
+	if f.TweetSearchStreamDeleteRulesByTagFunc == nil {
+		return nil, notProgrammed("TweetSearchStreamDeleteRulesByTag")
+	}
+	return f.TweetSearchStreamDeleteRulesByTagFunc(ctx, tags, dryRun)
+}
+
+// TweetSearchStreamRules calls TweetSearchStreamRulesFunc
+func (f *Fake) TweetSearchStreamRules(ctx context.Context, ruleIDs []twitter.TweetSearchStreamRuleID) (*twitter.TweetSearchStreamRulesResponse, error) {
+	f.calls.record("TweetSearchStreamRules", ctx, ruleIDs)
+	if f.TweetSearchStreamRulesFunc == nil {
+		return nil, notProgrammed("TweetSearchStreamRules")
+	}
+	return f.TweetSearchStreamRulesFunc(ctx, ruleIDs)
+}
+
+// TweetUsage calls TweetUsageFunc
+func (f *Fake) TweetUsage(ctx context.Context, opts twitter.TweetUsageOpts) (*twitter.TweetUsageResponse, error) {
+	f.calls.record("TweetUsage", ctx, opts)
+	if f.TweetUsageFunc == nil {
+		return nil, notProgrammed("TweetUsage")
+	}
+	return f.TweetUsageFunc(ctx, opts)
+}
+
+// UpdateList calls UpdateListFunc
+func (f *Fake) UpdateList(ctx context.Context, listID string, update twitter.ListMetaData) (*twitter.ListUpdateResponse, error) {
+	f.calls.record("UpdateList", ctx, listID, update)
+	if f.UpdateListFunc == nil {
+		return nil, notProgrammed("UpdateList")
+	}
+	return f.UpdateListFunc(ctx, listID, update)
+}
+
+// UserBlocks calls UserBlocksFunc
+func (f *Fake) UserBlocks(ctx context.Context, userID string, targetUserID string) (*twitter.UserBlocksResponse, error) {
+	f.calls.record("UserBlocks", ctx, userID, targetUserID)
+	if f.UserBlocksFunc == nil {
+		return nil, notProgrammed("UserBlocks")
+	}
+	return f.UserBlocksFunc(ctx, userID, targetUserID)
+}
+
+// UserBlocksLookup calls UserBlocksLookupFunc
+func (f *Fake) UserBlocksLookup(ctx context.Context, userID string, opts twitter.UserBlocksLookupOpts) (*twitter.UserBlocksLookupResponse, error) {
+	f.calls.record("UserBlocksLookup", ctx, userID, opts)
+	if f.UserBlocksLookupFunc == nil {
+		return nil, notProgrammed("UserBlocksLookup")
+	}
+	return f.UserBlocksLookupFunc(ctx, userID, opts)
+}
+
+// UserFollowList calls UserFollowListFunc
+func (f *Fake) UserFollowList(ctx context.Context, userID string, listID string) (*twitter.UserFollowListResponse, error) {
+	f.calls.record("UserFollowList", ctx, userID, listID)
+	if f.UserFollowListFunc == nil {
+		return nil, notProgrammed("UserFollowList")
+	}
+	return f.UserFollowListFunc(ctx, userID, listID)
+}
+
+// UserFollowedLists calls UserFollowedListsFunc
+func (f *Fake) UserFollowedLists(ctx context.Context, userID string, opts twitter.UserFollowedListsOpts) (*twitter.UserFollowedListsResponse, error) {
+	f.calls.record("UserFollowedLists", ctx, userID, opts)
+	if f.UserFollowedListsFunc == nil {
+		return nil, notProgrammed("UserFollowedLists")
+	}
+	return f.UserFollowedListsFunc(ctx, userID, opts)
+}
+
+// UserFollowersLookup calls UserFollowersLookupFunc
+func (f *Fake) UserFollowersLookup(ctx context.Context, id string, opts twitter.UserFollowersLookupOpts) (*twitter.UserFollowersLookupResponse, error) {
+	f.calls.record("UserFollowersLookup", ctx, id, opts)
+	if f.UserFollowersLookupFunc == nil {
+		return nil, notProgrammed("UserFollowersLookup")
+	}
+	return f.UserFollowersLookupFunc(ctx, id, opts)
+}
+
+// UserFollowingLookup calls UserFollowingLookupFunc
+func (f *Fake) UserFollowingLookup(ctx context.Context, id string, opts twitter.UserFollowingLookupOpts) (*twitter.UserFollowingLookupResponse, error) {
+	f.calls.record("UserFollowingLookup", ctx, id, opts)
+	if f.UserFollowingLookupFunc == nil {
+		return nil, notProgrammed("UserFollowingLookup")
+	}
+	return f.UserFollowingLookupFunc(ctx, id, opts)
+}
+
+// UserFollows calls UserFollowsFunc
+func (f *Fake) UserFollows(ctx context.Context, userID string, targetUserID string) (*twitter.UserFollowsResponse, error) {
+	f.calls.record("UserFollows", ctx, userID, targetUserID)
+	if f.UserFollowsFunc == nil {
+		return nil, notProgrammed("UserFollows")
+	}
+	return f.UserFollowsFunc(ctx, userID, targetUserID)
+}
+
+// UserLikes calls UserLikesFunc
+func (f *Fake) UserLikes(ctx context.Context, userID string, tweetID string) (*twitter.UserLikesResponse, error) {
+	f.calls.record("UserLikes", ctx, userID, tweetID)
+	if f.UserLikesFunc == nil {
+		return nil, notProgrammed("UserLikes")
+	}
+	return f.UserLikesFunc(ctx, userID, tweetID)
+}
+
+// UserLikesAsync calls UserLikesAsyncFunc
+func (f *Fake) UserLikesAsync(ctx context.Context, userID string, tweetID string) (*twitter.UserLikesAsyncResponse, error) {
+	f.calls.record("UserLikesAsync", ctx, userID, tweetID)
+	if f.UserLikesAsyncFunc == nil {
+		return nil, notProgrammed("UserLikesAsync")
+	}
+	return f.UserLikesAsyncFunc(ctx, userID, tweetID)
+}
+
+// UserLikesLookup calls UserLikesLookupFunc
+func (f *Fake) UserLikesLookup(ctx context.Context, userID string, opts twitter.UserLikesLookupOpts) (*twitter.UserLikesLookupResponse, error) {
+	f.calls.record("UserLikesLookup", ctx, userID, opts)
+	if f.UserLikesLookupFunc == nil {
+		return nil, notProgrammed("UserLikesLookup")
+	}
+	return f.UserLikesLookupFunc(ctx, userID, opts)
+}
+
+// UserListLookup calls UserListLookupFunc
+func (f *Fake) UserListLookup(ctx context.Context, userID string, opts twitter.UserListLookupOpts) (*twitter.UserListLookupResponse, error) {
+	f.calls.record("UserListLookup", ctx, userID, opts)
+	if f.UserListLookupFunc == nil {
+		return nil, notProgrammed("UserListLookup")
+	}
+	return f.UserListLookupFunc(ctx, userID, opts)
+}
+
+// UserListMemberships calls UserListMembershipsFunc
+func (f *Fake) UserListMemberships(ctx context.Context, userID string, opts twitter.UserListMembershipsOpts) (*twitter.UserListMembershipsResponse, error) {
+	f.calls.record("UserListMemberships", ctx, userID, opts)
+	if f.UserListMembershipsFunc == nil {
+		return nil, notProgrammed("UserListMemberships")
+	}
+	return f.UserListMembershipsFunc(ctx, userID, opts)
+}
+
+// UserLookup calls UserLookupFunc
+func (f *Fake) UserLookup(ctx context.Context, ids []string, opts twitter.UserLookupOpts) (*twitter.UserLookupResponse, error) {
+	f.calls.record("UserLookup", ctx, ids, opts)
+	if f.UserLookupFunc == nil {
+		return nil, notProgrammed("UserLookup")
+	}
+	return f.UserLookupFunc(ctx, ids, opts)
+}
+
+// UserMentionTimeline calls UserMentionTimelineFunc
+func (f *Fake) UserMentionTimeline(ctx context.Context, userID string, opts twitter.UserMentionTimelineOpts) (*twitter.UserMentionTimelineResponse, error) {
+	f.calls.record("UserMentionTimeline", ctx, userID, opts)
+	if f.UserMentionTimelineFunc == nil {
+		return nil, notProgrammed("UserMentionTimeline")
+	}
+	return f.UserMentionTimelineFunc(ctx, userID, opts)
+}
+
+// UserMutes calls UserMutesFunc
+func (f *Fake) UserMutes(ctx context.Context, userID string, targetUserID string) (*twitter.UserMutesResponse, error) {
+	f.calls.record("UserMutes", ctx, userID, targetUserID)
+	if f.UserMutesFunc == nil {
+		return nil, notProgrammed("UserMutes")
+	}
+	return f.UserMutesFunc(ctx, userID, targetUserID)
+}
+
+// UserMutesLookup calls UserMutesLookupFunc
+func (f *Fake) UserMutesLookup(ctx context.Context, userID string, opts twitter.UserMutesLookupOpts) (*twitter.UserMutesLookupResponse, error) {
+	f.calls.record("UserMutesLookup", ctx, userID, opts)
+	if f.UserMutesLookupFunc == nil {
+		return nil, notProgrammed("UserMutesLookup")
+	}
+	return f.UserMutesLookupFunc(ctx, userID, opts)
+}
+
+// UserNameLookup calls UserNameLookupFunc
+func (f *Fake) UserNameLookup(ctx context.Context, usernames []string, opts twitter.UserLookupOpts) (*twitter.UserLookupResponse, error) {
+	f.calls.record("UserNameLookup", ctx, usernames, opts)
+	if f.UserNameLookupFunc == nil {
+		return nil, notProgrammed("UserNameLookup")
+	}
+	return f.UserNameLookupFunc(ctx, usernames, opts)
+}
+
+// UserNameLookupAsync calls UserNameLookupAsyncFunc
+func (f *Fake) UserNameLookupAsync(ctx context.Context, usernames []string, opts twitter.UserLookupOpts) (*twitter.UserNameLookupAsyncResponse, error) {
+	f.calls.record("UserNameLookupAsync", ctx, usernames, opts)
+	if f.UserNameLookupAsyncFunc == nil {
+		return nil, notProgrammed("UserNameLookupAsync")
+	}
+	return f.UserNameLookupAsyncFunc(ctx, usernames, opts)
+}
+
+// UserPinList calls UserPinListFunc
+func (f *Fake) UserPinList(ctx context.Context, userID string, listID string) (*twitter.UserPinListResponse, error) {
+	f.calls.record("UserPinList", ctx, userID, listID)
+	if f.UserPinListFunc == nil {
+		return nil, notProgrammed("UserPinList")
+	}
+	return f.UserPinListFunc(ctx, userID, listID)
+}
+
+// UserPinnedLists calls UserPinnedListsFunc
+func (f *Fake) UserPinnedLists(ctx context.Context, userID string, opts twitter.UserPinnedListsOpts) (*twitter.UserPinnedListsResponse, error) {
+	f.calls.record("UserPinnedLists", ctx, userID, opts)
+	if f.UserPinnedListsFunc == nil {
+		return nil, notProgrammed("UserPinnedLists")
+	}
+	return f.UserPinnedListsFunc(ctx, userID, opts)
+}
+
+// UserRetweet calls UserRetweetFunc
+func (f *Fake) UserRetweet(ctx context.Context, userID string, tweetID string) (*twitter.UserRetweetResponse, error) {
+	f.calls.record("UserRetweet", ctx, userID, tweetID)
+	if f.UserRetweetFunc == nil {
+		return nil, notProgrammed("UserRetweet")
+	}
+	return f.UserRetweetFunc(ctx, userID, tweetID)
+}
+
+// UserRetweetLookup calls UserRetweetLookupFunc
+func (f *Fake) UserRetweetLookup(ctx context.Context, tweetID string, opts twitter.UserRetweetLookupOpts) (*twitter.UserRetweetLookupResponse, error) {
+	f.calls.record("UserRetweetLookup", ctx, tweetID, opts)
+	if f.UserRetweetLookupFunc == nil {
+		return nil, notProgrammed("UserRetweetLookup")
+	}
+	return f.UserRetweetLookupFunc(ctx, tweetID, opts)
+}
+
+// UserTweetReverseChronologicalTimeline calls UserTweetReverseChronologicalTimelineFunc
+func (f *Fake) UserTweetReverseChronologicalTimeline(ctx context.Context, userID string, opts twitter.UserTweetReverseChronologicalTimelineOpts) (*twitter.UserTweetReverseChronologicalTimelineResponse, error) {
+	f.calls.record("UserTweetReverseChronologicalTimeline", ctx, userID, opts)
+	if f.UserTweetReverseChronologicalTimelineFunc == nil {
+		return nil, notProgrammed("UserTweetReverseChronologicalTimeline")
+	}
+	return f.UserTweetReverseChronologicalTimelineFunc(ctx, userID, opts)
+}
+
+// UserTweetTimeline calls UserTweetTimelineFunc
+func (f *Fake) UserTweetTimeline(ctx context.Context, userID string, opts twitter.UserTweetTimelineOpts) (*twitter.UserTweetTimelineResponse, error) {
+	f.calls.record("UserTweetTimeline", ctx, userID, opts)
+	if f.UserTweetTimelineFunc == nil {
+		return nil, notProgrammed("UserTweetTimeline")
+	}
+	return f.UserTweetTimelineFunc(ctx, userID, opts)
+}
+
+// UserTweetTimelineAsync calls UserTweetTimelineAsyncFunc
+func (f *Fake) UserTweetTimelineAsync(ctx context.Context, userID string, opts twitter.UserTweetTimelineOpts) (*twitter.UserTweetTimelineAsyncResponse, error) {
+	f.calls.record("UserTweetTimelineAsync", ctx, userID, opts)
+	if f.UserTweetTimelineAsyncFunc == nil {
+		return nil, notProgrammed("UserTweetTimelineAsync")
+	}
+	return f.UserTweetTimelineAsyncFunc(ctx, userID, opts)
+}
+
+// UserUnfollowList calls UserUnfollowListFunc
+func (f *Fake) UserUnfollowList(ctx context.Context, userID string, listID string) (*twitter.UserUnfollowListResponse, error) {
+	f.calls.record("UserUnfollowList", ctx, userID, listID)
+	if f.UserUnfollowListFunc == nil {
+		return nil, notProgrammed("UserUnfollowList")
+	}
+	return f.UserUnfollowListFunc(ctx, userID, listID)
+}
+
+// UserUnpinList calls UserUnpinListFunc
+func (f *Fake) UserUnpinList(ctx context.Context, userID string, listID string) (*twitter.UserUnpinListResponse, error) {
+	f.calls.record("UserUnpinList", ctx, userID, listID)
+	if f.UserUnpinListFunc == nil {
+		return nil, notProgrammed("UserUnpinList")
+	}
+	return f.UserUnpinListFunc(ctx, userID, listID)
+}
@@ -0,0 +1,164 @@
+package twittertest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+// The canned response bodies of common endpoints
+const (
+	// SearchPage is a recent search response with a next token and the author expansion
+	SearchPage = `{
+	"data": [
+		{
+			"id": "1495979553889697792",
+			"text": "Tweeting with the #golang twitter client",
+			"author_id": "2244994945",
+			"created_at": "2022-02-22T04:12:02.000Z"
+		},
+		{
+			"id": "1495979553889697791",
+			"text": "Another #golang tweet",
+			"author_id": "2244994945",
+			"created_at": "2022-02-22T04:10:02.000Z"
+		}
+	],
+	"includes": {
+		"users": [
+			{
+				"id": "2244994945",
+				"name": "Twitter Dev",
+				"username": "TwitterDev"
+			}
+		]
+	},
+	"meta": {
+		"newest_id": "1495979553889697792",
+		"oldest_id": "1495979553889697791",
+		"result_count": 2,
+		"next_token": "b26v89c19zqg8o3fpywkopbo3ikomaa0yvq1u4ya3jolp"
+	}
+}`
+	// LastSearchPage is a recent search response without a next token
+	LastSearchPage = `{
+	"data": [
+		{
+			"id": "1495979553889697790",
+			"text": "The first #golang tweet",
+			"author_id": "2244994945",
+			"created_at": "2022-02-22T04:08:02.000Z"
+		}
+	],
+	"meta": {
+		"newest_id": "1495979553889697790",
+		"oldest_id": "1495979553889697790",
+		"result_count": 1
+	}
+}`
+	// UserLookup is a user lookup response
+	UserLookup = `{
+	"data": {
+		"id": "2244994945",
+		"name": "Twitter Dev",
+		"username": "TwitterDev",
+		"created_at": "2013-12-14T04:35:55.000Z"
+	}
+}`
+	// StreamMessage is a filtered stream message, the messages are separated by a carriage return and new line
+	StreamMessage = `{"data":{"id":"1495979553889697792","text":"Tweeting with the #golang twitter client","author_id":"2244994945"},"includes":{"users":[{"id":"2244994945","name":"Twitter Dev","username":"TwitterDev"}]},"matching_rules":[{"id":"1495978917425332225","tag":"golang"}]}` + "\r\n"
+	// StreamKeepAlive is the blank line the stream sends to keep the connection open
+	StreamKeepAlive = "\r\n"
+	// PartialErrors is a tweet lookup response where one of the tweets was not found
+	PartialErrors = `{
+	"data": [
+		{
+			"id": "1495979553889697792",
+			"text": "Tweeting with the #golang twitter client"
+		}
+	],
+	"errors": [
+		{
+			"value": "1",
+			"detail": "Could not find tweet with ids: [1].",
+			"title": "Not Found Error",
+			"resource_type": "tweet",
+			"parameter": "ids",
+			"resource_id": "1",
+			"type": "https://api.twitter.com/2/problems/resource-not-found"
+		}
+	]
+}`
+	// ErrorUnauthorized is the body of a 401 response
+	ErrorUnauthorized = `{
+	"title": "Unauthorized",
+	"type": "about:blank",
+	"status": 401,
+	"detail": "Unauthorized"
+}`
+	// ErrorTooManyRequests is the body of a 429 response
+	ErrorTooManyRequests = `{
+	"title": "Too Many Requests",
+	"type": "about:blank",
+	"status": 429,
+	"detail": "Too Many Requests"
+}`
+	// ErrorInvalidRequest is the body of a 400 response
+	ErrorInvalidRequest = `{
+	"errors": [
+		{
+			"parameters": {
+				"query": [
+					""
+				]
+			},
+			"message": "Invalid query"
+		}
+	],
+	"title": "Invalid Request",
+	"detail": "One or more parameters to your request was invalid.",
+	"type": "https://api.twitter.com/2/problems/invalid-request"
+}`
+)
+
+// Response is a canned response of the test server
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       string
+}
+
+// NewServer starts a server that returns the canned responses, the key is the method and path like
+// GET /2/tweets/search/recent.  Other requests are not found.  The client is set up to send its requests to the
+// server, which must be closed by the caller.
+func NewServer(responses map[string]Response) (*httptest.Server, *twitter.Client) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, has := responses[r.Method+" "+r.URL.Path]
+		if !has {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `{"title":"Not Found Error","type":"about:blank","status":404,"detail":"%s %s is not a canned response"}`, r.Method, r.URL.Path)
+			return
+		}
+		for k, v := range resp.Header {
+			w.Header()[k] = v
+		}
+		if len(w.Header().Get("Content-Type")) == 0 {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		status := resp.StatusCode
+		if status == 0 {
+			status = http.StatusOK
+		}
+		w.WriteHeader(status)
+		fmt.Fprint(w, resp.Body)
+	}))
+	client := &twitter.Client{
+		Authorizer: twitter.BearerToken("twittertest"),
+		Client:     server.Client(),
+		Host:       server.URL,
+	}
+	return server, client
+}
@@ -0,0 +1,66 @@
+// Package twittertest has a fake twitter client and canned responses for testing code that uses the twitter client.
+package twittertest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrNotProgrammed is returned by the fake when the method's func is not set
+var ErrNotProgrammed = errors.New("twittertest fake method is not programmed")
+
+// NotProgrammedError has the method of the fake that was called without a func
+type NotProgrammedError struct {
+	Method string
+}
+
+func (n *NotProgrammedError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrNotProgrammed.Error(), n.Method)
+}
+
+// Is will match ErrNotProgrammed
+func (n *NotProgrammedError) Is(target error) bool {
+	return target == ErrNotProgrammed
+}
+
+func notProgrammed(method string) error {
+	return &NotProgrammedError{
+		Method: method,
+	}
+}
+
+// Call is a method call made to the fake, the arguments do not include the context
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+type calls struct {
+	mutex sync.Mutex
+	calls []Call
+}
+
+func (c *calls) record(method string, args ...interface{}) {
+	if len(args) > 0 {
+		if _, ok := args[0].(context.Context); ok {
+			args = args[1:]
+		}
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.calls = append(c.calls, Call{
+		Method: method,
+		Args:   args,
+	})
+}
+
+// Calls returns the calls made to the fake
+func (f *Fake) Calls() []Call {
+	f.calls.mutex.Lock()
+	defer f.calls.mutex.Unlock()
+	calls := make([]Call, len(f.calls.calls))
+	copy(calls, f.calls.calls)
+	return calls
+}
@@ -0,0 +1,88 @@
+package twittertest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	twitter "github.com/g8rswimmer/go-twitter/v2"
+)
+
+func TestFake(t *testing.T) {
+	fake := &Fake{
+		TweetRecentSearchFunc: func(ctx context.Context, query string, opts twitter.TweetRecentSearchOpts) (*twitter.TweetRecentSearchResponse, error) {
+			return &twitter.TweetRecentSearchResponse{
+				Meta: &twitter.TweetRecentSearchMeta{
+					ResultCount: 1,
+				},
+			}, nil
+		},
+	}
+	var client Client = fake
+
+	resp, err := client.TweetRecentSearch(context.Background(), "golang", twitter.TweetRecentSearchOpts{MaxResults: 10})
+	if err != nil || resp.Meta.ResultCount != 1 {
+		t.Fatalf("Fake.TweetRecentSearch() = %v, %v", resp, err)
+	}
+	_, err = client.UserLookup(context.Background(), []string{"2244994945"}, twitter.UserLookupOpts{})
+	var notProgrammed *NotProgrammedError
+	if !errors.Is(err, ErrNotProgrammed) || !errors.As(err, &notProgrammed) || notProgrammed.Method != "UserLookup" {
+		t.Errorf("Fake.UserLookup() error = %v, want not programmed", err)
+	}
+
+	want := []Call{
+		{Method: "TweetRecentSearch", Args: []interface{}{"golang", twitter.TweetRecentSearchOpts{MaxResults: 10}}},
+		{Method: "UserLookup", Args: []interface{}{[]string{"2244994945"}, twitter.UserLookupOpts{}}},
+	}
+	if got := fake.Calls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Fake.Calls() = %v, want %v", got, want)
+	}
+}
+
+func TestNewServer(t *testing.T) {
+	server, client := NewServer(map[string]Response{
+		"GET /2/tweets/search/recent": {Body: SearchPage},
+		"GET /2/tweets":               {Body: PartialErrors},
+		"GET /2/tweets/search/stream": {Body: StreamKeepAlive + StreamMessage},
+		"GET /2/users/me":             {StatusCode: http.StatusUnauthorized, Body: ErrorUnauthorized},
+	})
+	defer server.Close()
+
+	search, err := client.TweetRecentSearch(context.Background(), "golang", twitter.TweetRecentSearchOpts{})
+	if err != nil {
+		t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+	}
+	if len(search.Raw.Tweets) != 2 || len(search.Raw.Includes.Users) != 1 || len(search.Meta.NextToken) == 0 {
+		t.Errorf("Client.TweetRecentSearch() = %+v", search.Raw)
+	}
+
+	lookup, err := client.TweetLookup(context.Background(), []string{"1495979553889697792", "1"}, twitter.TweetLookupOpts{})
+	if err != nil {
+		t.Fatalf("Client.TweetLookup() error = %v", err)
+	}
+	if len(lookup.Raw.Tweets) != 1 || len(lookup.Raw.Errors) != 1 {
+		t.Errorf("Client.TweetLookup() = %+v", lookup.Raw)
+	}
+
+	stream, err := client.TweetSearchStream(context.Background(), twitter.TweetSearchStreamOpts{})
+	if err != nil {
+		t.Fatalf("Client.TweetSearchStream() error = %v", err)
+	}
+	defer stream.Close()
+	select {
+	case tm := <-stream.Tweets():
+		if len(tm.Raw.Tweets) != 1 {
+			t.Errorf("Client.TweetSearchStream() message = %+v", tm.Raw)
+		}
+	case err := <-stream.Err():
+		t.Fatalf("Client.TweetSearchStream() error = %v", err)
+	}
+
+	_, err = client.AuthUserLookup(context.Background(), twitter.UserLookupOpts{})
+	er := &twitter.ErrorResponse{}
+	if !errors.As(err, &er) || er.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Client.AuthUserLookup() error = %v, want unauthorized", err)
+	}
+}
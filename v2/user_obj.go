@@ -1,5 +1,7 @@
 package twitter
 
+import "encoding/json"
+
 // UserField defines the twitter user account metadata fields
 type UserField string
 
@@ -42,22 +44,24 @@ func userFieldStringArray(arr []UserField) []string {
 	return strs
 }
 
-// UserObj contains Twitter user account metadata describing the referenced user
+// UserObj contains Twitter user account metadata describing the referenced user.  The fields of the response that are
+// not in the struct are kept in Extra.
 type UserObj struct {
-	ID              string          `json:"id"`
-	Name            string          `json:"name"`
-	UserName        string          `json:"username"`
-	CreatedAt       string          `json:"created_at,omitempty"`
-	Description     string          `json:"description,omitempty"`
-	Entities        *EntitiesObj    `json:"entities,omitempty"`
-	Location        string          `json:"location,omitempty"`
-	PinnedTweetID   string          `json:"pinned_tweet_id,omitempty"`
-	ProfileImageURL string          `json:"profile_image_url,omitempty"`
-	Protected       bool            `json:"protected,omitempty"`
-	PublicMetrics   *UserMetricsObj `json:"public_metrics,omitempty"`
-	URL             string          `json:"url,omitempty"`
-	Verified        bool            `json:"verified,omitempty"`
-	WithHeld        *WithHeldObj    `json:"withheld,omitempty"`
+	ID              string                     `json:"id"`
+	Name            string                     `json:"name"`
+	UserName        string                     `json:"username"`
+	CreatedAt       string                     `json:"created_at,omitempty"`
+	Description     string                     `json:"description,omitempty"`
+	Entities        *EntitiesObj               `json:"entities,omitempty"`
+	Location        string                     `json:"location,omitempty"`
+	PinnedTweetID   string                     `json:"pinned_tweet_id,omitempty"`
+	ProfileImageURL string                     `json:"profile_image_url,omitempty"`
+	Protected       bool                       `json:"protected,omitempty"`
+	PublicMetrics   *UserMetricsObj            `json:"public_metrics,omitempty"`
+	URL             string                     `json:"url,omitempty"`
+	Verified        bool                       `json:"verified,omitempty"`
+	WithHeld        *WithHeldObj               `json:"withheld,omitempty"`
+	Extra           map[string]json.RawMessage `json:"-"`
 }
 
 // UserMetricsObj contains details about activity for this user
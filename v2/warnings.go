@@ -0,0 +1,165 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WarningType is the type of warning sent on the client's warning channel
+type WarningType string
+
+const (
+	// WarningSlowRequest is a request that took longer than the slow request threshold
+	WarningSlowRequest WarningType = "slow_request"
+	// WarningPaginationCost is an operation that has sent more requests than the pagination cost threshold
+	WarningPaginationCost WarningType = "pagination_cost"
+	// WarningTweetCap is a threshold of the monthly tweet cap that has been reached
+	WarningTweetCap WarningType = "tweet_cap"
+	// WarningStreamRule is a filtered stream rule that the lint has a warning for, like an unknown operator
+	WarningStreamRule WarningType = "stream_rule"
+)
+
+// Warning is a hint about how the client is being used.  Warnings do not stop the request.
+type Warning struct {
+	Type      WarningType
+	Operation string
+	Method    string
+	URL       string
+	Duration  time.Duration
+	Requests  int
+	Message   string
+}
+
+func (w *Warning) String() string {
+	return fmt.Sprintf("twitter %s: %s", w.Type, w.Message)
+}
+
+type operationKey struct{}
+
+// Operation tracks the requests made for a logical operation, like paging through all of a search's results.
+type Operation struct {
+	Name     string
+	mutex    sync.Mutex
+	requests int
+	duration time.Duration
+	slowest  time.Duration
+	warned   bool
+}
+
+// OperationStats are the stats of an operation
+type OperationStats struct {
+	Name     string
+	Requests int
+	Duration time.Duration
+	Slowest  time.Duration
+}
+
+// StartOperation will return a context that tracks the requests made with it under the operation name.  The client will
+// warn through its warning channel when the operation uses more requests than the pagination cost threshold.
+func StartOperation(ctx context.Context, name string) (context.Context, *Operation) {
+	op := &Operation{
+		Name: name,
+	}
+	return context.WithValue(ctx, operationKey{}, op), op
+}
+
+// OperationFromContext returns the operation started with the context
+func OperationFromContext(ctx context.Context) (*Operation, bool) {
+	op, ok := ctx.Value(operationKey{}).(*Operation)
+	return op, ok
+}
+
+// Stats returns the current stats of the operation
+func (o *Operation) Stats() OperationStats {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	return OperationStats{
+		Name:     o.Name,
+		Requests: o.requests,
+		Duration: o.duration,
+		Slowest:  o.slowest,
+	}
+}
+
+// record returns true the first time the threshold has been reached
+func (o *Operation) record(d time.Duration, threshold int) (int, bool) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.requests++
+	o.duration += d
+	if d > o.slowest {
+		o.slowest = d
+	}
+	if threshold > 0 && o.requests >= threshold && !o.warned {
+		o.warned = true
+		return o.requests, true
+	}
+	return o.requests, false
+}
+
+func (c *Client) warn(w *Warning) {
+	if c.Warnings == nil {
+		return
+	}
+	select {
+	case c.Warnings <- w:
+	default:
+	}
+}
+
+func (c *Client) observeRequest(req *http.Request, d time.Duration) {
+	op, hasOp := OperationFromContext(req.Context())
+	opName := ""
+	if hasOp {
+		opName = op.Name
+	}
+	if c.SlowRequestThreshold > 0 && d > c.SlowRequestThreshold {
+		c.warn(&Warning{
+			Type:      WarningSlowRequest,
+			Operation: opName,
+			Method:    req.Method,
+			URL:       req.URL.String(),
+			Duration:  d,
+			Message:   fmt.Sprintf("%s %s took %s", req.Method, req.URL.Path, d.Round(time.Millisecond)),
+		})
+	}
+	if !hasOp {
+		return
+	}
+	requests, crossed := op.record(d, c.PaginationCostThreshold)
+	if !crossed {
+		return
+	}
+	c.warn(&Warning{
+		Type:      WarningPaginationCost,
+		Operation: opName,
+		Method:    req.Method,
+		URL:       req.URL.String(),
+		Requests:  requests,
+		Message:   paginationHint(opName, requests, req),
+	})
+}
+
+func paginationHint(name string, requests int, req *http.Request) string {
+	msg := fmt.Sprintf("operation %s has consumed %d requests", name, requests)
+	switch {
+	case strings.Contains(req.URL.Path, "/search/") || strings.Contains(req.URL.Path, "/counts/"):
+		query := req.URL.Query().Get("query")
+		msg += "; consider narrowing the time range"
+		if !strings.Contains(query, "lang:") {
+			msg += " or adding lang:"
+		}
+		if !strings.Contains(query, "is:retweet") {
+			msg += " or -is:retweet"
+		}
+	case len(req.URL.Query().Get("max_results")) == 0:
+		msg += "; consider setting max results to use fewer pages"
+	default:
+		msg += "; consider limiting the number of pages"
+	}
+	return msg
+}
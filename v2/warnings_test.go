@@ -0,0 +1,73 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_Warnings(t *testing.T) {
+	warnings := make(chan *Warning, 10)
+	client := &Client{
+		Authorizer:              &mockAuth{},
+		Host:                    "https://www.test.com",
+		Warnings:                warnings,
+		SlowRequestThreshold:    5 * time.Millisecond,
+		PaginationCostThreshold: 3,
+		Client: mockHTTPClient(func(req *http.Request) *http.Response {
+			if req.URL.Query().Get("next_token") == "slow" {
+				time.Sleep(10 * time.Millisecond)
+			}
+			body := `{"data":[{"id":"1","text":"hello"}],"meta":{"result_count":1,"next_token":"next"}}`
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}
+		}),
+	}
+
+	ctx, op := StartOperation(context.Background(), "backfill")
+	for i := 0; i < 5; i++ {
+		opts := TweetRecentSearchOpts{}
+		if i == 1 {
+			opts.NextToken = "slow"
+		}
+		if _, err := client.TweetRecentSearch(ctx, "golang", opts); err != nil {
+			t.Fatalf("Client.TweetRecentSearch() error = %v", err)
+		}
+	}
+	close(warnings)
+
+	got := map[WarningType][]*Warning{}
+	for w := range warnings {
+		got[w.Type] = append(got[w.Type], w)
+	}
+	if len(got[WarningSlowRequest]) != 1 {
+		t.Errorf("Client slow request warnings = %d, want 1", len(got[WarningSlowRequest]))
+	}
+	if len(got[WarningPaginationCost]) != 1 {
+		t.Fatalf("Client pagination cost warnings = %d, want 1", len(got[WarningPaginationCost]))
+	}
+	cost := got[WarningPaginationCost][0]
+	if cost.Operation != "backfill" || cost.Requests != 3 || !strings.Contains(cost.Message, "lang:") {
+		t.Errorf("Client pagination cost warning = %+v", cost)
+	}
+	if stats := op.Stats(); stats.Requests != 5 || stats.Slowest < 10*time.Millisecond {
+		t.Errorf("Operation.Stats() = %+v", stats)
+	}
+}
+
+func TestClient_Warnings_NotReady(t *testing.T) {
+	client := &Client{
+		Warnings:             make(chan *Warning),
+		SlowRequestThreshold: time.Nanosecond,
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://www.test.com/2/tweets", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.observeRequest(req, time.Second)
+}
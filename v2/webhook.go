@@ -0,0 +1,73 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// WebhookEventType is the type of activity delivered to a webhook
+type WebhookEventType string
+
+const (
+	// WebhookEventTweetCreate is a tweet that was created by, or mentions, the subscribed user
+	WebhookEventTweetCreate WebhookEventType = "tweet_create_events"
+	// WebhookEventFavorite is a like by, or of a tweet of, the subscribed user
+	WebhookEventFavorite WebhookEventType = "favorite_events"
+	// WebhookEventFollow is a follow or unfollow by, or of, the subscribed user
+	WebhookEventFollow WebhookEventType = "follow_events"
+	// WebhookEventBlock is a block or unblock by the subscribed user
+	WebhookEventBlock WebhookEventType = "block_events"
+	// WebhookEventMute is a mute or unmute by the subscribed user
+	WebhookEventMute WebhookEventType = "mute_events"
+	// WebhookEventDirectMessage is a direct message sent or received by the subscribed user
+	WebhookEventDirectMessage WebhookEventType = "direct_message_events"
+	// WebhookEventTweetDelete is a deleted tweet of the subscribed user
+	WebhookEventTweetDelete WebhookEventType = "tweet_delete_events"
+	// WebhookEventUser is a change of the subscribed user, like revoking the app's access
+	WebhookEventUser WebhookEventType = "user_event"
+)
+
+// WebhookEvent is an activity event for a subscribed user.  Tweet is the created or liked tweet, Source and Target are
+// the users of a like, follow, block, mute or direct message, and Action is the kind of event, like follow or unfollow.
+// Raw is the activity as it was delivered, which has the fields of the events that are not typed.
+type WebhookEvent struct {
+	Type          WebhookEventType
+	ForUserID     string
+	Action        string
+	Tweet         *TweetDictionary
+	Source        *UserObj
+	Target        *UserObj
+	DirectMessage *WebhookDirectMessage
+	DeletedTweet  *WebhookDeletedTweet
+	CreatedAt     time.Time
+	Raw           json.RawMessage
+}
+
+// WebhookDirectMessage is the direct message of the event
+type WebhookDirectMessage struct {
+	ID          string
+	SenderID    string
+	RecipientID string
+	Text        string
+}
+
+// WebhookDeletedTweet is the tweet of a delete event
+type WebhookDeletedTweet struct {
+	ID     string
+	UserID string
+}
+
+// WebhookDispatcher will handle the events delivered to a webhook.  Application code written against the dispatcher
+// can receive events from webhooks or from a polling simulator.
+type WebhookDispatcher interface {
+	Dispatch(ctx context.Context, event *WebhookEvent) error
+}
+
+// WebhookDispatcherFunc is a function that can be used as a webhook dispatcher
+type WebhookDispatcherFunc func(ctx context.Context, event *WebhookEvent) error
+
+// Dispatch will call the function
+func (f WebhookDispatcherFunc) Dispatch(ctx context.Context, event *WebhookEvent) error {
+	return f(ctx, event)
+}
@@ -0,0 +1,389 @@
+package twitter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	webhookSignatureHeader = "x-twitter-webhooks-signature"
+	webhookForUserIDKey    = "for_user_id"
+	webhookUsersKey        = "users"
+	webhookAppsKey         = "apps"
+	webhookBlockedKey      = "user_has_blocked"
+	webhookMaxBodySize     = 1 << 20
+)
+
+// WebhookHandler is the http.Handler of an account activity webhook.  A GET request is the CRC challenge, which is
+// answered with the token signed by the consumer secret.  A POST request must have a valid signature, its activity is
+// decoded into events and each event is sent to the dispatcher.  A dispatcher error responds with an internal server
+// error, so twitter will send the activity again.
+//
+// The handler fails closed, without a consumer secret every request is an internal server error.  MaxBodySize is the
+// largest activity, in bytes, that is read, it defaults to 1MB and a larger activity is a request entity too large.
+//
+//	handler := &twitter.WebhookHandler{
+//		ConsumerSecret: secret,
+//		Dispatcher: twitter.WebhookDispatcherFunc(func(ctx context.Context, event *twitter.WebhookEvent) error {
+//			...
+//		}),
+//	}
+//	http.Handle("/webhooks/twitter", handler)
+type WebhookHandler struct {
+	ConsumerSecret string
+	Dispatcher     WebhookDispatcher
+	MaxBodySize    int64
+}
+
+// ServeHTTP will answer the CRC challenge or dispatch the activity
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if len(h.ConsumerSecret) == 0 {
+		http.Error(w, "the webhook does not have a consumer secret", http.StatusInternalServerError)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		h.crc(w, r)
+	case http.MethodPost:
+		h.activity(w, r)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *WebhookHandler) crc(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("crc_token")
+	if len(token) == 0 {
+		http.Error(w, "crc_token is required", http.StatusBadRequest)
+		return
+	}
+	resp := struct {
+		ResponseToken string `json:"response_token"`
+	}{
+		ResponseToken: WebhookCRCResponse(h.ConsumerSecret, token),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (h *WebhookHandler) activity(w http.ResponseWriter, r *http.Request) {
+	maxBodySize := h.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = webhookMaxBodySize
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	switch {
+	case err == nil:
+	case int64(len(body)) >= maxBodySize:
+		// the max bytes reader stops at the limit
+		http.Error(w, "the body is too large", http.StatusRequestEntityTooLarge)
+		return
+	default:
+		http.Error(w, "unable to read the body", http.StatusBadRequest)
+		return
+	}
+	if !ValidWebhookSignature(h.ConsumerSecret, r.Header.Get(webhookSignatureHeader), body) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	events, err := DecodeWebhookEvents(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if h.Dispatcher != nil {
+		for _, event := range events {
+			if err := h.Dispatcher.Dispatch(r.Context(), event); err != nil {
+				http.Error(w, "dispatch error", http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// DecodeWebhookEvents will decode the account activity into events.  The events that are not typed, like the typing
+// indicators, are returned with the type of their key and the raw activity.
+func DecodeWebhookEvents(body []byte) ([]*WebhookEvent, error) {
+	activity := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &activity); err != nil {
+		return nil, fmt.Errorf("webhook activity decode: %w", err)
+	}
+	forUserID := ""
+	if raw, has := activity[webhookForUserIDKey]; has {
+		if err := json.Unmarshal(raw, &forUserID); err != nil {
+			return nil, fmt.Errorf("webhook activity for user id decode: %w", err)
+		}
+	}
+	users := map[string]*webhookUser{}
+	if raw, has := activity[webhookUsersKey]; has {
+		if err := json.Unmarshal(raw, &users); err != nil {
+			return nil, fmt.Errorf("webhook activity users decode: %w", err)
+		}
+	}
+
+	keys := []string{}
+	for key := range activity {
+		switch key {
+		case webhookForUserIDKey, webhookUsersKey, webhookAppsKey, webhookBlockedKey:
+		default:
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	events := []*WebhookEvent{}
+	for _, key := range keys {
+		eventType := WebhookEventType(key)
+		raws := []json.RawMessage{}
+		if err := json.Unmarshal(activity[key], &raws); err != nil {
+			// the user event is not a list
+			raws = []json.RawMessage{activity[key]}
+		}
+		for _, raw := range raws {
+			event := &WebhookEvent{
+				Type:      eventType,
+				ForUserID: forUserID,
+				Raw:       raw,
+			}
+			if err := event.decode(raw, users); err != nil {
+				return nil, fmt.Errorf("webhook activity %s decode: %w", key, err)
+			}
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (e *WebhookEvent) decode(raw json.RawMessage, users map[string]*webhookUser) error {
+	switch e.Type {
+	case WebhookEventTweetCreate:
+		tweet := &webhookTweet{}
+		if err := json.Unmarshal(raw, tweet); err != nil {
+			return err
+		}
+		e.Tweet = tweet.dictionary()
+		e.CreatedAt = webhookTime(tweet.CreatedAt)
+	case WebhookEventFavorite:
+		favorite := struct {
+			CreatedAt       string        `json:"created_at"`
+			FavoritedStatus *webhookTweet `json:"favorited_status"`
+			User            *webhookUser  `json:"user"`
+		}{}
+		if err := json.Unmarshal(raw, &favorite); err != nil {
+			return err
+		}
+		e.Action = "favorite"
+		e.Tweet = favorite.FavoritedStatus.dictionary()
+		e.Source = favorite.User.userObj("")
+		e.CreatedAt = webhookTime(favorite.CreatedAt)
+	case WebhookEventFollow, WebhookEventBlock, WebhookEventMute:
+		relation := struct {
+			Type             string       `json:"type"`
+			CreatedTimestamp string       `json:"created_timestamp"`
+			Source           *webhookUser `json:"source"`
+			Target           *webhookUser `json:"target"`
+		}{}
+		if err := json.Unmarshal(raw, &relation); err != nil {
+			return err
+		}
+		e.Action = relation.Type
+		e.Source = relation.Source.userObj("")
+		e.Target = relation.Target.userObj("")
+		e.CreatedAt = webhookTimestamp(relation.CreatedTimestamp)
+	case WebhookEventDirectMessage:
+		dm := struct {
+			Type             string `json:"type"`
+			ID               string `json:"id"`
+			CreatedTimestamp string `json:"created_timestamp"`
+			MessageCreate    struct {
+				Target struct {
+					RecipientID string `json:"recipient_id"`
+				} `json:"target"`
+				SenderID    string `json:"sender_id"`
+				MessageData struct {
+					Text string `json:"text"`
+				} `json:"message_data"`
+			} `json:"message_create"`
+		}{}
+		if err := json.Unmarshal(raw, &dm); err != nil {
+			return err
+		}
+		e.Action = dm.Type
+		e.DirectMessage = &WebhookDirectMessage{
+			ID:          dm.ID,
+			SenderID:    dm.MessageCreate.SenderID,
+			RecipientID: dm.MessageCreate.Target.RecipientID,
+			Text:        dm.MessageCreate.MessageData.Text,
+		}
+		e.Source = users[dm.MessageCreate.SenderID].userObj(dm.MessageCreate.SenderID)
+		e.Target = users[dm.MessageCreate.Target.RecipientID].userObj(dm.MessageCreate.Target.RecipientID)
+		e.CreatedAt = webhookTimestamp(dm.CreatedTimestamp)
+	case WebhookEventTweetDelete:
+		deleted := struct {
+			Status struct {
+				ID     string `json:"id"`
+				UserID string `json:"user_id"`
+			} `json:"status"`
+			TimestampMS string `json:"timestamp_ms"`
+		}{}
+		if err := json.Unmarshal(raw, &deleted); err != nil {
+			return err
+		}
+		e.Action = "delete"
+		e.DeletedTweet = &WebhookDeletedTweet{
+			ID:     deleted.Status.ID,
+			UserID: deleted.Status.UserID,
+		}
+		e.CreatedAt = webhookTimestamp(deleted.TimestampMS)
+	case WebhookEventUser:
+		user := map[string]struct {
+			DateTime string `json:"date_time"`
+			Source   struct {
+				UserID string `json:"user_id"`
+			} `json:"source"`
+		}{}
+		if err := json.Unmarshal(raw, &user); err != nil {
+			return err
+		}
+		for action, change := range user {
+			e.Action = action
+			e.Source = &UserObj{ID: change.Source.UserID}
+			e.CreatedAt, _ = time.Parse(time.RFC3339, change.DateTime)
+		}
+	default:
+	}
+	return nil
+}
+
+// webhookTweet is the v1.1 tweet of the account activity
+type webhookTweet struct {
+	IDStr         string `json:"id_str"`
+	Text          string `json:"text"`
+	FullText      string `json:"full_text"`
+	ExtendedTweet *struct {
+		FullText string `json:"full_text"`
+	} `json:"extended_tweet"`
+	CreatedAt            string        `json:"created_at"`
+	Lang                 string        `json:"lang"`
+	Source               string        `json:"source"`
+	User                 *webhookUser  `json:"user"`
+	InReplyToStatusIDStr string        `json:"in_reply_to_status_id_str"`
+	InReplyToUserIDStr   string        `json:"in_reply_to_user_id_str"`
+	QuotedStatusIDStr    string        `json:"quoted_status_id_str"`
+	RetweetedStatus      *webhookTweet `json:"retweeted_status"`
+}
+
+func (t *webhookTweet) dictionary() *TweetDictionary {
+	if t == nil {
+		return nil
+	}
+	text := t.Text
+	switch {
+	case t.ExtendedTweet != nil && len(t.ExtendedTweet.FullText) > 0:
+		text = t.ExtendedTweet.FullText
+	case len(t.FullText) > 0:
+		text = t.FullText
+	default:
+	}
+	dictionary := &TweetDictionary{
+		Tweet: TweetObj{
+			ID:              t.IDStr,
+			Text:            text,
+			Language:        t.Lang,
+			Source:          t.Source,
+			InReplyToUserID: t.InReplyToUserIDStr,
+		},
+		Author: t.User.userObj(""),
+	}
+	if created := webhookTime(t.CreatedAt); !created.IsZero() {
+		dictionary.Tweet.CreatedAt = created.Format(time.RFC3339)
+	}
+	if dictionary.Author != nil {
+		dictionary.Tweet.AuthorID = dictionary.Author.ID
+	}
+	reference := func(referenceType, id string) {
+		if len(id) > 0 {
+			dictionary.Tweet.ReferencedTweets = append(dictionary.Tweet.ReferencedTweets, &TweetReferencedTweetObj{
+				Type: referenceType,
+				ID:   id,
+			})
+		}
+	}
+	reference("replied_to", t.InReplyToStatusIDStr)
+	reference("quoted", t.QuotedStatusIDStr)
+	if t.RetweetedStatus != nil {
+		reference("retweeted", t.RetweetedStatus.IDStr)
+	}
+	return dictionary
+}
+
+// webhookUser is the v1.1 user of the account activity, the users of a direct message are keyed by their id
+type webhookUser struct {
+	IDStr           string `json:"id_str"`
+	Name            string `json:"name"`
+	ScreenName      string `json:"screen_name"`
+	Description     string `json:"description"`
+	Location        string `json:"location"`
+	URL             string `json:"url"`
+	Protected       bool   `json:"protected"`
+	Verified        bool   `json:"verified"`
+	ProfileImageURL string `json:"profile_image_url_https"`
+	FollowersCount  int    `json:"followers_count"`
+	FriendsCount    int    `json:"friends_count"`
+	StatusesCount   int    `json:"statuses_count"`
+	ListedCount     int    `json:"listed_count"`
+}
+
+func (u *webhookUser) userObj(id string) *UserObj {
+	if u == nil {
+		if len(id) == 0 {
+			return nil
+		}
+		return &UserObj{ID: id}
+	}
+	if len(u.IDStr) > 0 {
+		id = u.IDStr
+	}
+	return &UserObj{
+		ID:              id,
+		Name:            u.Name,
+		UserName:        u.ScreenName,
+		Description:     u.Description,
+		Location:        u.Location,
+		URL:             u.URL,
+		Protected:       u.Protected,
+		Verified:        u.Verified,
+		ProfileImageURL: u.ProfileImageURL,
+		PublicMetrics: &UserMetricsObj{
+			Followers: u.FollowersCount,
+			Following: u.FriendsCount,
+			Tweets:    u.StatusesCount,
+			Listed:    u.ListedCount,
+		},
+	}
+}
+
+// webhookTime parses the v1.1 created at, like Wed Oct 10 20:19:24 +0000 2018
+func webhookTime(createdAt string) time.Time {
+	t, err := time.Parse(time.RubyDate, createdAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return t.UTC()
+}
+
+// webhookTimestamp parses the milliseconds since the epoch
+func webhookTimestamp(timestamp string) time.Time {
+	ms, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms).UTC()
+}
@@ -0,0 +1,230 @@
+package twitter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWebhookCRCResponse(t *testing.T) {
+	if got := WebhookCRCResponse("secret", "token"); got != "sha256=6UERDj0r/oJiHw4+FDRzDXMF0QbF9oyHFl0LJ6RhGko=" {
+		t.Errorf("WebhookCRCResponse() = %s", got)
+	}
+}
+
+func TestWebhookHandler_CRC(t *testing.T) {
+	handler := &WebhookHandler{ConsumerSecret: "secret"}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/webhook?crc_token=token", nil))
+	resp := map[string]string{}
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("WebhookHandler.ServeHTTP() decode error %v", err)
+	}
+	if rec.Code != http.StatusOK || resp["response_token"] != WebhookCRCResponse("secret", "token") {
+		t.Errorf("WebhookHandler.ServeHTTP() = %d %v", rec.Code, resp)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/webhook", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("WebhookHandler.ServeHTTP() without a token = %d", rec.Code)
+	}
+}
+
+func TestWebhookHandler_Activity(t *testing.T) {
+	body := `{
+		"for_user_id": "2244994945",
+		"tweet_create_events": [
+			{
+				"created_at": "Wed Oct 10 20:19:24 +0000 2018",
+				"id_str": "1050118621198921728",
+				"text": "To make room for more expression",
+				"in_reply_to_status_id_str": "1050118621198921700",
+				"user": {"id_str": "6253282", "name": "Twitter API", "screen_name": "TwitterAPI", "followers_count": 10}
+			}
+		],
+		"follow_events": [
+			{
+				"type": "unfollow",
+				"created_timestamp": "1517588749178",
+				"target": {"id_str": "2244994945", "screen_name": "TwitterDev"},
+				"source": {"id_str": "3001969357", "screen_name": "Bot"}
+			}
+		],
+		"direct_message_events": [
+			{
+				"type": "message_create",
+				"id": "954491830116155396",
+				"created_timestamp": "1516403560557",
+				"message_create": {
+					"target": {"recipient_id": "2244994945"},
+					"sender_id": "3001969357",
+					"message_data": {"text": "Hello World!"}
+				}
+			}
+		],
+		"users": {
+			"3001969357": {"id": "3001969357", "name": "Jordan Brinks", "screen_name": "furiouscamper"}
+		},
+		"direct_message_indicate_typing_events": [
+			{"created_timestamp": "1518127183443", "sender_id": "3001969357", "target": {"recipient_id": "2244994945"}}
+		]
+	}`
+	events := []*WebhookEvent{}
+	handler := &WebhookHandler{
+		ConsumerSecret: "secret",
+		Dispatcher: WebhookDispatcherFunc(func(ctx context.Context, event *WebhookEvent) error {
+			events = append(events, event)
+			return nil
+		}),
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	req.Header.Set("x-twitter-webhooks-signature", "sha256="+webhookSignature("secret", []byte(body)))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("WebhookHandler.ServeHTTP() = %d %s", rec.Code, rec.Body.String())
+	}
+	if len(events) != 4 {
+		t.Fatalf("WebhookHandler.ServeHTTP() events = %d, want 4", len(events))
+	}
+
+	dm := events[0]
+	wantDM := &WebhookDirectMessage{
+		ID:          "954491830116155396",
+		SenderID:    "3001969357",
+		RecipientID: "2244994945",
+		Text:        "Hello World!",
+	}
+	if dm.Type != WebhookEventDirectMessage || !reflect.DeepEqual(dm.DirectMessage, wantDM) || dm.Source.UserName != "furiouscamper" || dm.Target.ID != "2244994945" {
+		t.Errorf("WebhookHandler.ServeHTTP() direct message = %+v", dm)
+	}
+	if typing := events[1]; typing.Type != "direct_message_indicate_typing_events" || len(typing.Raw) == 0 {
+		t.Errorf("WebhookHandler.ServeHTTP() typing = %+v", typing)
+	}
+	follow := events[2]
+	if follow.Type != WebhookEventFollow || follow.Action != "unfollow" || follow.Source.ID != "3001969357" || !follow.CreatedAt.Equal(time.UnixMilli(1517588749178)) {
+		t.Errorf("WebhookHandler.ServeHTTP() follow = %+v", follow)
+	}
+	tweet := events[3]
+	wantTweet := TweetObj{
+		ID:        "1050118621198921728",
+		Text:      "To make room for more expression",
+		AuthorID:  "6253282",
+		CreatedAt: "2018-10-10T20:19:24Z",
+		ReferencedTweets: []*TweetReferencedTweetObj{
+			{Type: "replied_to", ID: "1050118621198921700"},
+		},
+	}
+	if tweet.Type != WebhookEventTweetCreate || tweet.ForUserID != "2244994945" || !reflect.DeepEqual(tweet.Tweet.Tweet, wantTweet) || tweet.Tweet.Author.UserName != "TwitterAPI" {
+		t.Errorf("WebhookHandler.ServeHTTP() tweet = %+v", tweet.Tweet.Tweet)
+	}
+}
+
+func TestWebhookHandler_Rejected(t *testing.T) {
+	body := `{"for_user_id":"2244994945","tweet_delete_events":[{"status":{"id":"1","user_id":"2"},"timestamp_ms":"1528917879478"}]}`
+	dispatchErr := errors.New("dispatch error")
+	handler := &WebhookHandler{
+		ConsumerSecret: "secret",
+		Dispatcher: WebhookDispatcherFunc(func(ctx context.Context, event *WebhookEvent) error {
+			return dispatchErr
+		}),
+	}
+	tests := []struct {
+		name      string
+		method    string
+		signature string
+		want      int
+	}{
+		{
+			name:      "invalid signature",
+			method:    http.MethodPost,
+			signature: "sha256=" + webhookSignature("other", []byte(body)),
+			want:      http.StatusUnauthorized,
+		},
+		{
+			name:      "dispatch error",
+			method:    http.MethodPost,
+			signature: "sha256=" + webhookSignature("secret", []byte(body)),
+			want:      http.StatusInternalServerError,
+		},
+		{
+			name:   "method",
+			method: http.MethodPut,
+			want:   http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/webhook", strings.NewReader(body))
+			req.Header.Set("x-twitter-webhooks-signature", tt.signature)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.want {
+				t.Errorf("WebhookHandler.ServeHTTP() = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookHandler_FailClosed(t *testing.T) {
+	body := `{"for_user_id":"2244994945","tweet_delete_events":[{"status":{"id":"1","user_id":"2"},"timestamp_ms":"1528917879478"}]}`
+	dispatched := false
+	dispatcher := WebhookDispatcherFunc(func(ctx context.Context, event *WebhookEvent) error {
+		dispatched = true
+		return nil
+	})
+	tests := []struct {
+		name    string
+		handler *WebhookHandler
+		req     *http.Request
+		want    int
+	}{
+		{
+			name:    "no secret crc",
+			handler: &WebhookHandler{Dispatcher: dispatcher},
+			req:     httptest.NewRequest(http.MethodGet, "/webhook?crc_token=token", nil),
+			want:    http.StatusInternalServerError,
+		},
+		{
+			name:    "no secret activity",
+			handler: &WebhookHandler{Dispatcher: dispatcher},
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+				req.Header.Set("x-twitter-webhooks-signature", "sha256="+webhookSignature("", []byte(body)))
+				return req
+			}(),
+			want: http.StatusInternalServerError,
+		},
+		{
+			name:    "too large",
+			handler: &WebhookHandler{ConsumerSecret: "secret", Dispatcher: dispatcher, MaxBodySize: 16},
+			req: func() *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+				req.Header.Set("x-twitter-webhooks-signature", "sha256="+webhookSignature("secret", []byte(body)))
+				return req
+			}(),
+			want: http.StatusRequestEntityTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rec, tt.req)
+			if rec.Code != tt.want {
+				t.Errorf("WebhookHandler.ServeHTTP() = %d, want %d", rec.Code, tt.want)
+			}
+			if dispatched {
+				t.Errorf("WebhookHandler.ServeHTTP() should not dispatch the activity")
+			}
+		})
+	}
+}